@@ -31,29 +31,135 @@ const (
 const (
 	BADREQUEST          ErrorResponseErrorCode = "BAD_REQUEST"
 	INTERNALSERVERERROR ErrorResponseErrorCode = "INTERNAL_SERVER_ERROR"
+	INVALIDTRANSITION   ErrorResponseErrorCode = "INVALID_TRANSITION"
+	MERGEBLOCKED        ErrorResponseErrorCode = "MERGE_BLOCKED"
 	NOCANDIDATE         ErrorResponseErrorCode = "NO_CANDIDATE"
 	NOTASSIGNED         ErrorResponseErrorCode = "NOT_ASSIGNED"
 	NOTFOUND            ErrorResponseErrorCode = "NOT_FOUND"
 	PREXISTS            ErrorResponseErrorCode = "PR_EXISTS"
 	PRMERGED            ErrorResponseErrorCode = "PR_MERGED"
+	PRNOTOPEN           ErrorResponseErrorCode = "PR_NOT_OPEN"
+	REVIEWERLIMIT       ErrorResponseErrorCode = "REVIEWER_LIMIT"
 	TEAMEXISTS          ErrorResponseErrorCode = "TEAM_EXISTS"
 )
 
+// Defines values for EscalationPolicy.
+const (
+	NotifyLead EscalationPolicy = "notify_lead"
+	Reassign   EscalationPolicy = "reassign"
+)
+
 // Defines values for PullRequestStatus.
 const (
+	PullRequestStatusCLOSED PullRequestStatus = "CLOSED"
+	PullRequestStatusDRAFT  PullRequestStatus = "DRAFT"
 	PullRequestStatusMERGED PullRequestStatus = "MERGED"
 	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
 )
 
 // Defines values for PullRequestShortStatus.
 const (
+	PullRequestShortStatusCLOSED PullRequestShortStatus = "CLOSED"
+	PullRequestShortStatusDRAFT  PullRequestShortStatus = "DRAFT"
 	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
 	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
 )
 
+// Defines values for ReviewAssignmentStatus.
+const (
+	ReviewAssignmentStatusCLOSED ReviewAssignmentStatus = "CLOSED"
+	ReviewAssignmentStatusDRAFT  ReviewAssignmentStatus = "DRAFT"
+	ReviewAssignmentStatusMERGED ReviewAssignmentStatus = "MERGED"
+	ReviewAssignmentStatusOPEN   ReviewAssignmentStatus = "OPEN"
+)
+
+// Defines values for ReviewVerdict.
+const (
+	ReviewVerdictAPPROVED         ReviewVerdict = "APPROVED"
+	ReviewVerdictCHANGESREQUESTED ReviewVerdict = "CHANGES_REQUESTED"
+)
+
+// Defines values for ReviewerEventVerdict.
+const (
+	ReviewerEventVerdictAPPROVED         ReviewerEventVerdict = "APPROVED"
+	ReviewerEventVerdictCHANGESREQUESTED ReviewerEventVerdict = "CHANGES_REQUESTED"
+)
+
+// Defines values for ReviewerEventType.
+const (
+	ReviewerEventTypeASSIGNED   ReviewerEventType = "ASSIGNED"
+	ReviewerEventTypeCLOSED     ReviewerEventType = "CLOSED"
+	ReviewerEventTypeMERGED     ReviewerEventType = "MERGED"
+	ReviewerEventTypeREASSIGNED ReviewerEventType = "REASSIGNED"
+	ReviewerEventTypeREOPENED   ReviewerEventType = "REOPENED"
+	ReviewerEventTypeUNASSIGNED ReviewerEventType = "UNASSIGNED"
+	ReviewerEventTypeVERDICT    ReviewerEventType = "VERDICT"
+)
+
+// Defines values for ReviewerStrategy.
+const (
+	LeastLoaded ReviewerStrategy = "least_loaded"
+	Random      ReviewerStrategy = "random"
+	RoundRobin  ReviewerStrategy = "round_robin"
+)
+
+// Defines values for TeamChangeBlockedErrorCode.
+const (
+	OPENREVIEWS TeamChangeBlockedErrorCode = "OPEN_REVIEWS"
+)
+
+// Defines values for GetPullRequestListParamsStatusItem.
+const (
+	GetPullRequestListParamsStatusItemCLOSED GetPullRequestListParamsStatusItem = "CLOSED"
+	GetPullRequestListParamsStatusItemDRAFT  GetPullRequestListParamsStatusItem = "DRAFT"
+	GetPullRequestListParamsStatusItemMERGED GetPullRequestListParamsStatusItem = "MERGED"
+	GetPullRequestListParamsStatusItemOPEN   GetPullRequestListParamsStatusItem = "OPEN"
+)
+
+// Defines values for GetPullRequestSearchParamsStatusItem.
+const (
+	GetPullRequestSearchParamsStatusItemCLOSED GetPullRequestSearchParamsStatusItem = "CLOSED"
+	GetPullRequestSearchParamsStatusItemDRAFT  GetPullRequestSearchParamsStatusItem = "DRAFT"
+	GetPullRequestSearchParamsStatusItemMERGED GetPullRequestSearchParamsStatusItem = "MERGED"
+	GetPullRequestSearchParamsStatusItemOPEN   GetPullRequestSearchParamsStatusItem = "OPEN"
+)
+
+// AssignmentCountPerTeam defines model for AssignmentCountPerTeam.
+type AssignmentCountPerTeam struct {
+	// AssignedCount Назначения участников команды
+	AssignedCount int `json:"assigned_count"`
+
+	// OpenCount Текущие назначения участников команды на OPEN PR
+	OpenCount      int     `json:"open_count"`
+	ParentTeamName *string `json:"parent_team_name"`
+
+	// SubtreeAssignedCount Назначения участников команды и всех команд ниже, участник нескольких команд учитывается один раз
+	SubtreeAssignedCount int `json:"subtree_assigned_count"`
+
+	// SubtreeOpenCount То же для OPEN PR
+	SubtreeOpenCount int    `json:"subtree_open_count"`
+	TeamName         string `json:"team_name"`
+}
+
 // AssignmentCountPerUser defines model for AssignmentCountPerUser.
 type AssignmentCountPerUser struct {
-	AssignedCount int    `json:"assigned_count"`
+	AssignedCount int `json:"assigned_count"`
+
+	// AtCapacity Лимит исчерпан, новые PR пользователю не назначаются
+	AtCapacity bool `json:"at_capacity"`
+
+	// MaxOpenReviews Действующий лимит OPEN ревью, null - без ограничений
+	MaxOpenReviews *int `json:"max_open_reviews"`
+
+	// OpenCount Текущее число назначений на OPEN PR
+	OpenCount int    `json:"open_count"`
+	UserId    string `json:"user_id"`
+}
+
+// BlockingReview defines model for BlockingReview.
+type BlockingReview struct {
+	// PullRequestId OPEN PR, где пользователь назначен ревьювером
+	PullRequestId string `json:"pull_request_id"`
 	UserId        string `json:"user_id"`
 }
 
@@ -68,24 +174,75 @@ type ErrorResponse struct {
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// EscalationPolicy Что происходит с просроченным назначением: reassign - ревью переназначается как в /pullRequest/reassign
+// (если замены нет, уведомляется тимлид), notify_lead - уведомляется тимлид. По умолчанию reassign
+type EscalationPolicy string
+
+// MergeOverride PR смержен администратором в обход merge-политики команды автора
+type MergeOverride struct {
+	// By user_id администратора
+	By              string   `json:"by"`
+	UnmetConditions []string `json:"unmet_conditions"`
+}
+
+// OwnershipRule defines model for OwnershipRule.
+type OwnershipRule struct {
+	// Pattern Glob-шаблон пути в синтаксисе CODEOWNERS
+	Pattern string `json:"pattern"`
+
+	// Teams Команды-владельцы (@org/team_name в документе)
+	Teams []string `json:"teams"`
+
+	// Users user_id владельцев (@user_id в документе)
+	Users []string `json:"users"`
+}
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
-	// AssignedReviewers user_id назначенных ревьюверов (0..2)
-	AssignedReviewers []string          `json:"assigned_reviewers"`
-	AuthorId          string            `json:"author_id"`
-	CreatedAt         *time.Time        `json:"createdAt"`
-	MergedAt          *time.Time        `json:"mergedAt"`
-	PullRequestId     string            `json:"pull_request_id"`
-	PullRequestName   string            `json:"pull_request_name"`
-	Status            PullRequestStatus `json:"status"`
+	// AssignedReviewers user_id назначенных ревьюверов (от min_reviewers до max_reviewers команды автора)
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	AuthorId          string     `json:"author_id"`
+	ClosedAt          *time.Time `json:"closedAt"`
+	CreatedAt         *time.Time `json:"createdAt"`
+	Description       *string    `json:"description,omitempty"`
+
+	// ExternalId Идентификатор PR в системе хостинга кода
+	ExternalId *string `json:"external_id,omitempty"`
+
+	// FallbackReviewers Ревьюверы из assigned_reviewers, назначенные из резервных команд
+	FallbackReviewers *[]string      `json:"fallback_reviewers,omitempty"`
+	MergeOverride     *MergeOverride `json:"merge_override"`
+	MergedAt          *time.Time     `json:"mergedAt"`
+	PullRequestId     string         `json:"pull_request_id"`
+	PullRequestName   string         `json:"pull_request_name"`
+
+	// Reviews Последние вердикты ревьюверов, по одному на ревьювера
+	Reviews      *[]Review         `json:"reviews,omitempty"`
+	SourceBranch *string           `json:"source_branch,omitempty"`
+	Status       PullRequestStatus `json:"status"`
+	TargetBranch *string           `json:"target_branch,omitempty"`
+
+	// Url Ссылка на PR в системе хостинга кода
+	Url *string `json:"url,omitempty"`
 }
 
 // PullRequestStatus defines model for PullRequest.Status.
 type PullRequestStatus string
 
+// PullRequestSearchHit defines model for PullRequestSearchHit.
+type PullRequestSearchHit struct {
+	// Highlight Фрагмент названия и описания, совпадения обёрнуты в <b></b>
+	Highlight string           `json:"highlight"`
+	Pr        PullRequestShort `json:"pr"`
+
+	// Rank Релевантность, больше — лучше
+	Rank float64 `json:"rank"`
+}
+
 // PullRequestShort defines model for PullRequestShort.
 type PullRequestShort struct {
 	AuthorId        string                 `json:"author_id"`
+	CreatedAt       *time.Time             `json:"createdAt,omitempty"`
 	PullRequestId   string                 `json:"pull_request_id"`
 	PullRequestName string                 `json:"pull_request_name"`
 	Status          PullRequestShortStatus `json:"status"`
@@ -94,12 +251,147 @@ type PullRequestShort struct {
 // PullRequestShortStatus defines model for PullRequestShort.Status.
 type PullRequestShortStatus string
 
+// Review defines model for Review.
+type Review struct {
+	Comment     *string       `json:"comment,omitempty"`
+	ReviewerId  string        `json:"reviewer_id"`
+	SubmittedAt time.Time     `json:"submitted_at"`
+	Verdict     ReviewVerdict `json:"verdict"`
+}
+
+// ReviewAssignment defines model for ReviewAssignment.
+type ReviewAssignment struct {
+	AuthorId  string     `json:"author_id"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// DueAt Срок ревью, отсутствует, если у команды автора нет SLA
+	DueAt *time.Time `json:"due_at"`
+
+	// Overdue PR открыт, а срок ревью прошёл
+	Overdue         bool   `json:"overdue"`
+	PullRequestId   string `json:"pull_request_id"`
+	PullRequestName string `json:"pull_request_name"`
+
+	// ReviewerAbsences Текущие и предстоящие периоды недоступности ревьюверов PR
+	ReviewerAbsences *[]Unavailability      `json:"reviewer_absences,omitempty"`
+	Status           ReviewAssignmentStatus `json:"status"`
+}
+
+// ReviewAssignmentStatus defines model for ReviewAssignment.Status.
+type ReviewAssignmentStatus string
+
+// ReviewReassignFailure defines model for ReviewReassignFailure.
+type ReviewReassignFailure struct {
+	PullRequestId string `json:"pull_request_id"`
+	Reason        string `json:"reason"`
+}
+
+// ReviewReplacement defines model for ReviewReplacement.
+type ReviewReplacement struct {
+	PullRequestId string `json:"pull_request_id"`
+	ReplacedBy    string `json:"replaced_by"`
+}
+
+// ReviewVerdict defines model for ReviewVerdict.
+type ReviewVerdict string
+
+// ReviewerEvent defines model for ReviewerEvent.
+type ReviewerEvent struct {
+	// ActorId user_id из JWT инициатора. Пусто, если изменение сделал сервис (например, эскалация просроченного ревью)
+	ActorId   *string   `json:"actor_id"`
+	CreatedAt time.Time `json:"created_at"`
+	EventId   int64     `json:"event_id"`
+
+	// PreviousReviewerId Заменённый ревьювер, только для REASSIGNED
+	PreviousReviewerId *string `json:"previous_reviewer_id"`
+	Reason             string  `json:"reason"`
+
+	// ReviewerId Ревьювер, которого касается событие. Пусто для MERGED, CLOSED и REOPENED
+	ReviewerId *string           `json:"reviewer_id"`
+	Type       ReviewerEventType `json:"type"`
+
+	// Verdict Только для VERDICT
+	Verdict *ReviewerEventVerdict `json:"verdict"`
+}
+
+// ReviewerEventVerdict Только для VERDICT
+type ReviewerEventVerdict string
+
+// ReviewerEventType defines model for ReviewerEventType.
+type ReviewerEventType string
+
+// ReviewerStrategy Стратегия выбора ревьюверов команды:
+// random - случайные активные участники,
+// least_loaded - участники с наименьшим числом OPEN назначений,
+// round_robin - участники по очереди
+type ReviewerStrategy string
+
 // Team defines model for Team.
 type Team struct {
-	Members  []TeamMember `json:"members"`
-	TeamName string       `json:"team_name"`
+	// Ancestors Цепочка команд выше, начиная с родителя. Отдается в /team/get
+	Ancestors *[]string `json:"ancestors,omitempty"`
+
+	// BlockOnChangesRequested Запрещать merge, пока у PR есть вердикт CHANGES_REQUESTED, по умолчанию false
+	BlockOnChangesRequested *bool `json:"block_on_changes_requested,omitempty"`
+
+	// Children Команды непосредственно ниже, по имени. Отдаются в /team/get
+	Children *[]string `json:"children,omitempty"`
+
+	// DefaultMaxOpenReviews Лимит OPEN ревью для участников без собственного лимита, 0 - без ограничений (по умолчанию)
+	DefaultMaxOpenReviews *int              `json:"default_max_open_reviews,omitempty"`
+	EscalationPolicy      *EscalationPolicy `json:"escalation_policy,omitempty"`
+
+	// FallbackTeams Команды, из которых добираются ревьюверы, если в команде не хватает активных участников (в порядке приоритета)
+	FallbackTeams *[]string `json:"fallback_teams,omitempty"`
+
+	// LeadId Тимлид, которому приходят уведомления о просроченных ревью
+	LeadId *string `json:"lead_id"`
+
+	// MaxReviewers Максимальное число ревьюверов PR автора из команды, по умолчанию 2
+	MaxReviewers *int         `json:"max_reviewers,omitempty"`
+	Members      []TeamMember `json:"members"`
+
+	// MinReviewers Минимальное число ревьюверов PR автора из команды, по умолчанию 0
+	MinReviewers *int `json:"min_reviewers,omitempty"`
+
+	// ParentTeamName Команда уровнем выше (squad -> tribe -> department), null - корень дерева.
+	// Если команде и ее резервным командам не хватает ревьюверов, они добираются из соседних команд, затем из родителя и выше
+	ParentTeamName *string `json:"parent_team_name"`
+
+	// RequiredApprovals Число APPROVED вердиктов, необходимое для merge PR участника команды, 0 - не требуются (по умолчанию)
+	RequiredApprovals *int `json:"required_approvals,omitempty"`
+
+	// ReviewSlaBusinessHours Считать срок ревью только в рабочие часы (пн-пт с review_sla_workday_start до review_sla_workday_end
+	// в review_sla_timezone), по умолчанию false. Ревью, назначенное в пятницу вечером, отсчитывается с утра понедельника
+	ReviewSlaBusinessHours *bool `json:"review_sla_business_hours,omitempty"`
+
+	// ReviewSlaHours Сколько часов у ревьювера на ревью с момента назначения, 0 - без срока (по умолчанию)
+	ReviewSlaHours *int `json:"review_sla_hours,omitempty"`
+
+	// ReviewSlaTimezone Часовой пояс рабочих часов в формате IANA (например, Europe/Moscow), по умолчанию UTC
+	ReviewSlaTimezone *string `json:"review_sla_timezone,omitempty"`
+
+	// ReviewSlaWorkdayEnd Час конца рабочего дня для review_sla_business_hours, больше начала, по умолчанию 18
+	ReviewSlaWorkdayEnd *int `json:"review_sla_workday_end,omitempty"`
+
+	// ReviewSlaWorkdayStart Час начала рабочего дня для review_sla_business_hours, по умолчанию 9
+	ReviewSlaWorkdayStart *int              `json:"review_sla_workday_start,omitempty"`
+	ReviewerStrategy      *ReviewerStrategy `json:"reviewer_strategy,omitempty"`
+	TeamName              string            `json:"team_name"`
+}
+
+// TeamChangeBlocked Ошибка в формате ErrorResponse со списком ревью, которые не дают сменить команду
+type TeamChangeBlocked struct {
+	BlockingReviews []BlockingReview `json:"blocking_reviews"`
+	Error           struct {
+		Code    TeamChangeBlockedErrorCode `json:"code"`
+		Message string                     `json:"message"`
+	} `json:"error"`
 }
 
+// TeamChangeBlockedErrorCode defines model for TeamChangeBlocked.Error.Code.
+type TeamChangeBlockedErrorCode string
+
 // TeamMember defines model for TeamMember.
 type TeamMember struct {
 	IsActive bool   `json:"is_active"`
@@ -107,12 +399,93 @@ type TeamMember struct {
 	Username string `json:"username"`
 }
 
+// TeamSummary defines model for TeamSummary.
+type TeamSummary struct {
+	ActiveMemberCount int `json:"active_member_count"`
+	MemberCount       int `json:"member_count"`
+
+	// OpenReviews Число назначений участников команды на OPEN PR
+	OpenReviews int    `json:"open_reviews"`
+	TeamName    string `json:"team_name"`
+}
+
+// TeamUpdate defines model for TeamUpdate.
+type TeamUpdate struct {
+	// BlockOnChangesRequested Запрещать merge, пока у PR есть вердикт CHANGES_REQUESTED, по умолчанию false
+	BlockOnChangesRequested *bool `json:"block_on_changes_requested,omitempty"`
+
+	// DefaultMaxOpenReviews Лимит OPEN ревью для участников без собственного лимита, 0 - без ограничений (по умолчанию)
+	DefaultMaxOpenReviews *int              `json:"default_max_open_reviews,omitempty"`
+	EscalationPolicy      *EscalationPolicy `json:"escalation_policy,omitempty"`
+
+	// FallbackTeams Команды, из которых добираются ревьюверы, если в команде не хватает активных участников (в порядке приоритета)
+	FallbackTeams *[]string `json:"fallback_teams,omitempty"`
+
+	// LeadId Тимлид команды, пустая строка убирает тимлида
+	LeadId *string `json:"lead_id,omitempty"`
+
+	// MaxReviewers Максимальное число ревьюверов PR автора из команды, по умолчанию 2
+	MaxReviewers *int `json:"max_reviewers,omitempty"`
+
+	// MinReviewers Минимальное число ревьюверов PR автора из команды, по умолчанию 0
+	MinReviewers *int `json:"min_reviewers,omitempty"`
+
+	// ParentTeamName Команда уровнем выше, пустая строка делает команду корнем дерева
+	ParentTeamName *string `json:"parent_team_name,omitempty"`
+
+	// RequiredApprovals Число APPROVED вердиктов, необходимое для merge PR участника команды, 0 - не требуются (по умолчанию)
+	RequiredApprovals *int `json:"required_approvals,omitempty"`
+
+	// ReviewSlaBusinessHours Считать срок ревью только в рабочие часы (пн-пт, см. review_sla_workday_start, review_sla_workday_end и review_sla_timezone)
+	ReviewSlaBusinessHours *bool `json:"review_sla_business_hours,omitempty"`
+
+	// ReviewSlaHours Срок ревью в часах для новых назначений, 0 - без срока
+	ReviewSlaHours *int `json:"review_sla_hours,omitempty"`
+
+	// ReviewSlaTimezone Часовой пояс рабочих часов в формате IANA
+	ReviewSlaTimezone *string `json:"review_sla_timezone,omitempty"`
+
+	// ReviewSlaWorkdayEnd Час конца рабочего дня, больше начала
+	ReviewSlaWorkdayEnd *int `json:"review_sla_workday_end,omitempty"`
+
+	// ReviewSlaWorkdayStart Час начала рабочего дня
+	ReviewSlaWorkdayStart *int              `json:"review_sla_workday_start,omitempty"`
+	ReviewerStrategy      *ReviewerStrategy `json:"reviewer_strategy,omitempty"`
+	TeamName              string            `json:"team_name"`
+}
+
+// Unavailability defines model for Unavailability.
+type Unavailability struct {
+	// EndsAt Не включительно, с этого момента пользователь снова выбирается ревьювером
+	EndsAt time.Time `json:"ends_at"`
+
+	// ExternalUid UID события календаря, из которого импортирован период
+	ExternalUid      *string   `json:"external_uid,omitempty"`
+	Reason           string    `json:"reason"`
+	StartsAt         time.Time `json:"starts_at"`
+	UnavailabilityId int64     `json:"unavailability_id"`
+	UserId           string    `json:"user_id"`
+}
+
 // User defines model for User.
 type User struct {
-	IsActive bool   `json:"is_active"`
+	// Expertise Теги экспертизы пользователя (go, sql, frontend, ...)
+	Expertise *[]string `json:"expertise,omitempty"`
+	IsActive  bool      `json:"is_active"`
+
+	// MaxOpenReviews Собственный лимит OPEN ревью пользователя, null - действует лимит команды
+	MaxOpenReviews *int `json:"max_open_reviews"`
+
+	// TeamName Основная команда, её настройки действуют для PR пользователя и его лимита ревью
 	TeamName string `json:"team_name"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+
+	// Teams Все команды пользователя, основная первой. Ревьювером он выбирается в любой из них
+	Teams *[]string `json:"teams,omitempty"`
+
+	// UnavailableUntil Конец текущего периода недоступности, null - пользователь доступен
+	UnavailableUntil *time.Time `json:"unavailable_until"`
+	UserId           string     `json:"user_id"`
+	Username         string     `json:"username"`
 }
 
 // TeamNameQuery defines model for TeamNameQuery.
@@ -121,107 +494,525 @@ type TeamNameQuery = string
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery = string
 
+// PostOwnershipUploadJSONBody defines parameters for PostOwnershipUpload.
+type PostOwnershipUploadJSONBody struct {
+	// Content Текст документа CODEOWNERS, при совпадении нескольких правил действует последнее
+	Content string `json:"content"`
+}
+
+// PostPullRequestAddReviewerJSONBody defines parameters for PostPullRequestAddReviewer.
+type PostPullRequestAddReviewerJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+
+	// UserId Ревьювер, выбранный вручную
+	UserId *string `json:"user_id,omitempty"`
+}
+
+// PostPullRequestCloseJSONBody defines parameters for PostPullRequestClose.
+type PostPullRequestCloseJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
 // PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
 type PostPullRequestCreateJSONBody struct {
-	AuthorId        string `json:"author_id"`
+	AuthorId string `json:"author_id"`
+
+	// ChangedPaths Изменённые файлы; сначала назначаются владельцы этих путей по правилам /ownership/upload (не больше max_reviewers команды, приоритет у правил, идущих раньше)
+	ChangedPaths *[]string `json:"changed_paths,omitempty"`
+
+	// Draft Создать PR в статусе DRAFT без ревьюверов. Ревьюверы назначаются в /pullRequest/ready,
+	// поэтому changed_paths, required_tags и reviewers передаются туда
+	Draft           *bool  `json:"draft,omitempty"`
 	PullRequestId   string `json:"pull_request_id"`
 	PullRequestName string `json:"pull_request_name"`
+
+	// RequiredTags Нужная экспертиза; предпочитаются участники команды, чьи теги покрывают запрос
+	RequiredTags *[]string `json:"required_tags,omitempty"`
+
+	// Reviewers Ревьюверы, выбранные вручную, вместо автоматического выбора.
+	// Каждый должен быть активным, не автором, из команды автора или её резервных команд,
+	// не больше max_reviewers команды
+	Reviewers *[]string `json:"reviewers,omitempty"`
+}
+
+// GetPullRequestHistoryParams defines parameters for GetPullRequestHistory.
+type GetPullRequestHistoryParams struct {
+	PullRequestId string `form:"pull_request_id" json:"pull_request_id"`
+}
+
+// GetPullRequestListParams defines parameters for GetPullRequestList.
+type GetPullRequestListParams struct {
+	// Status Один или несколько статусов (status=OPEN&status=DRAFT)
+	Status     *[]GetPullRequestListParamsStatusItem `form:"status,omitempty" json:"status,omitempty"`
+	AuthorId   *string                               `form:"author_id,omitempty" json:"author_id,omitempty"`
+	ReviewerId *string                               `form:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
+
+	// TeamName Команда автора
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// CreatedFrom Нижняя граница created_at включительно
+	CreatedFrom *time.Time `form:"created_from,omitempty" json:"created_from,omitempty"`
+
+	// CreatedTo Верхняя граница created_at не включительно
+	CreatedTo *time.Time `form:"created_to,omitempty" json:"created_to,omitempty"`
+	Limit     *int       `form:"limit,omitempty" json:"limit,omitempty"`
+	Cursor    *string    `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
+// GetPullRequestListParamsStatusItem defines parameters for GetPullRequestList.
+type GetPullRequestListParamsStatusItem string
+
 // PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
 type PostPullRequestMergeJSONBody struct {
+	// Override Смержить PR, не удовлетворяющий merge-политике
+	Override      *bool  `json:"override,omitempty"`
 	PullRequestId string `json:"pull_request_id"`
 }
 
+// PostPullRequestReadyJSONBody defines parameters for PostPullRequestReady.
+type PostPullRequestReadyJSONBody struct {
+	// ChangedPaths См. /pullRequest/create
+	ChangedPaths  *[]string `json:"changed_paths,omitempty"`
+	PullRequestId string    `json:"pull_request_id"`
+
+	// RequiredTags См. /pullRequest/create
+	RequiredTags *[]string `json:"required_tags,omitempty"`
+
+	// Reviewers См. /pullRequest/create
+	Reviewers *[]string `json:"reviewers,omitempty"`
+}
+
 // PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
 type PostPullRequestReassignJSONBody struct {
-	OldUserId     string `json:"old_user_id"`
+	// NewUserId Ревьювер, выбранный вручную
+	NewUserId     *string `json:"new_user_id,omitempty"`
+	OldUserId     string  `json:"old_user_id"`
+	PullRequestId string  `json:"pull_request_id"`
+}
+
+// PostPullRequestRemoveReviewerJSONBody defines parameters for PostPullRequestRemoveReviewer.
+type PostPullRequestRemoveReviewerJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+	UserId        string `json:"user_id"`
+}
+
+// PostPullRequestReopenJSONBody defines parameters for PostPullRequestReopen.
+type PostPullRequestReopenJSONBody struct {
 	PullRequestId string `json:"pull_request_id"`
 }
 
+// GetPullRequestSearchParams defines parameters for GetPullRequestSearch.
+type GetPullRequestSearchParams struct {
+	// Q Текст запроса
+	Q string `form:"q" json:"q"`
+
+	// Status Один или несколько статусов (status=OPEN&status=DRAFT)
+	Status     *[]GetPullRequestSearchParamsStatusItem `form:"status,omitempty" json:"status,omitempty"`
+	AuthorId   *string                                 `form:"author_id,omitempty" json:"author_id,omitempty"`
+	ReviewerId *string                                 `form:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
+
+	// TeamName Команда автора
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+	Limit    *int    `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetPullRequestSearchParamsStatusItem defines parameters for GetPullRequestSearch.
+type GetPullRequestSearchParamsStatusItem string
+
+// PostPullRequestSubmitReviewJSONBody defines parameters for PostPullRequestSubmitReview.
+type PostPullRequestSubmitReviewJSONBody struct {
+	Comment       *string       `json:"comment,omitempty"`
+	PullRequestId string        `json:"pull_request_id"`
+	Verdict       ReviewVerdict `json:"verdict"`
+}
+
+// PostPullRequestUpdateJSONBody defines parameters for PostPullRequestUpdate.
+type PostPullRequestUpdateJSONBody struct {
+	Description     *string `json:"description,omitempty"`
+	ExternalId      *string `json:"external_id,omitempty"`
+	PullRequestId   string  `json:"pull_request_id"`
+	PullRequestName *string `json:"pull_request_name,omitempty"`
+	SourceBranch    *string `json:"source_branch,omitempty"`
+	TargetBranch    *string `json:"target_branch,omitempty"`
+	Url             *string `json:"url,omitempty"`
+}
+
+// PostTeamAddMemberJSONBody defines parameters for PostTeamAddMember.
+type PostTeamAddMemberJSONBody struct {
+	Members  []TeamMember `json:"members"`
+	TeamName string       `json:"team_name"`
+}
+
+// PostTeamDeleteJSONBody defines parameters for PostTeamDelete.
+type PostTeamDeleteJSONBody struct {
+	// MoveMembersTo Команда, в которую переводятся участники удаляемой
+	MoveMembersTo *string `json:"move_members_to,omitempty"`
+	TeamName      string  `json:"team_name"`
+}
+
 // GetTeamGetParams defines parameters for GetTeamGet.
 type GetTeamGetParams struct {
 	// TeamName Уникальное имя команды
 	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
+// GetTeamListParams defines parameters for GetTeamList.
+type GetTeamListParams struct {
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// PostTeamRemoveMemberJSONBody defines parameters for PostTeamRemoveMember.
+type PostTeamRemoveMemberJSONBody struct {
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+}
+
+// PostTeamRenameJSONBody defines parameters for PostTeamRename.
+type PostTeamRenameJSONBody struct {
+	NewTeamName string `json:"new_team_name"`
+	TeamName    string `json:"team_name"`
+}
+
+// PostUsersAddUnavailabilityJSONBody defines parameters for PostUsersAddUnavailability.
+type PostUsersAddUnavailabilityJSONBody struct {
+	// EndsAt Не включительно
+	EndsAt   time.Time `json:"ends_at"`
+	Reason   *string   `json:"reason,omitempty"`
+	StartsAt time.Time `json:"starts_at"`
+	UserId   string    `json:"user_id"`
+}
+
+// PostUsersBulkSetIsActiveJSONBody defines parameters for PostUsersBulkSetIsActive.
+type PostUsersBulkSetIsActiveJSONBody struct {
+	IsActive bool      `json:"is_active"`
+	TeamName *string   `json:"team_name,omitempty"`
+	UserIds  *[]string `json:"user_ids,omitempty"`
+}
+
+// PostUsersDeactivateAndReassignJSONBody defines parameters for PostUsersDeactivateAndReassign.
+type PostUsersDeactivateAndReassignJSONBody struct {
+	UserId string `json:"user_id"`
+}
+
+// GetUsersGetExpertiseParams defines parameters for GetUsersGetExpertise.
+type GetUsersGetExpertiseParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
 // GetUsersGetReviewParams defines parameters for GetUsersGetReview.
 type GetUsersGetReviewParams struct {
 	// UserId Идентификатор пользователя
 	UserId UserIdQuery `form:"user_id" json:"user_id"`
 }
 
+// PostUsersImportUnavailabilityJSONBody defines parameters for PostUsersImportUnavailability.
+type PostUsersImportUnavailabilityJSONBody struct {
+	// Calendar Содержимое .ics файла
+	Calendar string `json:"calendar"`
+	UserId   string `json:"user_id"`
+}
+
+// PostUsersRemoveUnavailabilityJSONBody defines parameters for PostUsersRemoveUnavailability.
+type PostUsersRemoveUnavailabilityJSONBody struct {
+	UnavailabilityId int64  `json:"unavailability_id"`
+	UserId           string `json:"user_id"`
+}
+
+// PostUsersSetExpertiseJSONBody defines parameters for PostUsersSetExpertise.
+type PostUsersSetExpertiseJSONBody struct {
+	Tags   []string `json:"tags"`
+	UserId string   `json:"user_id"`
+}
+
 // PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
 type PostUsersSetIsActiveJSONBody struct {
 	IsActive bool   `json:"is_active"`
 	UserId   string `json:"user_id"`
 }
 
+// PostUsersSetMaxOpenReviewsJSONBody defines parameters for PostUsersSetMaxOpenReviews.
+type PostUsersSetMaxOpenReviewsJSONBody struct {
+	MaxOpenReviews *int   `json:"max_open_reviews"`
+	UserId         string `json:"user_id"`
+}
+
+// PostOwnershipUploadJSONRequestBody defines body for PostOwnershipUpload for application/json ContentType.
+type PostOwnershipUploadJSONRequestBody PostOwnershipUploadJSONBody
+
+// PostPullRequestAddReviewerJSONRequestBody defines body for PostPullRequestAddReviewer for application/json ContentType.
+type PostPullRequestAddReviewerJSONRequestBody PostPullRequestAddReviewerJSONBody
+
+// PostPullRequestCloseJSONRequestBody defines body for PostPullRequestClose for application/json ContentType.
+type PostPullRequestCloseJSONRequestBody PostPullRequestCloseJSONBody
+
 // PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
 type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
 
 // PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
 type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
 
+// PostPullRequestReadyJSONRequestBody defines body for PostPullRequestReady for application/json ContentType.
+type PostPullRequestReadyJSONRequestBody PostPullRequestReadyJSONBody
+
 // PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
 type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
 
+// PostPullRequestRemoveReviewerJSONRequestBody defines body for PostPullRequestRemoveReviewer for application/json ContentType.
+type PostPullRequestRemoveReviewerJSONRequestBody PostPullRequestRemoveReviewerJSONBody
+
+// PostPullRequestReopenJSONRequestBody defines body for PostPullRequestReopen for application/json ContentType.
+type PostPullRequestReopenJSONRequestBody PostPullRequestReopenJSONBody
+
+// PostPullRequestSubmitReviewJSONRequestBody defines body for PostPullRequestSubmitReview for application/json ContentType.
+type PostPullRequestSubmitReviewJSONRequestBody PostPullRequestSubmitReviewJSONBody
+
+// PostPullRequestUpdateJSONRequestBody defines body for PostPullRequestUpdate for application/json ContentType.
+type PostPullRequestUpdateJSONRequestBody PostPullRequestUpdateJSONBody
+
 // PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
 type PostTeamAddJSONRequestBody = Team
 
+// PostTeamAddMemberJSONRequestBody defines body for PostTeamAddMember for application/json ContentType.
+type PostTeamAddMemberJSONRequestBody PostTeamAddMemberJSONBody
+
+// PostTeamDeleteJSONRequestBody defines body for PostTeamDelete for application/json ContentType.
+type PostTeamDeleteJSONRequestBody PostTeamDeleteJSONBody
+
+// PostTeamRemoveMemberJSONRequestBody defines body for PostTeamRemoveMember for application/json ContentType.
+type PostTeamRemoveMemberJSONRequestBody PostTeamRemoveMemberJSONBody
+
+// PostTeamRenameJSONRequestBody defines body for PostTeamRename for application/json ContentType.
+type PostTeamRenameJSONRequestBody PostTeamRenameJSONBody
+
+// PostTeamUpdateJSONRequestBody defines body for PostTeamUpdate for application/json ContentType.
+type PostTeamUpdateJSONRequestBody = TeamUpdate
+
+// PostUsersAddUnavailabilityJSONRequestBody defines body for PostUsersAddUnavailability for application/json ContentType.
+type PostUsersAddUnavailabilityJSONRequestBody PostUsersAddUnavailabilityJSONBody
+
+// PostUsersBulkSetIsActiveJSONRequestBody defines body for PostUsersBulkSetIsActive for application/json ContentType.
+type PostUsersBulkSetIsActiveJSONRequestBody PostUsersBulkSetIsActiveJSONBody
+
+// PostUsersDeactivateAndReassignJSONRequestBody defines body for PostUsersDeactivateAndReassign for application/json ContentType.
+type PostUsersDeactivateAndReassignJSONRequestBody PostUsersDeactivateAndReassignJSONBody
+
+// PostUsersImportUnavailabilityJSONRequestBody defines body for PostUsersImportUnavailability for application/json ContentType.
+type PostUsersImportUnavailabilityJSONRequestBody PostUsersImportUnavailabilityJSONBody
+
+// PostUsersRemoveUnavailabilityJSONRequestBody defines body for PostUsersRemoveUnavailability for application/json ContentType.
+type PostUsersRemoveUnavailabilityJSONRequestBody PostUsersRemoveUnavailabilityJSONBody
+
+// PostUsersSetExpertiseJSONRequestBody defines body for PostUsersSetExpertise for application/json ContentType.
+type PostUsersSetExpertiseJSONRequestBody PostUsersSetExpertiseJSONBody
+
 // PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
 type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
 
+// PostUsersSetMaxOpenReviewsJSONRequestBody defines body for PostUsersSetMaxOpenReviews for application/json ContentType.
+type PostUsersSetMaxOpenReviewsJSONRequestBody PostUsersSetMaxOpenReviewsJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// Получить текущие правила владения кодом
+	// (GET /ownership/get)
+	GetOwnershipGet(w http.ResponseWriter, r *http.Request)
+	// Загрузить правила владения кодом в формате CODEOWNERS (заменяют текущие)
+	// (POST /ownership/upload)
+	PostOwnershipUpload(w http.ResponseWriter, r *http.Request)
+	// Добавить ревьювера в OPEN PR
+	// (POST /pullRequest/addReviewer)
+	PostPullRequestAddReviewer(w http.ResponseWriter, r *http.Request)
+	// Закрыть PR без merge
+	// (POST /pullRequest/close)
+	PostPullRequestClose(w http.ResponseWriter, r *http.Request)
+	// Создать PR и автоматически назначить ревьюверов из команды автора (и её резервных команд) по стратегии и лимитам команды
 	// (POST /pullRequest/create)
 	PostPullRequestCreate(w http.ResponseWriter, r *http.Request)
+	// Получить историю ревьюверов PR
+	// (GET /pullRequest/history)
+	GetPullRequestHistory(w http.ResponseWriter, r *http.Request, params GetPullRequestHistoryParams)
+	// Список PR с фильтрами и постраничной выдачей
+	// (GET /pullRequest/list)
+	GetPullRequestList(w http.ResponseWriter, r *http.Request, params GetPullRequestListParams)
 	// Пометить PR как MERGED (идемпотентная операция)
 	// (POST /pullRequest/merge)
 	PostPullRequestMerge(w http.ResponseWriter, r *http.Request)
+	// Перевести DRAFT PR в OPEN и назначить ревьюверов
+	// (POST /pullRequest/ready)
+	PostPullRequestReady(w http.ResponseWriter, r *http.Request)
 	// Переназначить конкретного ревьювера на другого из его команды
 	// (POST /pullRequest/reassign)
 	PostPullRequestReassign(w http.ResponseWriter, r *http.Request)
+	// Снять ревьювера с OPEN PR без замены
+	// (POST /pullRequest/removeReviewer)
+	PostPullRequestRemoveReviewer(w http.ResponseWriter, r *http.Request)
+	// Переоткрыть закрытый PR
+	// (POST /pullRequest/reopen)
+	PostPullRequestReopen(w http.ResponseWriter, r *http.Request)
+	// Полнотекстовый поиск PR по названию и описанию
+	// (GET /pullRequest/search)
+	GetPullRequestSearch(w http.ResponseWriter, r *http.Request, params GetPullRequestSearchParams)
+	// Отправить вердикт ревью
+	// (POST /pullRequest/submitReview)
+	PostPullRequestSubmitReview(w http.ResponseWriter, r *http.Request)
+	// Обновить название и метаданные OPEN PR
+	// (POST /pullRequest/update)
+	PostPullRequestUpdate(w http.ResponseWriter, r *http.Request)
 	// Получить статистику назначений PR по пользователям
 	// (GET /stats/assignments)
 	GetStatsAssignments(w http.ResponseWriter, r *http.Request)
 	// Создать команду с участниками (создаёт/обновляет пользователей)
 	// (POST /team/add)
 	PostTeamAdd(w http.ResponseWriter, r *http.Request)
+	// Добавить участников в существующую команду
+	// (POST /team/addMember)
+	PostTeamAddMember(w http.ResponseWriter, r *http.Request)
+	// Удалить команду
+	// (POST /team/delete)
+	PostTeamDelete(w http.ResponseWriter, r *http.Request)
 	// Получить команду с участниками
 	// (GET /team/get)
 	GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams)
+	// Список команд с числом участников и нагрузкой
+	// (GET /team/list)
+	GetTeamList(w http.ResponseWriter, r *http.Request, params GetTeamListParams)
+	// Исключить пользователя из команды
+	// (POST /team/removeMember)
+	PostTeamRemoveMember(w http.ResponseWriter, r *http.Request)
+	// Переименовать команду
+	// (POST /team/rename)
+	PostTeamRename(w http.ResponseWriter, r *http.Request)
+	// Изменить настройки команды (не переданные поля не меняются)
+	// (POST /team/update)
+	PostTeamUpdate(w http.ResponseWriter, r *http.Request)
+	// Добавить период недоступности пользователя
+	// (POST /users/addUnavailability)
+	PostUsersAddUnavailability(w http.ResponseWriter, r *http.Request)
+	// Установить флаг активности нескольким пользователям
+	// (POST /users/bulkSetIsActive)
+	PostUsersBulkSetIsActive(w http.ResponseWriter, r *http.Request)
+	// Деактивировать пользователя и переназначить его OPEN ревью
+	// (POST /users/deactivateAndReassign)
+	PostUsersDeactivateAndReassign(w http.ResponseWriter, r *http.Request)
+	// Получить теги экспертизы пользователя
+	// (GET /users/getExpertise)
+	GetUsersGetExpertise(w http.ResponseWriter, r *http.Request, params GetUsersGetExpertiseParams)
 	// Получить PR'ы, где пользователь назначен ревьювером
 	// (GET /users/getReview)
 	GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams)
+	// Импортировать периоды недоступности из iCalendar
+	// (POST /users/importUnavailability)
+	PostUsersImportUnavailability(w http.ResponseWriter, r *http.Request)
+	// Удалить период недоступности пользователя
+	// (POST /users/removeUnavailability)
+	PostUsersRemoveUnavailability(w http.ResponseWriter, r *http.Request)
+	// Задать теги экспертизы пользователя (заменяют текущие)
+	// (POST /users/setExpertise)
+	PostUsersSetExpertise(w http.ResponseWriter, r *http.Request)
 	// Установить флаг активности пользователя
 	// (POST /users/setIsActive)
 	PostUsersSetIsActive(w http.ResponseWriter, r *http.Request)
+	// Задать лимит OPEN ревью пользователя
+	// (POST /users/setMaxOpenReviews)
+	PostUsersSetMaxOpenReviews(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
-// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+// Получить текущие правила владения кодом
+// (GET /ownership/get)
+func (_ Unimplemented) GetOwnershipGet(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Загрузить правила владения кодом в формате CODEOWNERS (заменяют текущие)
+// (POST /ownership/upload)
+func (_ Unimplemented) PostOwnershipUpload(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Добавить ревьювера в OPEN PR
+// (POST /pullRequest/addReviewer)
+func (_ Unimplemented) PostPullRequestAddReviewer(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Закрыть PR без merge
+// (POST /pullRequest/close)
+func (_ Unimplemented) PostPullRequestClose(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать PR и автоматически назначить ревьюверов из команды автора (и её резервных команд) по стратегии и лимитам команды
 // (POST /pullRequest/create)
 func (_ Unimplemented) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Получить историю ревьюверов PR
+// (GET /pullRequest/history)
+func (_ Unimplemented) GetPullRequestHistory(w http.ResponseWriter, r *http.Request, params GetPullRequestHistoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Список PR с фильтрами и постраничной выдачей
+// (GET /pullRequest/list)
+func (_ Unimplemented) GetPullRequestList(w http.ResponseWriter, r *http.Request, params GetPullRequestListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Пометить PR как MERGED (идемпотентная операция)
 // (POST /pullRequest/merge)
 func (_ Unimplemented) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Перевести DRAFT PR в OPEN и назначить ревьюверов
+// (POST /pullRequest/ready)
+func (_ Unimplemented) PostPullRequestReady(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Переназначить конкретного ревьювера на другого из его команды
 // (POST /pullRequest/reassign)
 func (_ Unimplemented) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Снять ревьювера с OPEN PR без замены
+// (POST /pullRequest/removeReviewer)
+func (_ Unimplemented) PostPullRequestRemoveReviewer(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переоткрыть закрытый PR
+// (POST /pullRequest/reopen)
+func (_ Unimplemented) PostPullRequestReopen(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Полнотекстовый поиск PR по названию и описанию
+// (GET /pullRequest/search)
+func (_ Unimplemented) GetPullRequestSearch(w http.ResponseWriter, r *http.Request, params GetPullRequestSearchParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отправить вердикт ревью
+// (POST /pullRequest/submitReview)
+func (_ Unimplemented) PostPullRequestSubmitReview(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Обновить название и метаданные OPEN PR
+// (POST /pullRequest/update)
+func (_ Unimplemented) PostPullRequestUpdate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Получить статистику назначений PR по пользователям
 // (GET /stats/assignments)
 func (_ Unimplemented) GetStatsAssignments(w http.ResponseWriter, r *http.Request) {
@@ -234,24 +1025,108 @@ func (_ Unimplemented) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Получить команду с участниками
-// (GET /team/get)
-func (_ Unimplemented) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
+// Добавить участников в существующую команду
+// (POST /team/addMember)
+func (_ Unimplemented) PostTeamAddMember(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Получить PR'ы, где пользователь назначен ревьювером
-// (GET /users/getReview)
-func (_ Unimplemented) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
+// Удалить команду
+// (POST /team/delete)
+func (_ Unimplemented) PostTeamDelete(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Установить флаг активности пользователя
-// (POST /users/setIsActive)
+// Получить команду с участниками
+// (GET /team/get)
+func (_ Unimplemented) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Список команд с числом участников и нагрузкой
+// (GET /team/list)
+func (_ Unimplemented) GetTeamList(w http.ResponseWriter, r *http.Request, params GetTeamListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Исключить пользователя из команды
+// (POST /team/removeMember)
+func (_ Unimplemented) PostTeamRemoveMember(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переименовать команду
+// (POST /team/rename)
+func (_ Unimplemented) PostTeamRename(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить настройки команды (не переданные поля не меняются)
+// (POST /team/update)
+func (_ Unimplemented) PostTeamUpdate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Добавить период недоступности пользователя
+// (POST /users/addUnavailability)
+func (_ Unimplemented) PostUsersAddUnavailability(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить флаг активности нескольким пользователям
+// (POST /users/bulkSetIsActive)
+func (_ Unimplemented) PostUsersBulkSetIsActive(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Деактивировать пользователя и переназначить его OPEN ревью
+// (POST /users/deactivateAndReassign)
+func (_ Unimplemented) PostUsersDeactivateAndReassign(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить теги экспертизы пользователя
+// (GET /users/getExpertise)
+func (_ Unimplemented) GetUsersGetExpertise(w http.ResponseWriter, r *http.Request, params GetUsersGetExpertiseParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить PR'ы, где пользователь назначен ревьювером
+// (GET /users/getReview)
+func (_ Unimplemented) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Импортировать периоды недоступности из iCalendar
+// (POST /users/importUnavailability)
+func (_ Unimplemented) PostUsersImportUnavailability(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить период недоступности пользователя
+// (POST /users/removeUnavailability)
+func (_ Unimplemented) PostUsersRemoveUnavailability(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать теги экспертизы пользователя (заменяют текущие)
+// (POST /users/setExpertise)
+func (_ Unimplemented) PostUsersSetExpertise(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить флаг активности пользователя
+// (POST /users/setIsActive)
 func (_ Unimplemented) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Задать лимит OPEN ревью пользователя
+// (POST /users/setMaxOpenReviews)
+func (_ Unimplemented) PostUsersSetMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -261,8 +1136,8 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
-// PostPullRequestCreate operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+// GetOwnershipGet operation middleware
+func (siw *ServerInterfaceWrapper) GetOwnershipGet(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -271,7 +1146,7 @@ func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestCreate(w, r)
+		siw.Handler.GetOwnershipGet(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -281,8 +1156,8 @@ func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// PostPullRequestMerge operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+// PostOwnershipUpload operation middleware
+func (siw *ServerInterfaceWrapper) PostOwnershipUpload(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -291,7 +1166,7 @@ func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestMerge(w, r)
+		siw.Handler.PostOwnershipUpload(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -301,8 +1176,8 @@ func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// PostPullRequestReassign operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+// PostPullRequestAddReviewer operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestAddReviewer(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -311,7 +1186,7 @@ func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestReassign(w, r)
+		siw.Handler.PostPullRequestAddReviewer(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -321,8 +1196,8 @@ func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// GetStatsAssignments operation middleware
-func (siw *ServerInterfaceWrapper) GetStatsAssignments(w http.ResponseWriter, r *http.Request) {
+// PostPullRequestClose operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestClose(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -331,7 +1206,7 @@ func (siw *ServerInterfaceWrapper) GetStatsAssignments(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStatsAssignments(w, r)
+		siw.Handler.PostPullRequestClose(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -341,11 +1216,17 @@ func (siw *ServerInterfaceWrapper) GetStatsAssignments(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// PostTeamAdd operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+// PostPullRequestCreate operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostTeamAdd(w, r)
+		siw.Handler.PostPullRequestCreate(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -355,8 +1236,8 @@ func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// GetTeamGet operation middleware
-func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
+// GetPullRequestHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestHistory(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -369,25 +1250,25 @@ func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Req
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTeamGetParams
+	var params GetPullRequestHistoryParams
 
-	// ------------- Required query parameter "team_name" -------------
+	// ------------- Required query parameter "pull_request_id" -------------
 
-	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("pull_request_id"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pull_request_id"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", r.URL.Query(), &params.PullRequestId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTeamGet(w, r, params)
+		siw.Handler.GetPullRequestHistory(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -397,8 +1278,8 @@ func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetUsersGetReview operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
+// GetPullRequestList operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestList(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -411,25 +1292,74 @@ func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *h
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUsersGetReviewParams
+	var params GetPullRequestListParams
 
-	// ------------- Required query parameter "user_id" -------------
+	// ------------- Optional query parameter "status" -------------
 
-	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+	// ------------- Optional query parameter "author_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "author_id", r.URL.Query(), &params.AuthorId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "author_id", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	// ------------- Optional query parameter "reviewer_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reviewer_id", r.URL.Query(), &params.ReviewerId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reviewer_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_from", r.URL.Query(), &params.CreatedFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_to", r.URL.Query(), &params.CreatedTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUsersGetReview(w, r, params)
+		siw.Handler.GetPullRequestList(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -439,8 +1369,8 @@ func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// PostUsersSetIsActive operation middleware
-func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+// PostPullRequestMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -449,7 +1379,7 @@ func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostUsersSetIsActive(w, r)
+		siw.Handler.PostPullRequestMerge(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -459,533 +1389,3387 @@ func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// PostPullRequestReady operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReady(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	ctx := r.Context()
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	r = r.WithContext(ctx)
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReady(w, r)
+	}))
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type RequiredParamError struct {
-	ParamName string
+	handler.ServeHTTP(w, r)
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+// PostPullRequestReassign operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	ctx := r.Context()
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	r = r.WithContext(ctx)
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReassign(w, r)
+	}))
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+	handler.ServeHTTP(w, r)
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+// PostPullRequestRemoveReviewer operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestRemoveReviewer(w http.ResponseWriter, r *http.Request) {
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	ctx := r.Context()
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	r = r.WithContext(ctx)
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestRemoveReviewer(w, r)
+	}))
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+// PostPullRequestReopen operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReopen(w http.ResponseWriter, r *http.Request) {
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+	ctx := r.Context()
 
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/stats/assignments", wrapper.GetStatsAssignments)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/team/get", wrapper.GetTeamGet)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
-	})
-
-	return r
-}
-
-type PostPullRequestCreateRequestObject struct {
-	Body *PostPullRequestCreateJSONRequestBody
-}
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
 
-type PostPullRequestCreateResponseObject interface {
-	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type PostPullRequestCreate201JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReopen(w, r)
+	}))
 
-func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type PostPullRequestCreate400JSONResponse ErrorResponse
+// GetPullRequestSearch operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestSearch(w http.ResponseWriter, r *http.Request) {
 
-func (response PostPullRequestCreate400JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type PostPullRequestCreate404JSONResponse ErrorResponse
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
 
-func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx = context.WithValue(ctx, UserTokenScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type PostPullRequestCreate409JSONResponse ErrorResponse
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestSearchParams
 
-func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	// ------------- Required query parameter "q" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
 
-type PostPullRequestCreate500JSONResponse ErrorResponse
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
+	}
 
-func (response PostPullRequestCreate500JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "status" -------------
 
-type PostPullRequestMergeRequestObject struct {
-	Body *PostPullRequestMergeJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
 
-type PostPullRequestMergeResponseObject interface {
-	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "author_id" -------------
 
-type PostPullRequestMerge200JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "author_id", r.URL.Query(), &params.AuthorId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "author_id", Err: err})
+		return
+	}
 
-func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "reviewer_id" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "reviewer_id", r.URL.Query(), &params.ReviewerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reviewer_id", Err: err})
+		return
+	}
 
-type PostPullRequestMerge404JSONResponse ErrorResponse
+	// ------------- Optional query parameter "team_name" -------------
 
-func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type PostPullRequestMerge500JSONResponse ErrorResponse
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-func (response PostPullRequestMerge500JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestSearch(w, r, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostPullRequestReassignRequestObject struct {
-	Body *PostPullRequestReassignJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type PostPullRequestReassignResponseObject interface {
-	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
-}
+// PostPullRequestSubmitReview operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestSubmitReview(w http.ResponseWriter, r *http.Request) {
 
-type PostPullRequestReassign200JSONResponse struct {
-	Pr PullRequest `json:"pr"`
+	ctx := r.Context()
 
-	// ReplacedBy user_id нового ревьювера
-	ReplacedBy string `json:"replaced_by"`
-}
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
 
-func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, UserTokenScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type PostPullRequestReassign404JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestSubmitReview(w, r)
+	}))
 
-func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type PostPullRequestReassign409JSONResponse ErrorResponse
-
-func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+// PostPullRequestUpdate operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestUpdate(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type PostPullRequestReassign500JSONResponse ErrorResponse
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
 
-func (response PostPullRequestReassign500JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestUpdate(w, r)
+	}))
 
-type GetStatsAssignmentsRequestObject struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetStatsAssignmentsResponseObject interface {
-	VisitGetStatsAssignmentsResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type GetStatsAssignments200JSONResponse struct {
-	ByUser *[]AssignmentCountPerUser `json:"by_user,omitempty"`
-}
+// GetStatsAssignments operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsAssignments(w http.ResponseWriter, r *http.Request) {
 
-func (response GetStatsAssignments200JSONResponse) VisitGetStatsAssignmentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
 
-type GetStatsAssignments401JSONResponse ErrorResponse
+	r = r.WithContext(ctx)
 
-func (response GetStatsAssignments401JSONResponse) VisitGetStatsAssignmentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsAssignments(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostTeamAddRequestObject struct {
-	Body *PostTeamAddJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type PostTeamAddResponseObject interface {
-	VisitPostTeamAddResponse(w http.ResponseWriter) error
-}
+// PostTeamAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 
-type PostTeamAdd201JSONResponse struct {
-	Team *Team `json:"team,omitempty"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamAdd(w, r)
+	}))
 
-func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type PostTeamAdd400JSONResponse ErrorResponse
+// PostTeamAddMember operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAddMember(w http.ResponseWriter, r *http.Request) {
 
-func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
 
-type PostTeamAdd409JSONResponse ErrorResponse
+	r = r.WithContext(ctx)
 
-func (response PostTeamAdd409JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamAddMember(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostTeamAdd500JSONResponse ErrorResponse
+// PostTeamDelete operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamDelete(w http.ResponseWriter, r *http.Request) {
 
-func (response PostTeamAdd500JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
 
-type GetTeamGetRequestObject struct {
-	Params GetTeamGetParams
-}
+	r = r.WithContext(ctx)
 
-type GetTeamGetResponseObject interface {
-	VisitGetTeamGetResponse(w http.ResponseWriter) error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamDelete(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetTeamGet200JSONResponse Team
+// GetTeamGet operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
 
-func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type GetTeamGet404JSONResponse ErrorResponse
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
 
-func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx = context.WithValue(ctx, UserTokenScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type GetTeamGet500JSONResponse ErrorResponse
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetParams
 
-func (response GetTeamGet500JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Required query parameter "team_name" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
 
-type GetUsersGetReviewRequestObject struct {
-	Params GetUsersGetReviewParams
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetUsersGetReviewResponseObject interface {
-	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
+// GetTeamList operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, UserTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamListParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetUsersGetReview200JSONResponse struct {
-	PullRequests []PullRequestShort `json:"pull_requests"`
-	UserId       string             `json:"user_id"`
+// PostTeamRemoveMember operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamRemoveMember(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamRemoveMember(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostTeamRename operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamRename(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamRename(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetUsersGetReview500JSONResponse ErrorResponse
+// PostTeamUpdate operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamUpdate(w http.ResponseWriter, r *http.Request) {
 
-func (response GetUsersGetReview500JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamUpdate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostUsersSetIsActiveRequestObject struct {
-	Body *PostUsersSetIsActiveJSONRequestBody
+// PostUsersAddUnavailability operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersAddUnavailability(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersAddUnavailability(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostUsersSetIsActiveResponseObject interface {
-	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
+// PostUsersBulkSetIsActive operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersBulkSetIsActive(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersBulkSetIsActive(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostUsersSetIsActive200JSONResponse struct {
-	User *User `json:"user,omitempty"`
+// PostUsersDeactivateAndReassign operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersDeactivateAndReassign(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersDeactivateAndReassign(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetUsersGetExpertise operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetExpertise(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, UserTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetExpertiseParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersGetExpertise(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostUsersSetIsActive401JSONResponse ErrorResponse
+// GetUsersGetReview operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
 
-func (response PostUsersSetIsActive401JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	ctx = context.WithValue(ctx, UserTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetReviewParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersGetReview(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersImportUnavailability operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersImportUnavailability(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersImportUnavailability(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersRemoveUnavailability operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersRemoveUnavailability(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersRemoveUnavailability(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersSetExpertise operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetExpertise(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetExpertise(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersSetIsActive operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetIsActive(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersSetMaxOpenReviews operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetMaxOpenReviews(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/ownership/get", wrapper.GetOwnershipGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/ownership/upload", wrapper.PostOwnershipUpload)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/addReviewer", wrapper.PostPullRequestAddReviewer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/close", wrapper.PostPullRequestClose)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/history", wrapper.GetPullRequestHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/list", wrapper.GetPullRequestList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/ready", wrapper.PostPullRequestReady)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/removeReviewer", wrapper.PostPullRequestRemoveReviewer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/reopen", wrapper.PostPullRequestReopen)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/search", wrapper.GetPullRequestSearch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/submitReview", wrapper.PostPullRequestSubmitReview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/update", wrapper.PostPullRequestUpdate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/assignments", wrapper.GetStatsAssignments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/addMember", wrapper.PostTeamAddMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/delete", wrapper.PostTeamDelete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/get", wrapper.GetTeamGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/list", wrapper.GetTeamList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/removeMember", wrapper.PostTeamRemoveMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/rename", wrapper.PostTeamRename)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/update", wrapper.PostTeamUpdate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/addUnavailability", wrapper.PostUsersAddUnavailability)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/bulkSetIsActive", wrapper.PostUsersBulkSetIsActive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/deactivateAndReassign", wrapper.PostUsersDeactivateAndReassign)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/getExpertise", wrapper.GetUsersGetExpertise)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/importUnavailability", wrapper.PostUsersImportUnavailability)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/removeUnavailability", wrapper.PostUsersRemoveUnavailability)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setExpertise", wrapper.PostUsersSetExpertise)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setMaxOpenReviews", wrapper.PostUsersSetMaxOpenReviews)
+	})
+
+	return r
+}
+
+type GetOwnershipGetRequestObject struct {
+}
+
+type GetOwnershipGetResponseObject interface {
+	VisitGetOwnershipGetResponse(w http.ResponseWriter) error
+}
+
+type GetOwnershipGet200JSONResponse struct {
+	Rules []OwnershipRule `json:"rules"`
+}
+
+func (response GetOwnershipGet200JSONResponse) VisitGetOwnershipGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOwnershipGet404JSONResponse ErrorResponse
+
+func (response GetOwnershipGet404JSONResponse) VisitGetOwnershipGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOwnershipGet500JSONResponse ErrorResponse
+
+func (response GetOwnershipGet500JSONResponse) VisitGetOwnershipGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOwnershipUploadRequestObject struct {
+	Body *PostOwnershipUploadJSONRequestBody
+}
+
+type PostOwnershipUploadResponseObject interface {
+	VisitPostOwnershipUploadResponse(w http.ResponseWriter) error
+}
+
+type PostOwnershipUpload200JSONResponse struct {
+	Rules []OwnershipRule `json:"rules"`
+}
+
+func (response PostOwnershipUpload200JSONResponse) VisitPostOwnershipUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOwnershipUpload400JSONResponse ErrorResponse
+
+func (response PostOwnershipUpload400JSONResponse) VisitPostOwnershipUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOwnershipUpload404JSONResponse ErrorResponse
+
+func (response PostOwnershipUpload404JSONResponse) VisitPostOwnershipUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOwnershipUpload500JSONResponse ErrorResponse
+
+func (response PostOwnershipUpload500JSONResponse) VisitPostOwnershipUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewerRequestObject struct {
+	Body *PostPullRequestAddReviewerJSONRequestBody
+}
+
+type PostPullRequestAddReviewerResponseObject interface {
+	VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestAddReviewer200JSONResponse struct {
+	// Added user_id добавленного ревьювера
+	Added string      `json:"added"`
+	Pr    PullRequest `json:"pr"`
+}
+
+func (response PostPullRequestAddReviewer200JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer400JSONResponse ErrorResponse
+
+func (response PostPullRequestAddReviewer400JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer404JSONResponse ErrorResponse
+
+func (response PostPullRequestAddReviewer404JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer409JSONResponse ErrorResponse
+
+func (response PostPullRequestAddReviewer409JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer500JSONResponse ErrorResponse
+
+func (response PostPullRequestAddReviewer500JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCloseRequestObject struct {
+	Body *PostPullRequestCloseJSONRequestBody
+}
+
+type PostPullRequestCloseResponseObject interface {
+	VisitPostPullRequestCloseResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestClose200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestClose200JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestClose404JSONResponse ErrorResponse
+
+func (response PostPullRequestClose404JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestClose409JSONResponse ErrorResponse
+
+func (response PostPullRequestClose409JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestClose500JSONResponse ErrorResponse
+
+func (response PostPullRequestClose500JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreateRequestObject struct {
+	Body *PostPullRequestCreateJSONRequestBody
+}
+
+type PostPullRequestCreateResponseObject interface {
+	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestCreate201JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate400JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate400JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate404JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate409JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate500JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate500JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestHistoryRequestObject struct {
+	Params GetPullRequestHistoryParams
+}
+
+type GetPullRequestHistoryResponseObject interface {
+	VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequestHistory200JSONResponse struct {
+	Events        []ReviewerEvent `json:"events"`
+	PullRequestId string          `json:"pull_request_id"`
+}
+
+func (response GetPullRequestHistory200JSONResponse) VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestHistory404JSONResponse ErrorResponse
+
+func (response GetPullRequestHistory404JSONResponse) VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestHistory500JSONResponse ErrorResponse
+
+func (response GetPullRequestHistory500JSONResponse) VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestListRequestObject struct {
+	Params GetPullRequestListParams
+}
+
+type GetPullRequestListResponseObject interface {
+	VisitGetPullRequestListResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequestList200JSONResponse struct {
+	// NextCursor Курсор следующей страницы
+	NextCursor   *string            `json:"next_cursor,omitempty"`
+	PullRequests []PullRequestShort `json:"pull_requests"`
+}
+
+func (response GetPullRequestList200JSONResponse) VisitGetPullRequestListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestList400JSONResponse ErrorResponse
+
+func (response GetPullRequestList400JSONResponse) VisitGetPullRequestListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestList404JSONResponse ErrorResponse
+
+func (response GetPullRequestList404JSONResponse) VisitGetPullRequestListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestList500JSONResponse ErrorResponse
+
+func (response GetPullRequestList500JSONResponse) VisitGetPullRequestListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMergeRequestObject struct {
+	Body *PostPullRequestMergeJSONRequestBody
+}
+
+type PostPullRequestMergeResponseObject interface {
+	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestMerge200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge404JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge409JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge409JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge500JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge500JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReadyRequestObject struct {
+	Body *PostPullRequestReadyJSONRequestBody
+}
+
+type PostPullRequestReadyResponseObject interface {
+	VisitPostPullRequestReadyResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReady200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestReady200JSONResponse) VisitPostPullRequestReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReady400JSONResponse ErrorResponse
+
+func (response PostPullRequestReady400JSONResponse) VisitPostPullRequestReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReady404JSONResponse ErrorResponse
+
+func (response PostPullRequestReady404JSONResponse) VisitPostPullRequestReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReady409JSONResponse ErrorResponse
+
+func (response PostPullRequestReady409JSONResponse) VisitPostPullRequestReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReady500JSONResponse ErrorResponse
+
+func (response PostPullRequestReady500JSONResponse) VisitPostPullRequestReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassignRequestObject struct {
+	Body *PostPullRequestReassignJSONRequestBody
+}
+
+type PostPullRequestReassignResponseObject interface {
+	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReassign200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+
+	// ReplacedBy user_id нового ревьювера
+	ReplacedBy string `json:"replaced_by"`
+}
+
+func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign400JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign400JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign404JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign409JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign500JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign500JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewerRequestObject struct {
+	Body *PostPullRequestRemoveReviewerJSONRequestBody
+}
+
+type PostPullRequestRemoveReviewerResponseObject interface {
+	VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestRemoveReviewer200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestRemoveReviewer200JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewer400JSONResponse ErrorResponse
+
+func (response PostPullRequestRemoveReviewer400JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewer404JSONResponse ErrorResponse
+
+func (response PostPullRequestRemoveReviewer404JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewer409JSONResponse ErrorResponse
+
+func (response PostPullRequestRemoveReviewer409JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewer500JSONResponse ErrorResponse
+
+func (response PostPullRequestRemoveReviewer500JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopenRequestObject struct {
+	Body *PostPullRequestReopenJSONRequestBody
+}
+
+type PostPullRequestReopenResponseObject interface {
+	VisitPostPullRequestReopenResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReopen200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestReopen200JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopen404JSONResponse ErrorResponse
+
+func (response PostPullRequestReopen404JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopen409JSONResponse ErrorResponse
+
+func (response PostPullRequestReopen409JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopen500JSONResponse ErrorResponse
+
+func (response PostPullRequestReopen500JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestSearchRequestObject struct {
+	Params GetPullRequestSearchParams
+}
+
+type GetPullRequestSearchResponseObject interface {
+	VisitGetPullRequestSearchResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequestSearch200JSONResponse struct {
+	Results []PullRequestSearchHit `json:"results"`
+}
+
+func (response GetPullRequestSearch200JSONResponse) VisitGetPullRequestSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestSearch400JSONResponse ErrorResponse
+
+func (response GetPullRequestSearch400JSONResponse) VisitGetPullRequestSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestSearch404JSONResponse ErrorResponse
+
+func (response GetPullRequestSearch404JSONResponse) VisitGetPullRequestSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestSearch500JSONResponse ErrorResponse
+
+func (response GetPullRequestSearch500JSONResponse) VisitGetPullRequestSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestSubmitReviewRequestObject struct {
+	Body *PostPullRequestSubmitReviewJSONRequestBody
+}
+
+type PostPullRequestSubmitReviewResponseObject interface {
+	VisitPostPullRequestSubmitReviewResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestSubmitReview200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestSubmitReview200JSONResponse) VisitPostPullRequestSubmitReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestSubmitReview400JSONResponse ErrorResponse
+
+func (response PostPullRequestSubmitReview400JSONResponse) VisitPostPullRequestSubmitReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestSubmitReview403JSONResponse ErrorResponse
+
+func (response PostPullRequestSubmitReview403JSONResponse) VisitPostPullRequestSubmitReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestSubmitReview404JSONResponse ErrorResponse
+
+func (response PostPullRequestSubmitReview404JSONResponse) VisitPostPullRequestSubmitReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestSubmitReview409JSONResponse ErrorResponse
+
+func (response PostPullRequestSubmitReview409JSONResponse) VisitPostPullRequestSubmitReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestSubmitReview500JSONResponse ErrorResponse
+
+func (response PostPullRequestSubmitReview500JSONResponse) VisitPostPullRequestSubmitReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestUpdateRequestObject struct {
+	Body *PostPullRequestUpdateJSONRequestBody
+}
+
+type PostPullRequestUpdateResponseObject interface {
+	VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestUpdate200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestUpdate200JSONResponse) VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestUpdate400JSONResponse ErrorResponse
+
+func (response PostPullRequestUpdate400JSONResponse) VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestUpdate404JSONResponse ErrorResponse
+
+func (response PostPullRequestUpdate404JSONResponse) VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestUpdate409JSONResponse ErrorResponse
+
+func (response PostPullRequestUpdate409JSONResponse) VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestUpdate500JSONResponse ErrorResponse
+
+func (response PostPullRequestUpdate500JSONResponse) VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsAssignmentsRequestObject struct {
+}
+
+type GetStatsAssignmentsResponseObject interface {
+	VisitGetStatsAssignmentsResponse(w http.ResponseWriter) error
+}
+
+type GetStatsAssignments200JSONResponse struct {
+	ByTeam *[]AssignmentCountPerTeam `json:"by_team,omitempty"`
+	ByUser *[]AssignmentCountPerUser `json:"by_user,omitempty"`
+}
+
+func (response GetStatsAssignments200JSONResponse) VisitGetStatsAssignmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsAssignments401JSONResponse ErrorResponse
+
+func (response GetStatsAssignments401JSONResponse) VisitGetStatsAssignmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddRequestObject struct {
+	Body *PostTeamAddJSONRequestBody
+}
+
+type PostTeamAddResponseObject interface {
+	VisitPostTeamAddResponse(w http.ResponseWriter) error
+}
+
+type PostTeamAdd201JSONResponse struct {
+	Team *Team `json:"team,omitempty"`
+}
+
+func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd400JSONResponse ErrorResponse
+
+func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd409JSONResponse ErrorResponse
+
+func (response PostTeamAdd409JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd500JSONResponse ErrorResponse
+
+func (response PostTeamAdd500JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddMemberRequestObject struct {
+	Body *PostTeamAddMemberJSONRequestBody
+}
+
+type PostTeamAddMemberResponseObject interface {
+	VisitPostTeamAddMemberResponse(w http.ResponseWriter) error
+}
+
+type PostTeamAddMember200JSONResponse struct {
+	Team Team `json:"team"`
+}
+
+func (response PostTeamAddMember200JSONResponse) VisitPostTeamAddMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddMember400JSONResponse ErrorResponse
+
+func (response PostTeamAddMember400JSONResponse) VisitPostTeamAddMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddMember401JSONResponse ErrorResponse
+
+func (response PostTeamAddMember401JSONResponse) VisitPostTeamAddMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddMember404JSONResponse ErrorResponse
+
+func (response PostTeamAddMember404JSONResponse) VisitPostTeamAddMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddMember500JSONResponse ErrorResponse
+
+func (response PostTeamAddMember500JSONResponse) VisitPostTeamAddMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamDeleteRequestObject struct {
+	Body *PostTeamDeleteJSONRequestBody
+}
+
+type PostTeamDeleteResponseObject interface {
+	VisitPostTeamDeleteResponse(w http.ResponseWriter) error
+}
+
+type PostTeamDelete200JSONResponse struct {
+	MoveMembersTo *string `json:"move_members_to"`
+
+	// MovedMembers user_id переведённых участников
+	MovedMembers []string `json:"moved_members"`
+	TeamName     string   `json:"team_name"`
+}
+
+func (response PostTeamDelete200JSONResponse) VisitPostTeamDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamDelete400JSONResponse ErrorResponse
+
+func (response PostTeamDelete400JSONResponse) VisitPostTeamDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamDelete401JSONResponse ErrorResponse
+
+func (response PostTeamDelete401JSONResponse) VisitPostTeamDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamDelete404JSONResponse ErrorResponse
+
+func (response PostTeamDelete404JSONResponse) VisitPostTeamDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamDelete409JSONResponse TeamChangeBlocked
+
+func (response PostTeamDelete409JSONResponse) VisitPostTeamDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamDelete500JSONResponse ErrorResponse
+
+func (response PostTeamDelete500JSONResponse) VisitPostTeamDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGetRequestObject struct {
+	Params GetTeamGetParams
+}
+
+type GetTeamGetResponseObject interface {
+	VisitGetTeamGetResponse(w http.ResponseWriter) error
+}
+
+type GetTeamGet200JSONResponse Team
+
+func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet404JSONResponse ErrorResponse
+
+func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet500JSONResponse ErrorResponse
+
+func (response GetTeamGet500JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamListRequestObject struct {
+	Params GetTeamListParams
+}
+
+type GetTeamListResponseObject interface {
+	VisitGetTeamListResponse(w http.ResponseWriter) error
+}
+
+type GetTeamList200JSONResponse struct {
+	// NextCursor Курсор следующей страницы
+	NextCursor *string       `json:"next_cursor,omitempty"`
+	Teams      []TeamSummary `json:"teams"`
+}
+
+func (response GetTeamList200JSONResponse) VisitGetTeamListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamList400JSONResponse ErrorResponse
+
+func (response GetTeamList400JSONResponse) VisitGetTeamListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamList500JSONResponse ErrorResponse
+
+func (response GetTeamList500JSONResponse) VisitGetTeamListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveMemberRequestObject struct {
+	Body *PostTeamRemoveMemberJSONRequestBody
+}
+
+type PostTeamRemoveMemberResponseObject interface {
+	VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error
+}
+
+type PostTeamRemoveMember200JSONResponse struct {
+	Team Team `json:"team"`
+}
+
+func (response PostTeamRemoveMember200JSONResponse) VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveMember400JSONResponse ErrorResponse
+
+func (response PostTeamRemoveMember400JSONResponse) VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveMember401JSONResponse ErrorResponse
+
+func (response PostTeamRemoveMember401JSONResponse) VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveMember404JSONResponse ErrorResponse
+
+func (response PostTeamRemoveMember404JSONResponse) VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveMember409JSONResponse TeamChangeBlocked
+
+func (response PostTeamRemoveMember409JSONResponse) VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveMember500JSONResponse ErrorResponse
+
+func (response PostTeamRemoveMember500JSONResponse) VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRenameRequestObject struct {
+	Body *PostTeamRenameJSONRequestBody
+}
+
+type PostTeamRenameResponseObject interface {
+	VisitPostTeamRenameResponse(w http.ResponseWriter) error
+}
+
+type PostTeamRename200JSONResponse struct {
+	Team Team `json:"team"`
+}
+
+func (response PostTeamRename200JSONResponse) VisitPostTeamRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRename400JSONResponse ErrorResponse
+
+func (response PostTeamRename400JSONResponse) VisitPostTeamRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRename401JSONResponse ErrorResponse
+
+func (response PostTeamRename401JSONResponse) VisitPostTeamRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRename404JSONResponse ErrorResponse
+
+func (response PostTeamRename404JSONResponse) VisitPostTeamRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRename409JSONResponse ErrorResponse
+
+func (response PostTeamRename409JSONResponse) VisitPostTeamRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRename500JSONResponse ErrorResponse
+
+func (response PostTeamRename500JSONResponse) VisitPostTeamRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamUpdateRequestObject struct {
+	Body *PostTeamUpdateJSONRequestBody
+}
+
+type PostTeamUpdateResponseObject interface {
+	VisitPostTeamUpdateResponse(w http.ResponseWriter) error
+}
+
+type PostTeamUpdate200JSONResponse struct {
+	Team *Team `json:"team,omitempty"`
+}
+
+func (response PostTeamUpdate200JSONResponse) VisitPostTeamUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamUpdate400JSONResponse ErrorResponse
+
+func (response PostTeamUpdate400JSONResponse) VisitPostTeamUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamUpdate401JSONResponse ErrorResponse
+
+func (response PostTeamUpdate401JSONResponse) VisitPostTeamUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamUpdate404JSONResponse ErrorResponse
+
+func (response PostTeamUpdate404JSONResponse) VisitPostTeamUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamUpdate500JSONResponse ErrorResponse
+
+func (response PostTeamUpdate500JSONResponse) VisitPostTeamUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAddUnavailabilityRequestObject struct {
+	Body *PostUsersAddUnavailabilityJSONRequestBody
+}
+
+type PostUsersAddUnavailabilityResponseObject interface {
+	VisitPostUsersAddUnavailabilityResponse(w http.ResponseWriter) error
+}
+
+type PostUsersAddUnavailability201JSONResponse struct {
+	Unavailability Unavailability `json:"unavailability"`
+}
+
+func (response PostUsersAddUnavailability201JSONResponse) VisitPostUsersAddUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAddUnavailability400JSONResponse ErrorResponse
+
+func (response PostUsersAddUnavailability400JSONResponse) VisitPostUsersAddUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAddUnavailability401JSONResponse ErrorResponse
+
+func (response PostUsersAddUnavailability401JSONResponse) VisitPostUsersAddUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAddUnavailability404JSONResponse ErrorResponse
+
+func (response PostUsersAddUnavailability404JSONResponse) VisitPostUsersAddUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAddUnavailability500JSONResponse ErrorResponse
+
+func (response PostUsersAddUnavailability500JSONResponse) VisitPostUsersAddUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersBulkSetIsActiveRequestObject struct {
+	Body *PostUsersBulkSetIsActiveJSONRequestBody
+}
+
+type PostUsersBulkSetIsActiveResponseObject interface {
+	VisitPostUsersBulkSetIsActiveResponse(w http.ResponseWriter) error
+}
+
+type PostUsersBulkSetIsActive200JSONResponse struct {
+	// NotFound user_ids, для которых пользователь не найден
+	NotFound []string `json:"not_found"`
+	Users    []User   `json:"users"`
+}
+
+func (response PostUsersBulkSetIsActive200JSONResponse) VisitPostUsersBulkSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersBulkSetIsActive400JSONResponse ErrorResponse
+
+func (response PostUsersBulkSetIsActive400JSONResponse) VisitPostUsersBulkSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersBulkSetIsActive401JSONResponse ErrorResponse
+
+func (response PostUsersBulkSetIsActive401JSONResponse) VisitPostUsersBulkSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersBulkSetIsActive404JSONResponse ErrorResponse
+
+func (response PostUsersBulkSetIsActive404JSONResponse) VisitPostUsersBulkSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersBulkSetIsActive500JSONResponse ErrorResponse
+
+func (response PostUsersBulkSetIsActive500JSONResponse) VisitPostUsersBulkSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersDeactivateAndReassignRequestObject struct {
+	Body *PostUsersDeactivateAndReassignJSONRequestBody
+}
+
+type PostUsersDeactivateAndReassignResponseObject interface {
+	VisitPostUsersDeactivateAndReassignResponse(w http.ResponseWriter) error
+}
+
+type PostUsersDeactivateAndReassign200JSONResponse struct {
+	// Failed PR, которые не удалось переназначить, пользователь остаётся на них ревьювером
+	Failed   []ReviewReassignFailure `json:"failed"`
+	Replaced []ReviewReplacement     `json:"replaced"`
+
+	// Unassigned PR, с которых пользователь снят без замены (нет кандидатов)
+	Unassigned []string `json:"unassigned"`
+	User       User     `json:"user"`
+}
+
+func (response PostUsersDeactivateAndReassign200JSONResponse) VisitPostUsersDeactivateAndReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersDeactivateAndReassign400JSONResponse ErrorResponse
+
+func (response PostUsersDeactivateAndReassign400JSONResponse) VisitPostUsersDeactivateAndReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersDeactivateAndReassign401JSONResponse ErrorResponse
+
+func (response PostUsersDeactivateAndReassign401JSONResponse) VisitPostUsersDeactivateAndReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersDeactivateAndReassign404JSONResponse ErrorResponse
+
+func (response PostUsersDeactivateAndReassign404JSONResponse) VisitPostUsersDeactivateAndReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersDeactivateAndReassign500JSONResponse ErrorResponse
+
+func (response PostUsersDeactivateAndReassign500JSONResponse) VisitPostUsersDeactivateAndReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetExpertiseRequestObject struct {
+	Params GetUsersGetExpertiseParams
+}
+
+type GetUsersGetExpertiseResponseObject interface {
+	VisitGetUsersGetExpertiseResponse(w http.ResponseWriter) error
+}
+
+type GetUsersGetExpertise200JSONResponse struct {
+	Tags   []string `json:"tags"`
+	UserId string   `json:"user_id"`
+}
+
+func (response GetUsersGetExpertise200JSONResponse) VisitGetUsersGetExpertiseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetExpertise404JSONResponse ErrorResponse
+
+func (response GetUsersGetExpertise404JSONResponse) VisitGetUsersGetExpertiseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetExpertise500JSONResponse ErrorResponse
+
+func (response GetUsersGetExpertise500JSONResponse) VisitGetUsersGetExpertiseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReviewRequestObject struct {
+	Params GetUsersGetReviewParams
+}
+
+type GetUsersGetReviewResponseObject interface {
+	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
+}
+
+type GetUsersGetReview200JSONResponse struct {
+	PullRequests []ReviewAssignment `json:"pull_requests"`
+	UserId       string             `json:"user_id"`
+}
+
+func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReview500JSONResponse ErrorResponse
+
+func (response GetUsersGetReview500JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersImportUnavailabilityRequestObject struct {
+	Body *PostUsersImportUnavailabilityJSONRequestBody
+}
+
+type PostUsersImportUnavailabilityResponseObject interface {
+	VisitPostUsersImportUnavailabilityResponse(w http.ResponseWriter) error
+}
+
+type PostUsersImportUnavailability200JSONResponse struct {
+	Imported []Unavailability `json:"imported"`
+}
+
+func (response PostUsersImportUnavailability200JSONResponse) VisitPostUsersImportUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersImportUnavailability400JSONResponse ErrorResponse
+
+func (response PostUsersImportUnavailability400JSONResponse) VisitPostUsersImportUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersImportUnavailability401JSONResponse ErrorResponse
+
+func (response PostUsersImportUnavailability401JSONResponse) VisitPostUsersImportUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersImportUnavailability404JSONResponse ErrorResponse
+
+func (response PostUsersImportUnavailability404JSONResponse) VisitPostUsersImportUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersImportUnavailability500JSONResponse ErrorResponse
+
+func (response PostUsersImportUnavailability500JSONResponse) VisitPostUsersImportUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersRemoveUnavailabilityRequestObject struct {
+	Body *PostUsersRemoveUnavailabilityJSONRequestBody
+}
+
+type PostUsersRemoveUnavailabilityResponseObject interface {
+	VisitPostUsersRemoveUnavailabilityResponse(w http.ResponseWriter) error
+}
+
+type PostUsersRemoveUnavailability200JSONResponse struct {
+	UnavailabilityId int64  `json:"unavailability_id"`
+	UserId           string `json:"user_id"`
+}
+
+func (response PostUsersRemoveUnavailability200JSONResponse) VisitPostUsersRemoveUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersRemoveUnavailability400JSONResponse ErrorResponse
+
+func (response PostUsersRemoveUnavailability400JSONResponse) VisitPostUsersRemoveUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersRemoveUnavailability401JSONResponse ErrorResponse
+
+func (response PostUsersRemoveUnavailability401JSONResponse) VisitPostUsersRemoveUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersRemoveUnavailability404JSONResponse ErrorResponse
+
+func (response PostUsersRemoveUnavailability404JSONResponse) VisitPostUsersRemoveUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersRemoveUnavailability500JSONResponse ErrorResponse
+
+func (response PostUsersRemoveUnavailability500JSONResponse) VisitPostUsersRemoveUnavailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetExpertiseRequestObject struct {
+	Body *PostUsersSetExpertiseJSONRequestBody
+}
+
+type PostUsersSetExpertiseResponseObject interface {
+	VisitPostUsersSetExpertiseResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetExpertise200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetExpertise200JSONResponse) VisitPostUsersSetExpertiseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetExpertise400JSONResponse ErrorResponse
+
+func (response PostUsersSetExpertise400JSONResponse) VisitPostUsersSetExpertiseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetExpertise401JSONResponse ErrorResponse
+
+func (response PostUsersSetExpertise401JSONResponse) VisitPostUsersSetExpertiseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetExpertise404JSONResponse ErrorResponse
+
+func (response PostUsersSetExpertise404JSONResponse) VisitPostUsersSetExpertiseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetExpertise500JSONResponse ErrorResponse
+
+func (response PostUsersSetExpertise500JSONResponse) VisitPostUsersSetExpertiseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActiveRequestObject struct {
+	Body *PostUsersSetIsActiveJSONRequestBody
+}
+
+type PostUsersSetIsActiveResponseObject interface {
+	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetIsActive200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive401JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive401JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive404JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive500JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive500JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetMaxOpenReviewsRequestObject struct {
+	Body *PostUsersSetMaxOpenReviewsJSONRequestBody
+}
+
+type PostUsersSetMaxOpenReviewsResponseObject interface {
+	VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetMaxOpenReviews200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetMaxOpenReviews200JSONResponse) VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetMaxOpenReviews400JSONResponse ErrorResponse
+
+func (response PostUsersSetMaxOpenReviews400JSONResponse) VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetMaxOpenReviews401JSONResponse ErrorResponse
+
+func (response PostUsersSetMaxOpenReviews401JSONResponse) VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetMaxOpenReviews404JSONResponse ErrorResponse
+
+func (response PostUsersSetMaxOpenReviews404JSONResponse) VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetMaxOpenReviews500JSONResponse ErrorResponse
+
+func (response PostUsersSetMaxOpenReviews500JSONResponse) VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Получить текущие правила владения кодом
+	// (GET /ownership/get)
+	GetOwnershipGet(ctx context.Context, request GetOwnershipGetRequestObject) (GetOwnershipGetResponseObject, error)
+	// Загрузить правила владения кодом в формате CODEOWNERS (заменяют текущие)
+	// (POST /ownership/upload)
+	PostOwnershipUpload(ctx context.Context, request PostOwnershipUploadRequestObject) (PostOwnershipUploadResponseObject, error)
+	// Добавить ревьювера в OPEN PR
+	// (POST /pullRequest/addReviewer)
+	PostPullRequestAddReviewer(ctx context.Context, request PostPullRequestAddReviewerRequestObject) (PostPullRequestAddReviewerResponseObject, error)
+	// Закрыть PR без merge
+	// (POST /pullRequest/close)
+	PostPullRequestClose(ctx context.Context, request PostPullRequestCloseRequestObject) (PostPullRequestCloseResponseObject, error)
+	// Создать PR и автоматически назначить ревьюверов из команды автора (и её резервных команд) по стратегии и лимитам команды
+	// (POST /pullRequest/create)
+	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
+	// Получить историю ревьюверов PR
+	// (GET /pullRequest/history)
+	GetPullRequestHistory(ctx context.Context, request GetPullRequestHistoryRequestObject) (GetPullRequestHistoryResponseObject, error)
+	// Список PR с фильтрами и постраничной выдачей
+	// (GET /pullRequest/list)
+	GetPullRequestList(ctx context.Context, request GetPullRequestListRequestObject) (GetPullRequestListResponseObject, error)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
+	// Перевести DRAFT PR в OPEN и назначить ревьюверов
+	// (POST /pullRequest/ready)
+	PostPullRequestReady(ctx context.Context, request PostPullRequestReadyRequestObject) (PostPullRequestReadyResponseObject, error)
+	// Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
+	// Снять ревьювера с OPEN PR без замены
+	// (POST /pullRequest/removeReviewer)
+	PostPullRequestRemoveReviewer(ctx context.Context, request PostPullRequestRemoveReviewerRequestObject) (PostPullRequestRemoveReviewerResponseObject, error)
+	// Переоткрыть закрытый PR
+	// (POST /pullRequest/reopen)
+	PostPullRequestReopen(ctx context.Context, request PostPullRequestReopenRequestObject) (PostPullRequestReopenResponseObject, error)
+	// Полнотекстовый поиск PR по названию и описанию
+	// (GET /pullRequest/search)
+	GetPullRequestSearch(ctx context.Context, request GetPullRequestSearchRequestObject) (GetPullRequestSearchResponseObject, error)
+	// Отправить вердикт ревью
+	// (POST /pullRequest/submitReview)
+	PostPullRequestSubmitReview(ctx context.Context, request PostPullRequestSubmitReviewRequestObject) (PostPullRequestSubmitReviewResponseObject, error)
+	// Обновить название и метаданные OPEN PR
+	// (POST /pullRequest/update)
+	PostPullRequestUpdate(ctx context.Context, request PostPullRequestUpdateRequestObject) (PostPullRequestUpdateResponseObject, error)
+	// Получить статистику назначений PR по пользователям
+	// (GET /stats/assignments)
+	GetStatsAssignments(ctx context.Context, request GetStatsAssignmentsRequestObject) (GetStatsAssignmentsResponseObject, error)
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /team/add)
+	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
+	// Добавить участников в существующую команду
+	// (POST /team/addMember)
+	PostTeamAddMember(ctx context.Context, request PostTeamAddMemberRequestObject) (PostTeamAddMemberResponseObject, error)
+	// Удалить команду
+	// (POST /team/delete)
+	PostTeamDelete(ctx context.Context, request PostTeamDeleteRequestObject) (PostTeamDeleteResponseObject, error)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
+	// Список команд с числом участников и нагрузкой
+	// (GET /team/list)
+	GetTeamList(ctx context.Context, request GetTeamListRequestObject) (GetTeamListResponseObject, error)
+	// Исключить пользователя из команды
+	// (POST /team/removeMember)
+	PostTeamRemoveMember(ctx context.Context, request PostTeamRemoveMemberRequestObject) (PostTeamRemoveMemberResponseObject, error)
+	// Переименовать команду
+	// (POST /team/rename)
+	PostTeamRename(ctx context.Context, request PostTeamRenameRequestObject) (PostTeamRenameResponseObject, error)
+	// Изменить настройки команды (не переданные поля не меняются)
+	// (POST /team/update)
+	PostTeamUpdate(ctx context.Context, request PostTeamUpdateRequestObject) (PostTeamUpdateResponseObject, error)
+	// Добавить период недоступности пользователя
+	// (POST /users/addUnavailability)
+	PostUsersAddUnavailability(ctx context.Context, request PostUsersAddUnavailabilityRequestObject) (PostUsersAddUnavailabilityResponseObject, error)
+	// Установить флаг активности нескольким пользователям
+	// (POST /users/bulkSetIsActive)
+	PostUsersBulkSetIsActive(ctx context.Context, request PostUsersBulkSetIsActiveRequestObject) (PostUsersBulkSetIsActiveResponseObject, error)
+	// Деактивировать пользователя и переназначить его OPEN ревью
+	// (POST /users/deactivateAndReassign)
+	PostUsersDeactivateAndReassign(ctx context.Context, request PostUsersDeactivateAndReassignRequestObject) (PostUsersDeactivateAndReassignResponseObject, error)
+	// Получить теги экспертизы пользователя
+	// (GET /users/getExpertise)
+	GetUsersGetExpertise(ctx context.Context, request GetUsersGetExpertiseRequestObject) (GetUsersGetExpertiseResponseObject, error)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
+	// Импортировать периоды недоступности из iCalendar
+	// (POST /users/importUnavailability)
+	PostUsersImportUnavailability(ctx context.Context, request PostUsersImportUnavailabilityRequestObject) (PostUsersImportUnavailabilityResponseObject, error)
+	// Удалить период недоступности пользователя
+	// (POST /users/removeUnavailability)
+	PostUsersRemoveUnavailability(ctx context.Context, request PostUsersRemoveUnavailabilityRequestObject) (PostUsersRemoveUnavailabilityResponseObject, error)
+	// Задать теги экспертизы пользователя (заменяют текущие)
+	// (POST /users/setExpertise)
+	PostUsersSetExpertise(ctx context.Context, request PostUsersSetExpertiseRequestObject) (PostUsersSetExpertiseResponseObject, error)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
+	// Задать лимит OPEN ревью пользователя
+	// (POST /users/setMaxOpenReviews)
+	PostUsersSetMaxOpenReviews(ctx context.Context, request PostUsersSetMaxOpenReviewsRequestObject) (PostUsersSetMaxOpenReviewsResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetOwnershipGet operation middleware
+func (sh *strictHandler) GetOwnershipGet(w http.ResponseWriter, r *http.Request) {
+	var request GetOwnershipGetRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOwnershipGet(ctx, request.(GetOwnershipGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOwnershipGet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetOwnershipGetResponseObject); ok {
+		if err := validResponse.VisitGetOwnershipGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostOwnershipUpload operation middleware
+func (sh *strictHandler) PostOwnershipUpload(w http.ResponseWriter, r *http.Request) {
+	var request PostOwnershipUploadRequestObject
+
+	var body PostOwnershipUploadJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOwnershipUpload(ctx, request.(PostOwnershipUploadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOwnershipUpload")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostOwnershipUploadResponseObject); ok {
+		if err := validResponse.VisitPostOwnershipUploadResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestAddReviewer operation middleware
+func (sh *strictHandler) PostPullRequestAddReviewer(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestAddReviewerRequestObject
+
+	var body PostPullRequestAddReviewerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestAddReviewer(ctx, request.(PostPullRequestAddReviewerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestAddReviewer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestAddReviewerResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestAddReviewerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestClose operation middleware
+func (sh *strictHandler) PostPullRequestClose(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestCloseRequestObject
+
+	var body PostPullRequestCloseJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestClose(ctx, request.(PostPullRequestCloseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestClose")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestCloseResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestCloseResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestCreate operation middleware
+func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestCreateRequestObject
+
+	var body PostPullRequestCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestCreate(ctx, request.(PostPullRequestCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestCreate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestCreateResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPullRequestHistory operation middleware
+func (sh *strictHandler) GetPullRequestHistory(w http.ResponseWriter, r *http.Request, params GetPullRequestHistoryParams) {
+	var request GetPullRequestHistoryRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequestHistory(ctx, request.(GetPullRequestHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequestHistory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPullRequestHistoryResponseObject); ok {
+		if err := validResponse.VisitGetPullRequestHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPullRequestList operation middleware
+func (sh *strictHandler) GetPullRequestList(w http.ResponseWriter, r *http.Request, params GetPullRequestListParams) {
+	var request GetPullRequestListRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequestList(ctx, request.(GetPullRequestListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequestList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPullRequestListResponseObject); ok {
+		if err := validResponse.VisitGetPullRequestListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestMerge operation middleware
+func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestMergeRequestObject
+
+	var body PostPullRequestMergeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestMerge(ctx, request.(PostPullRequestMergeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestMerge")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestMergeResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestMergeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReady operation middleware
+func (sh *strictHandler) PostPullRequestReady(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestReadyRequestObject
+
+	var body PostPullRequestReadyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReady(ctx, request.(PostPullRequestReadyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReady")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestReadyResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReadyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReassign operation middleware
+func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestReassignRequestObject
+
+	var body PostPullRequestReassignJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReassign(ctx, request.(PostPullRequestReassignRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReassign")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestReassignResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReassignResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestRemoveReviewer operation middleware
+func (sh *strictHandler) PostPullRequestRemoveReviewer(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestRemoveReviewerRequestObject
+
+	var body PostPullRequestRemoveReviewerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestRemoveReviewer(ctx, request.(PostPullRequestRemoveReviewerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestRemoveReviewer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestRemoveReviewerResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestRemoveReviewerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReopen operation middleware
+func (sh *strictHandler) PostPullRequestReopen(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestReopenRequestObject
+
+	var body PostPullRequestReopenJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReopen(ctx, request.(PostPullRequestReopenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReopen")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestReopenResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReopenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPullRequestSearch operation middleware
+func (sh *strictHandler) GetPullRequestSearch(w http.ResponseWriter, r *http.Request, params GetPullRequestSearchParams) {
+	var request GetPullRequestSearchRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequestSearch(ctx, request.(GetPullRequestSearchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequestSearch")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPullRequestSearchResponseObject); ok {
+		if err := validResponse.VisitGetPullRequestSearchResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestSubmitReview operation middleware
+func (sh *strictHandler) PostPullRequestSubmitReview(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestSubmitReviewRequestObject
+
+	var body PostPullRequestSubmitReviewJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestSubmitReview(ctx, request.(PostPullRequestSubmitReviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestSubmitReview")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestSubmitReviewResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestSubmitReviewResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestUpdate operation middleware
+func (sh *strictHandler) PostPullRequestUpdate(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestUpdateRequestObject
+
+	var body PostPullRequestUpdateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestUpdate(ctx, request.(PostPullRequestUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestUpdate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestUpdateResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestUpdateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStatsAssignments operation middleware
+func (sh *strictHandler) GetStatsAssignments(w http.ResponseWriter, r *http.Request) {
+	var request GetStatsAssignmentsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatsAssignments(ctx, request.(GetStatsAssignmentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatsAssignments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStatsAssignmentsResponseObject); ok {
+		if err := validResponse.VisitGetStatsAssignmentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamAdd operation middleware
+func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamAddRequestObject
+
+	var body PostTeamAddJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamAdd(ctx, request.(PostTeamAddRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamAdd")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamAddResponseObject); ok {
+		if err := validResponse.VisitPostTeamAddResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamAddMember operation middleware
+func (sh *strictHandler) PostTeamAddMember(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamAddMemberRequestObject
+
+	var body PostTeamAddMemberJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamAddMember(ctx, request.(PostTeamAddMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamAddMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamAddMemberResponseObject); ok {
+		if err := validResponse.VisitPostTeamAddMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamDelete operation middleware
+func (sh *strictHandler) PostTeamDelete(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamDeleteRequestObject
+
+	var body PostTeamDeleteJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamDelete(ctx, request.(PostTeamDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamDelete")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamDeleteResponseObject); ok {
+		if err := validResponse.VisitPostTeamDeleteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostUsersSetIsActive404JSONResponse ErrorResponse
+// GetTeamGet operation middleware
+func (sh *strictHandler) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
+	var request GetTeamGetRequestObject
 
-func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamGet(ctx, request.(GetTeamGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamGet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamGetResponseObject); ok {
+		if err := validResponse.VisitGetTeamGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostUsersSetIsActive500JSONResponse ErrorResponse
+// GetTeamList operation middleware
+func (sh *strictHandler) GetTeamList(w http.ResponseWriter, r *http.Request, params GetTeamListParams) {
+	var request GetTeamListRequestObject
 
-func (response PostUsersSetIsActive500JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamList(ctx, request.(GetTeamListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamList")
+	}
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
-	// (POST /pullRequest/create)
-	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
-	// Пометить PR как MERGED (идемпотентная операция)
-	// (POST /pullRequest/merge)
-	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
-	// Переназначить конкретного ревьювера на другого из его команды
-	// (POST /pullRequest/reassign)
-	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
-	// Получить статистику назначений PR по пользователям
-	// (GET /stats/assignments)
-	GetStatsAssignments(ctx context.Context, request GetStatsAssignmentsRequestObject) (GetStatsAssignmentsResponseObject, error)
-	// Создать команду с участниками (создаёт/обновляет пользователей)
-	// (POST /team/add)
-	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
-	// Получить команду с участниками
-	// (GET /team/get)
-	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
-	// Получить PR'ы, где пользователь назначен ревьювером
-	// (GET /users/getReview)
-	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
-	// Установить флаг активности пользователя
-	// (POST /users/setIsActive)
-	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamListResponseObject); ok {
+		if err := validResponse.VisitGetTeamListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// PostTeamRemoveMember operation middleware
+func (sh *strictHandler) PostTeamRemoveMember(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamRemoveMemberRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	var body PostTeamRemoveMemberJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamRemoveMember(ctx, request.(PostTeamRemoveMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamRemoveMember")
+	}
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamRemoveMemberResponseObject); ok {
+		if err := validResponse.VisitPostTeamRemoveMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// PostPullRequestCreate operation middleware
-func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
-	var request PostPullRequestCreateRequestObject
+// PostTeamRename operation middleware
+func (sh *strictHandler) PostTeamRename(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamRenameRequestObject
 
-	var body PostPullRequestCreateJSONRequestBody
+	var body PostTeamRenameJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -993,18 +4777,18 @@ func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Re
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestCreate(ctx, request.(PostPullRequestCreateRequestObject))
+		return sh.ssi.PostTeamRename(ctx, request.(PostTeamRenameRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestCreate")
+		handler = middleware(handler, "PostTeamRename")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPullRequestCreateResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestCreateResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostTeamRenameResponseObject); ok {
+		if err := validResponse.VisitPostTeamRenameResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1012,11 +4796,11 @@ func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// PostPullRequestMerge operation middleware
-func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
-	var request PostPullRequestMergeRequestObject
+// PostTeamUpdate operation middleware
+func (sh *strictHandler) PostTeamUpdate(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamUpdateRequestObject
 
-	var body PostPullRequestMergeJSONRequestBody
+	var body PostTeamUpdateJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1024,18 +4808,18 @@ func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Req
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestMerge(ctx, request.(PostPullRequestMergeRequestObject))
+		return sh.ssi.PostTeamUpdate(ctx, request.(PostTeamUpdateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestMerge")
+		handler = middleware(handler, "PostTeamUpdate")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPullRequestMergeResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestMergeResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostTeamUpdateResponseObject); ok {
+		if err := validResponse.VisitPostTeamUpdateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1043,11 +4827,11 @@ func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// PostPullRequestReassign operation middleware
-func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
-	var request PostPullRequestReassignRequestObject
+// PostUsersAddUnavailability operation middleware
+func (sh *strictHandler) PostUsersAddUnavailability(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersAddUnavailabilityRequestObject
 
-	var body PostPullRequestReassignJSONRequestBody
+	var body PostUsersAddUnavailabilityJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1055,18 +4839,18 @@ func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestReassign(ctx, request.(PostPullRequestReassignRequestObject))
+		return sh.ssi.PostUsersAddUnavailability(ctx, request.(PostUsersAddUnavailabilityRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestReassign")
+		handler = middleware(handler, "PostUsersAddUnavailability")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPullRequestReassignResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestReassignResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostUsersAddUnavailabilityResponseObject); ok {
+		if err := validResponse.VisitPostUsersAddUnavailabilityResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1074,23 +4858,30 @@ func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.
 	}
 }
 
-// GetStatsAssignments operation middleware
-func (sh *strictHandler) GetStatsAssignments(w http.ResponseWriter, r *http.Request) {
-	var request GetStatsAssignmentsRequestObject
+// PostUsersBulkSetIsActive operation middleware
+func (sh *strictHandler) PostUsersBulkSetIsActive(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersBulkSetIsActiveRequestObject
+
+	var body PostUsersBulkSetIsActiveJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetStatsAssignments(ctx, request.(GetStatsAssignmentsRequestObject))
+		return sh.ssi.PostUsersBulkSetIsActive(ctx, request.(PostUsersBulkSetIsActiveRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetStatsAssignments")
+		handler = middleware(handler, "PostUsersBulkSetIsActive")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetStatsAssignmentsResponseObject); ok {
-		if err := validResponse.VisitGetStatsAssignmentsResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostUsersBulkSetIsActiveResponseObject); ok {
+		if err := validResponse.VisitPostUsersBulkSetIsActiveResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1098,11 +4889,11 @@ func (sh *strictHandler) GetStatsAssignments(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// PostTeamAdd operation middleware
-func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
-	var request PostTeamAddRequestObject
+// PostUsersDeactivateAndReassign operation middleware
+func (sh *strictHandler) PostUsersDeactivateAndReassign(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersDeactivateAndReassignRequestObject
 
-	var body PostTeamAddJSONRequestBody
+	var body PostUsersDeactivateAndReassignJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1110,18 +4901,18 @@ func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostTeamAdd(ctx, request.(PostTeamAddRequestObject))
+		return sh.ssi.PostUsersDeactivateAndReassign(ctx, request.(PostUsersDeactivateAndReassignRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostTeamAdd")
+		handler = middleware(handler, "PostUsersDeactivateAndReassign")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostTeamAddResponseObject); ok {
-		if err := validResponse.VisitPostTeamAddResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostUsersDeactivateAndReassignResponseObject); ok {
+		if err := validResponse.VisitPostUsersDeactivateAndReassignResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1129,25 +4920,25 @@ func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetTeamGet operation middleware
-func (sh *strictHandler) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
-	var request GetTeamGetRequestObject
+// GetUsersGetExpertise operation middleware
+func (sh *strictHandler) GetUsersGetExpertise(w http.ResponseWriter, r *http.Request, params GetUsersGetExpertiseParams) {
+	var request GetUsersGetExpertiseRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTeamGet(ctx, request.(GetTeamGetRequestObject))
+		return sh.ssi.GetUsersGetExpertise(ctx, request.(GetUsersGetExpertiseRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTeamGet")
+		handler = middleware(handler, "GetUsersGetExpertise")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetTeamGetResponseObject); ok {
-		if err := validResponse.VisitGetTeamGetResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetUsersGetExpertiseResponseObject); ok {
+		if err := validResponse.VisitGetUsersGetExpertiseResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1181,6 +4972,99 @@ func (sh *strictHandler) GetUsersGetReview(w http.ResponseWriter, r *http.Reques
 	}
 }
 
+// PostUsersImportUnavailability operation middleware
+func (sh *strictHandler) PostUsersImportUnavailability(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersImportUnavailabilityRequestObject
+
+	var body PostUsersImportUnavailabilityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersImportUnavailability(ctx, request.(PostUsersImportUnavailabilityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersImportUnavailability")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersImportUnavailabilityResponseObject); ok {
+		if err := validResponse.VisitPostUsersImportUnavailabilityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostUsersRemoveUnavailability operation middleware
+func (sh *strictHandler) PostUsersRemoveUnavailability(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersRemoveUnavailabilityRequestObject
+
+	var body PostUsersRemoveUnavailabilityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersRemoveUnavailability(ctx, request.(PostUsersRemoveUnavailabilityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersRemoveUnavailability")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersRemoveUnavailabilityResponseObject); ok {
+		if err := validResponse.VisitPostUsersRemoveUnavailabilityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostUsersSetExpertise operation middleware
+func (sh *strictHandler) PostUsersSetExpertise(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersSetExpertiseRequestObject
+
+	var body PostUsersSetExpertiseJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersSetExpertise(ctx, request.(PostUsersSetExpertiseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersSetExpertise")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersSetExpertiseResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetExpertiseResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // PostUsersSetIsActive operation middleware
 func (sh *strictHandler) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
 	var request PostUsersSetIsActiveRequestObject
@@ -1212,52 +5096,255 @@ func (sh *strictHandler) PostUsersSetIsActive(w http.ResponseWriter, r *http.Req
 	}
 }
 
+// PostUsersSetMaxOpenReviews operation middleware
+func (sh *strictHandler) PostUsersSetMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersSetMaxOpenReviewsRequestObject
+
+	var body PostUsersSetMaxOpenReviewsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersSetMaxOpenReviews(ctx, request.(PostUsersSetMaxOpenReviewsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersSetMaxOpenReviews")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersSetMaxOpenReviewsResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetMaxOpenReviewsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xb4W4bxxF+lcW2QBzgLFK0VaD8x9iMK6CWGYopigoCseKtpUvIO/puqUYQCFhS2jiV",
-	"ETX5VQRIDCMvQNNiRMsi9Qqzb1TM7h15Rx5PJ1OW00J/bPK4tzszO/PNN7OrXVpzGk3H5rbwaH6XNpnL",
-	"GlxwV32rcNZYYQ3+WYu7O/jA5F7NtZrCcmyap/ALDKAPp9CBt/I5DGAIPQJ9OJNHBE5hCGfQgQEcy0Nq",
-	"UAvfeKImMqjNGpzmqeCsUVWfDeryJy3L5SbNC7fFDerVtniD4aJip4mDPeFa9iZttw36ucfdZXOWVP+B",
-	"Y+jBQO5DX36t5ZP7MJRPCZzDUIl6AkPoqsc9eCuPZojX8rhbtcxLCdcOflQGLHietWk3uC3uOS1blLiL",
-	"oitDu06Tu8LiahxT47hZreGw0LyWLfgmd2nbGEkTa5GxgGshsSemXTeCN52NL3hN4KxF13XcMveaju1x",
-	"nJt/xRrNuv6Iv+GHmmPiWyuPKtVPH32+cp8atME9j23iU5d7TsutcWI7gjx2WrapJIoqOJoq+lhPvEu5",
-	"3Wqg6JVi4WG1+Nfl1coqNWipHPn8sFh+UMS1UY7C6urygxX/a/VeYeX+8v1Cpej/Gki5vFIpllcKf66u",
-	"Fst/KZarxXL5UZka9JPC/Wq5+NnnxdVKyCqBPUPaXWRrpcB4/LSFJ8ZrO8RtRKlVr5f5kxb3RIJ/uHzb",
-	"4n/3wzPq9/62ExhAB07wX/kNxgEM5KH8B5FPoQdd+Vx+B13oyacYAeRWdmEh9zG6v+ANL0bdkaDMddkO",
-	"fmctseXMcESD1lzOBDcLSofHjttgguapyQS/LSwV53arXmcb6GA6lGJs727ON0OzVa9XXW3LWYJGxuh4",
-	"jxnlCSZaXthDH5WKK9Sgvi9O+87Efk+KErdw2KajJY24Pb/Ab1a3HDfOeRJ37P/BWHF2wdw1bYsGb2z4",
-	"0TNy+d+7/DHN099lxqkw42N4Bmd5qN6Ji4Vx/roQKMKpLhBiltj+glPCW16V1YS1HV5uw3HqnNnJCUL/",
-	"lk7QcfYYvWOEVo6TOT6nXSBtku3eqy7hnUjSCyez7MeOWsYSiDe0VCZlPxrJOLeTVe5uWzVOblW4J0iF",
-	"eV8a5FNWr5NcNreE8LrNXU+j9OJCdiGLWjhNbrOmRfP0zkJ24Q46OxNbynKZ5jimMxpRlXkdnRrQyAxB",
-	"f9lEkRxPhDDgnh6u7cA98Ylj7uhMawuuqQVrNutWTc2Q+cJDqSJZPwQXtLVIYxCCNt3bi9nsYmyA5mnB",
-	"NInHmVvbou0wVfoQqDQnwsR7RZQNqgeaPynFctnFyxm86c5K8Wu0lUPnvYOCXOW+jMFaY3Q7YaO0eEkg",
-	"GaYu7XasyaJUpVQmcg+GcALHWCHgZt7NZi9ntUlyGqZ0YXo6ZQlieYQ3mmJnQuskDaMsOUYj+AnZFRZC",
-	"0IdjpFxYCintgi8n0IFz5F1yDzpa5bspVL4qAf8NXV0FZcKlGXSQL/Y0aXzjF06HWro/zrchYfY+3o5S",
-	"mVgmYXWXM3OH8K8sT3hXuRHoWgfwK/SI3JMH8lvoyT25D115AD25j4otzetps4qKsZJYtbk2qxOPu9vc",
-	"JXqGq3S3H2AgD+S+IvXoYUdYcg/lM+jDKyx4Mb6Q5Guq39Fr81rLtcQOza/t0oLZsOyK8yW3aX5tvb1u",
-	"UK/VaDAsqCm8DGJT7svnpFQm0CfQ0Q6EnqNK62/QtHCKP4Urjr56B45hSHLxRQf04WSiPzCaXQlrUME2",
-	"FfyFkMWj66hEJDeqUiF1anyoRs+RGWcDbhJ8XpjJLshR75aDsteTg8bFGkWuc3sxezt3t7KYy9+5m1/6",
-	"w9+uLEv5JcT15ynoqlSlQGQoj1TDq08Cca4ZxFGeSbS+gbRUkPZCwU1PIVcAaqfQgVN/L8ktlbh7cAbn",
-	"MFSNwYHcV5iGYsC5XlX+E/ry6OP0EOVyHVSpUaocvDAHUDn1cQj7wZpLDMWEsMK5kqqxufHNiCzx4dEO",
-	"S7HW0ntn3KhDs85q3KxuoIe2lujVgdvE5AmtwiF0YQivYTidq1UiTt5Kl0ZXWk8BqvBCzd6b6lP2Edm6",
-	"8lB36RHoUL4PArJ95PGzjguex4PwpQiz34zC5KlEHwPVT3oNOEHcOVMwdKQp1bkqHd5Cj4w64dus3ppF",
-	"vkeDxiBeY7btCBJgEnFsomUgpbI2he3cY7ZpmX7LISqX3Fe8DnOhPIBzv7GsYBRLib5mjGirJNEm2vVj",
-	"6WyH6GYM8V1K9VZqgTzEsongrBEIKgp+/E4I+iJx017JQ3g71SOPI6pnyUpEjiDCpyF+e8jy1IFIADJE",
-	"OERsWZ5v6SutPDvyqTyQz8ZBdKyT3aj3r+rODnTRr4mfymLiTx7dkImUZGLagn7dg2XNAE7xZ0UfZmGr",
-	"ckECx7h1OEQN05VRT3+ePEBNIByYWrwMGzUkFbpschFzOPqDKu26ist8Cx0d1KcKcP2SDqtlXH8ant+E",
-	"9RhVc+da2A78qv1OHswCzu8WqDFBfh5wsYrCF0Kyv1N2n5U4N3YUtYi47Nr0geudUMsZc33bmB6zFBmT",
-	"o+gUqQ4RZpwCTx0opClJ4KXc9wtw3Km+duYDuafC+hl6XGD+A3/j/GbT4jU2m1SyyKgUrv19IA/hDVb5",
-	"x3AGfRjozgF6FOaLU51DL03otY468pQ5IoZBR4xzYkzw52MzTd0GgDMlSgaTTYaZZjJ1r3DWKJjmPHR9",
-	"dCi1Fjk10aebEbcMH37QQt2qceWpSS/loi994mwoxw0dv9Am29GRlxqAK6M8fMW9cOGf2n1ok2yw2pfc",
-	"v8kwC1oCWVMYKk1g/xjpyob740EEv6cO+Ujv31BnfM7ec/QeSZyq768DPbmRN93oeLY1q90cIT4HRO4R",
-	"hfIdZbngvtkZ9MmtcYzI7zHdDOGVX9EiiitmEwvx0IM34RYOBmlApRTo+9TJ/2+KseD4B1yoI9vxdbm1",
-	"eCuOh2Si1+kQcuZrY/xmQPLyaWPCgX6GV/Jf0IPTgI2Oie+1H5T9mHw6phHqpkiKIWjGrroKkpaxpQ30",
-	"GZGKzulhqOobGUkBi1J5D0YjLxu34eum80dtuFGol3+vfcb1yaol3VFV+itSUxfQYi5KvcPd1agwqRqL",
-	"L+Ec2T8WFKRU/mhUnsZe+b0J4isJ4lL5I3loEHiN6JjYMU3VcAsCXUVsJNA9Lpa9wuhC2eyCTL26Gho9",
-	"R2UWSpCPWd3j6WPpne/qzQyIi+6qXfEZSdA1mTZBHAW4kDokmCpYKSkOdMMkVR31c4gFfu8XGW9meub/",
-	"Sm/kulnQi8scfdwA6YXtql90f8r3TL9l9TW8hQ68xr0/VT2rLv6u+1dJf6wyhZLt0bPd4I9XNFVCOPcf",
-	"6MGhB5FGcuj5nziriy3aXm//NwAA//+6B8WHHjQAAA==",
+	"H4sIAAAAAAAC/+y9f28bR5on/lYK/f0Cay9av+x4ssPBAqvYSqI7W9ZQsud2IoNokW2pJ2RT6W7a1hoG",
+	"LGkdz5w80SXI3gxmN8lkcrj54/6hZXFEyRIF7Cuofgv3Sg7PU1XdVd3VzaYoyU7MwSAWyf5R9VTV8/v5",
+	"PI+NarOx1nRtN/CN0mNjzfKshh3YHn5atK3GnNWwf9myvXX4omb7Vc9ZC5yma5QM+gM9pl16SNv0dfiC",
+	"HtMe7RDapUfhDqGHtEePaJse071w2zANB+74DB9kGq7VsI2SEdhWo4J/m4Znf9ZyPLtmlAKvZZuGX121",
+	"Gxa8NFhfg4v9wHPcFePJE9O449vebC1rVH+ke7RDj8NN2g3/lY0v3KS98CmhJ7SHQ92nPbqLX3fo63An",
+	"Y3gt3/YqTm2gwT0RPyIBp33fWXEbthtcb7bcYN72gKRIaK+5ZnuBY+N1Fl5n1ypVuEwzp29om+7TY9oO",
+	"n8PcaDfcIeFW+Jy2w41wky9Dj+6m6c4H6LiBvWJ7xhPTaK7ZbuaL/kI79DDcCn9Hu7CYx6d7L95Ibs/P",
+	"zJH5snYQa5Znu0El3gGlx4bbqtet5botqJygrWn4reXAs+3KeZGL0C6hu+EG7YTPlJ8I3vI32jFTj4Gf",
+	"OuEGPeRb65B2kzfjLd1wM9yGTUc74Wa4AUekR/dolx6T8CkMV0smMeM+a9YjMDhC92A359JdIXj6ZMUb",
+	"/RPldCYormyizHXRDv9eNKrm8m/sagCDSh8TOOFFjkl6glZQqVprVtUJdLzh34E9wVoQ2g03YHeET+kJ",
+	"rJNJkIHthtu0Q+bLWbziC1xv9WC0wy/YksYUX24267blwoAa1iM2f89+4NgPfc2ovqYdeoBbajfcCr/A",
+	"w3dA6OtosLii4VPaobvhi/ALk8BRIWOEvqQdug876RVuItjpfMfTA8PMOlGDM4MO7RDcxBv0Ne2p0+ev",
+	"63/mBT/tu/Nixpu/7+S11u2rD+rN6qeOu1JGyqf301qrXq/Ai20/4ANTKcBnYxL6CoRK1qZ4kSKItFh0",
+	"FzdZjx4ZGpZ2CpokR62b+YznNb2y7a81XR+Puv3IaqzV2Z/wG/xRbdbgrrnbi5UPb9+Zu2GYRsP2fWsF",
+	"vvVsv9nyqjZxmwG532y5NRySSsDoUerX7MGPDdttNWDsizPTtyoz/212YXHBMI35svL3rZnyRzPwbhjH",
+	"9MLC7Edz/GPl+vTcjdkb04szhqmMcnZucaY8N32zsjBTvjtTrsyUy7dhy30wfaNSnvnlnZmFRbzq7vTN",
+	"2RuVxfL03MLs4uztOfZCeBIsrGEa+O7KBzdvX/+v+M7yzN3ZmV/NlCs3Z2/NLkqUjRcsolC/BUMixNen",
+	"VylxPaOldjH9qlW3YEvON+tOVcfX/goaDqEnuNGAsz1j0iXcJOEG/z7cwP+yA3scbtMj3Tnu0KMS8Wx2",
+	"8MiYtJFh93fwo8L8YnkGiuAhobtkArZome3QCfGsJfcSCsrXIGT3aZsewZOYrtAJN0GywlGheyg5X4c7",
+	"0YNBk4OvaJfuXTZhQzr31yt126qRsWJ3jRP6He3BtUd4gJ9zXvkFiUdnmNF+FV8CB41fpt0Ot2xvxb79",
+	"wPY8p2anF2a+TMINmGn4FCQ0PSa0TfeAq+P7N8JN4NtMPYUZAPVoj75k60ca8PQxxnVgMVFv6aa0ljbd",
+	"5Y9oa5i+ejaXNduH85a8sbW1zMtt2EGl2nRrDjwJn+8EdsPXnI7odsvzrPXU7l9eNzTP052G2w9d2/NX",
+	"nbVyi/GzBE+3gsD23PQkP6o3l8fC39I2fQkyDJbiJNwCmgLRww2YdrgJWxj+BiWQXL99Y+b2r+Zmygu6",
+	"2YN+pJPmf5IXZ4zu0tdIVxQU4efhNrn0T01vZSJSr3DNYf8ehlv8UGzSzmXDLExLJkb8nIVNDAKONLn0",
+	"T/HPQw4gsZhiDcS4BK10yzkfs4ochY/pTvlzTPKy43A7fKYTxLvkEu2Fm6ThuPGDkQIElDXpq+xzNtjy",
+	"WK1gtZkh502jWm/6dm0a53+/6TWswCgZNSuwxwKnYWtOdPoJnm0Fwz1CIapmkPYjWFKrrtWTcmzu+bI4",
+	"XqjgdmCDEWBv+BFYzSvaZpTe03OZ+1a9vmxVP83bBPTP6iqjLUf3SXoDmdqNwhwX+2y37MMT6C7fQPIm",
+	"GGjRkXlXmpJs+P89+75RMv6/idjpMsG9BROqIBG3D7WkGvU2/5oMu9A0GPV0dP8OFpK+Rgl8zHwG7JiB",
+	"aXsINq/2BJqoSjMTGMyuo3CL2Q+pi9syzfMIyJV8zUIwRbay7FludVU7Pz+wgpYvq6w3ytMfghIpK4qg",
+	"IV6/eXth5oZWFQgsb8UO8l7T8uoaEn4fboTb9DWcGUaDYY9MkhsntoFu0WUWFZHD1PHfPjx8wba86urH",
+	"joaZrzorq3VnZVVnaf4vWGn6SkgfcUZ3uZ62g56ZHj1Bwcy/M4FEYIadcNHGrwT16cvwKT0G6Q6MYJcs",
+	"tSYnr1aX8R+bfZjgn3QcZ83rt9nkGa82PaSCZ7mfZnAmOB84F/Qa4TqGL0ww39GcDH9LO+T/Pv2a0Nfg",
+	"K4KPhikd+mZruS4N1G01lsGiTq6zZ/BBmBKt+60Xjj4tePMFVl9xc4686AzO6lkdEB1ts3wN1WYDHFw5",
+	"7NXOpLffWm44QWDXKtYAJH9gezWnGhTjm3f5xUnayEOLH5kYUjYdYs/eBeyxWsvmBEpxWBA6h4oHDTTA",
+	"cANZBHe8MSs0slHDLcZi9foft1rJws1pw9SPr698BtWg1tJbjTA8egiKDA6qTcKN1CSEXf/b8Ev6Wut7",
+	"PGsNwPYq1rJvu1Xb7x876LLxdegeUrgX7ohfmB+hS3uMrMfMfoerwi16IjgkrIFOeZ8vF1UJ7rjWA8up",
+	"W8tOHVyEOtXg7WEm8X7IPk5l7pj40HLqLc8u5NHULKXla5X8vjPhd+aNb61uVW39eS82NnxArbK8rvm9",
+	"wADj27NHeTdmjGLVp+fny7fvsoX+eHruo5kF4UjMUPbK/DzMPNDztmoQsbYMcxXMjf/yq0XC3S2f027s",
+	"bAF/VbjFjo3EkuAepiIJZx2oQGjVQwyWhBvccumGG+QS6lAneNDQAWWS8PcYqGrD5eHnTFvSuAYhnEB7",
+	"0uG7PIANOpCIsoF6nEzRHY4b/Ow9fdAQuFCz5VcSAjPBh/4QORe/5NbdQYqTmOAfFBG7noiclWckB3Tf",
+	"GWceJNPIH+Cfk2OBMUROQKA9GtAbknMVVd2XIA1g2eX9IcbOOJVJGKMC9lueASZWbC7siyKaAt/zi3CD",
+	"qmWkw5JJ+t6dKd+Yvb5omIUPXp+BJ33oYj/xC6M1UrZnNmeQ5yZzh3hTKDvkzpz0IZ5bUmaYRrQSeaxk",
+	"IfCswF5Z1yowwhfboa/YuYVA5Uuui2jFpKq8lJZcz3JrzQa4zDeYnUHb9EA4P8DtCVtrl3+RipZ3zSW3",
+	"blt+UKk3rZrNXO/JazDYAGynyw8gmDZdeiTFDukRixHqQojmkutBpKniNZcdV/8G7jfgoVtQHLqq7x5n",
+	"aZiGPFjYCPGDtauQkZgBqk7Q1Lqc/jftwGDC58xyV9IFdsNtsOKYswkmj//CMSbhUxGaYckn44R+G27S",
+	"PemwQwgFPKYTK3aQ63LybKt2262vJ05HpN4sQwC00nQr1VXLXbF9IS3tLLaJClv4O9hq4QsWfmCuGpxj",
+	"uIW+iQ6zXxOeHpI6v+xOXdTlvlX3ba3GWl116jXPdvv51ZnWeMJFl9Axd4UAkxI22IbhG5J2Y3KHX5w1",
+	"uWv2fatVDyoFQv7/nhXbF6xSn67C4v1cGCgzZmIjShmgbZNM9ssQIJcyFggEfsNxnQacqUmdKLajmGRl",
+	"LQpK5gmPVBBT9uwWCqWYTGeKZSVzz0Lg4iXthk+lFU0yxHBb1qJU3siyOdDBxiL6eA4THDF8pl+QS/Cw",
+	"ExzMDt2jh2jdMNsmfMrOODxxsGgBBBv1isNf4pBmQmlAByq+mYUOw51wMxEblTxkGRFhJVxSRGtQwiWa",
+	"8f6HCKchseMkQSmVJMPESxjbYuGV3ZDBXK7Im3dKt3kbdmOZD7iQHQmy4ZbNnG4aP78cRtISATX8iyTB",
+	"ZN/zq8u/yz58yPvZwJDvHkUSjlzyP2tBIJ55U0ngOct29Klmr1leAObg5Thn6RBnhNoBnF4mxndpe3zJ",
+	"pf8mzmjigHYJS0FKhGfokXplmx5pD3NGCKIH5NJzENrlfBZsKhbbSMSCTMxiYP75KHikSHaeToh0Qh2l",
+	"gEXBdNmKtbbmNR9Ydd1++mu0cYTynJDEfHawUFEqAe6/XpwjiKIdNlmKrbVTuwzlCFJ1Ewn5MtyK6HR6",
+	"CcKOTMWvW5Xllu+4tu9XVpst7RH6niVQcrVE5wpTrbldgsLuJTK3LjttMMdtHO/xGD1hiTHSGB42vU9r",
+	"1nrFDywvYMFgza+2W1ty6a78E9i2/9J07ct91J1xEtt9uiAkWx0QJ8C7mU8g3GJL+1ykkEV+S11CKSiX",
+	"W8xSwKFwx1qHcx1cXNyHabVLmk/mGkgprj1OUORU4ZYmapeM5sHgkC4iv6CtTS5WlBaxzrR9NttMrJT2",
+	"TPHZ0B49YBJ9J9xQdlH4TJ40zPtfkZEdMSZAZqfnpnVOl5kWWBQTt5p+tfkwZ5PcWbxumFmehOQmzJoC",
+	"O7vH4ee0LQ++w7TDPXoc7ggOkHn+EnGpKM/rNW1nj37qHwxUB9hKXHmvnwjOOnnZE5OGMczUMsb/c2X4",
+	"V4vtKtur+JLRXsR3Ehn5p8/FFtqLzo0Bmsp1tPUw91Vr532LNvlLxupT21jJHEUJCP9hgVeUC2oURVLG",
+	"hSItbCue+YYERnNRFitbRioxjSfrykZTIe0skeWr0dAKpqmCJVZhKaALbyL300zTIGuNuTaampLjV6xq",
+	"4DyQxyix+exsY/Zbsc0YpyJH95jSm7PGvNBqNCxvPT1odl+F7eu83P7+V/Sxuv+an8F+JpUtQ53qOLVd",
+	"Q5PE9LLofGcNfO5pMv/4nEEjf8rIn3JR/hSNbc0jLMxvi3q12PmCQDgzOdFcn8H4Y3WTvMuOjbwNEIVc",
+	"cWMrao1wb/CHSe4NY2Tvv1F7H9MFj8YzbX4zw94HL47O3D+1HZ2eDN3lA6bt8JlYMVGOGD7TKisZlvLb",
+	"ZQ9fiEGbY6++BTbpj8Wu1GmTifyplEZpuzVfn3H3DUsEP4SKWX6WhRuqZ6Kj6vd4gpFOCbdQVo1luMGO",
+	"BG2L6Hckg7VKCi+5LJiJIsobWjpF4c7sDSUDQ1S7sZgK2LtPcR+q+pSYH7BiptNs4pB7LBdYyYMzBssv",
+	"wX3pD5Rr01IWs3jSTfEK1dQLTMlUjEdsRvsmN6NMXwJuP8IPvp2Rf/iKdkn4e9SmkLpAcrofbmdtqx1y",
+	"aaVpEv+zuknue003sN2aScbHxwdTMfvY3QUMmO+TJgimLWXXgGdOKA607Mll5UxXiR+XAoqQeWP/uvE8",
+	"/erb6KSypAc1RIOWw5eMdwql6oClduwl6uA3hSzMrsff4bGhpLWmBjIL1+59hXV/SZM/k9K0l5zqCQ9O",
+	"9eiB7PCPORJhpYc6BgZi9HX4BUqzA85MMO40WCmgOIZ1u9JyA6eeoQFD/vLnJNyMknY5ESWmxNOc9cm5",
+	"8T7LYtjKfR16XDRROiPNokjR/NBuLNklk+fSgoc57v0mvsYJ6jZL2xbimMSJ72TB9h44VZtcWrT9gCxa",
+	"/qcm+dCq18mVySvXLrOsep8tzNT45Pik8GNZa45RMq6OT45fNcCuCVZxA0w0RQEs5quUHhv8H2CT6BCY",
+	"rUG5qx1ElbIf2YzXMqcuPuXK5CRzgQLHw9uttbW6U8UHTPyGy50Y50blwl6rbhd30KoVu/2KR9mz9RRP",
+	"Fp/h8dmlXVTANK6DZFFrG97+3uR7BaZ+VoAJMQlzvTkKXoNursIh12N6ZwcDgfkV209M49rk5HBzzYJa",
+	"iOftuExzIr7tPbA9wp5wllP/ipdyPeWCcQdzWKQoRpT33GHzhnfb1ZaHSusnj43pWsNxF5uf2q5R+uTe",
+	"k3tQvsJ90VjByNMhWZhC4ohd4X6SN1lUSX0s9MAey7AB7mGt+BhJEDveuAeDkY5saw0yEvFENX3NsZ1v",
+	"+vG5vcMuZufD9oMPmrX1wZYzutL4exL/D+vPwVGI4ey/H/c/q4tfWleX3ImH9vKEfKlQjJZQmcliCtKo",
+	"tIUhG+Gm5kRKRfYm9/Vp6vpAyGtxlqTV0So8J0p9agcL6/rFbtg09BxIRQJ78g5wVYDqeBU+DbcYgEW4",
+	"zXjokHxFhWqRuckDq+7USHRiCI68ROqOa5MrJfYDWTJaV5cM0mj5AWEZGw+dYJX805nyna/Vvcp9YgjW",
+	"hcr6U2bFdVm52AYv45X0uWNRJ7bBFCwV4gm3r051gl08kdDPR3JrJLdUufWH6FzuiwB7YVGlcdfFbJhc",
+	"kuB5dlgkX5GJl3MknYz8Y9VqQh+WBV6CSl+iB1OURSWdOBmunk0GNcQSvDnukCQ79jEXkMmN+fI4od8T",
+	"LUyI9MQtfMw+bQvbe8nNNmxOaC9BbcyLQC0iih1KFRqlZIHFgckPQxQJQbP4d8wwTgMvmrowiRJHWXI5",
+	"I0LrWk2UZPakcu84keIK+voRHAf45v7GRSm/bDvc4BuuMFrKOKadpTUdqRB9WtosQyg8qSJDY80bm5qc",
+	"nJKcUSWj9V6eIlOkUFEyQfsWd+FG4NFi7tbZFZsFhMHACA4Xr5pYtZqdV8zIgsZtZDn6GkIJzmM4vAU9",
+	"7gEbYCH95s9JDpMYfHH95qwExTccHoKFkHlF1l60XzpMCeNSdBAj9owGOF/m3OAYS8b2Yir9vPjpxH1U",
+	"dxoMGkQSZD+wsCQimKocRceXwF9i1VtaoZ+A71O1HPZQgiMgnm1VV+1aiYC/h3BTiFj1evOhT6yANJp+",
+	"QK6QaCiMKgySRx1+PHYV6C1vnDLqYTxEOOWEn3Li+IS/Dd/sNq9bbs0RiT3x6+k3jDkrLjqBW8Q8rpiW",
+	"wKLEeYNK4C3G43KbER1IVYyCRE5GGOAT88y32i7hsfdNiMLTDvq/zWiitEtfMfUqKYW4FDyO7D+6F5XE",
+	"RE7OJG1GSmcxpfPriFVyZ4km8XtXTo/jaqLEv32NpogIaDk64ndRGoVAtcRX8LVGyAaOmsRKbscJqscc",
+	"PwNFrthYWWDMu4y7CY36kHbSk+vRXVCz2INEIhzdTz9qAnHvwD/LdJoC+s91JMG5aD5DqTpvnSoyuLLQ",
+	"VyUQgFsKv2E76ccpa/XMSQOHm3a8KFKIQZOQwLNcHyE5SxxmIKrwElQ6UwmgFagRZ9+PT/aIaxd2FQhe",
+	"+AI5IUsbQg1jAC6NEAr5vmuZo7HLh2BpEkKU0QILjmUu1yo8GPZJtDITPuLOTThuzX40vtIEvpRnCmpA",
+	"j4zpWo2wx0gdHyqCNitNwzT8z+rGvRx22gfSSh29BkRzP4Fb0gEvDbCB1+H2L1gCTJxwpMegJxrAWUy2",
+	"4e7yLfRiHGT4MFKRCqxo6qgZVnlmv6nJ1yXhlvImdGbsMW8SKzluc6iIAVFva551n6sLmChulDCX3NTl",
+	"VuwzTY/v/xS3ZzoEPxc6wZ+O5ofb2UuQgsCurZvMocQTn6BeW9kPJlG2XJx1iDQ+idAu4rfA2OGLjJK+",
+	"s8T+Us5COtkL+TUHuEjl3tD2LyIgMI6XwVI7o3loQD4Seyp8Hr4A3x7HP+G6V/iUaV4sU0QykQfaRAOh",
+	"ymq8OZ2ENwcuQbnF0Xm4G4w7WrFagIexkl5CKID+E23Tv8Gk6QFzTLwW0u8lZ98JT+JRypPI60T7+AtJ",
+	"trswBXsLW3cgLrDkDrAEQwKonU4DnRpQufay4Kg/MVqQSd+6atyTR8UF1lAySIDTMSy6J3lq/LnoxLIr",
+	"/VyDfilKgPvDbqwF62eqZL3tbjb6P8TxnFBT5tIWQbh9JjaB3IojXo75MnHAGYZii9iPHFAHz0nDVwOj",
+	"HIKzWEGRxr/FQdxVXHfO5/p54TWhp8jM34v7aYxMjkImR1rl6maLwq6qSGW4lnB9+0m1SwVF2mVeYrSh",
+	"IqvB3V0lkZQepVNlC9pLqw5Ah61LKXqJJfifWHt0zLAbE3CEPd714zhuaVLKgEyQu6lglcsxIkh09Tnx",
+	"u2Yaop12eYGQrvwOaj+iwE433AE15busyOgu7XIPHUcvZ3ZAomAHrg+fKZ2VevRYWknJjZvG6tehOWYn",
+	"FAhg7S9Qg3qmc8V9ZMt268d82UylG+Inj7U9AnUYpEV7Bd47lV9MZisPWN/GT2TAUW4pS2icBiSajk1N",
+	"jl15b3HqSunqe6VrP/u1IaNvTsXFBmoYgj/GSCBaMp2HzyfCQHxiPs5477WM917JwvRkL4jGxH4jAiA3",
+	"NZpr8WgkgMYnuU6AHHVK0PXxIJ0HBBasxsQY2stqiiEVCnB+rxaq9BCKAU0uXuJ7GPe6O/rRZhbpHKUR",
+	"28jp06ZgW0Z5guD+D7+kRyPxniHezcdYkVM0izbJ/TOBvAvK0rrjB5mClOOlI1xXosiKl2+QmC+BsEuc",
+	"L3ZG5ILLQ+nEQNEo/ZqBCPBUUp7CB340IWw4YNM2enkiZw09wOQq134UVKotz296XIURyOjb3BHG45I9",
+	"Vv/DKlg2CO/+0WXtPbGzDpjf7IVHUIPBcgyTWa7JYQH+mDIGLfh9rmBXfBEq+gFfcWAnoPA9B0CoDYzV",
+	"oRZF5sslElnG4ohKHJxw1yA+mhGCJ5b0F9Y3YV+kJHWyIIk3WpVjwxsqnJXsDUQgAmZ8/yOY3hDquPIz",
+	"/gU6Ci9ndAyOoNzjUxxJkGE6yyT8JXpVRMGUz+6erL9Z7fOQe3tuNX2iF12/rs+DvOgbRHTlnOyVtLnb",
+	"yunOqDrNGIy4877XbCjjKVJUqRnkV+gnfNZ/mMe0c9qxBs1TjVT3SJaPIz8tcqVfm5Qql6cmJ/Orp7Ne",
+	"wBiOca5qsMTZjJJx6zfT63MLk49uXZ9cn/vwl49u/ab5L3M3mlNz9bWH1Y9ng1uL0w9vrST8b1yJTsWb",
+	"4iYkWUp0loJ55RQevrzQkjLJ9CEES5LJvyJCql+HnuK6r64RUmH3blF9Vh489sc6V28kI3KJNKw6HC27",
+	"dv5OyBPa5iIdTH2MLJ3SFfm2pf9n6hPy/LB4hwGOKDKZ6RB8vUda+eBa+fccuA/wRtChr9EhCbeXFB7x",
+	"XCSNR0od8JHi6jrLLMhM6JovqwolBjJ7zMmEGjB6oGLYsWQL3E6+DzANq2MuudnwZ5exQEA0aiwR8NsA",
+	"uaLsk66mQPtIbqPQ4REyJBf320mtJcMt3vtgN+pll+2uQi7+jK0EK78Q71YbShbIKbvF8zsuNqesmdkN",
+	"mX6vkPQFcwAyk1y7AUQrfP0mOGXY+41ltJ1BPDFuCjqoPjJYxJFbJRcfc2SNL3uiYxiv4RHD+dHmvfPs",
+	"usUokS6VRJ6ZksJs1qiVTGb+9hnl97EXRfl90UYQ6e8SrqyahU6SzA8Sj1TGp29nnjepZIP+eD74LMKw",
+	"ElF38a3A8e87kNQ/RZr3MXufnVkSiYFf8Jwbn0TMnyyvk9bVs09iL7KcuLuYesScSyp3lNwVKeLStp6a",
+	"o0T24lX/qG1HkkjUEfIE10uon3c4dNMmL1fnKC897uPjXdQuF1eMMKaek+leOMdswBLIJVeu75DRO7Vh",
+	"9Lh7UycZTk8oXmyPagJy/eLtpj6+XkCtKSMNL1qt6Ze9+T34iXX5soMkoxXrkZifjncmA8nLijuDF7yz",
+	"VQXM0TMqMBwVPRQse1PUhthWgrUELflF0dSZkV5QUC8QlWYd3oNYri7jVWeFaT6IWoC2YF+cBNd+WImq",
+	"rqO8n0xIzGR6U0cHBPC9+tThARJk9YLn9QwDfkC4TsOMQpZclE4RlDxA4I1J/CohWqBSl1Werig02QAK",
+	"iRcWU1rYCg+htzTrNV2azKnUGWnJzwnEwMTx5mH1nUFejPyKN++5AaC+1rVzzwRP9MmGV56doybVhDsD",
+	"8uGYw0MXhXnQoTbk9+vWhhM6nPEnAbE1JnLvXMNE0gEqvYPJ6pjSWyDZanjfmQ51AckjHCeCsXMQJJEL",
+	"Q6LUigGBGKqWCw4lIZNJ0+VYDBiJegvgGBgqKfHibvsSMIPjIqiFGGgwzVlVYqDf5S7aS4AySwviDIjt",
+	"nEksVqQW2Ro4DsdH553gpyRokmDV8SVKBzN1Z8VZricp/dUZ5fOfAyRGCfOXSOt9mJ7jsuWK5nN7zT4H",
+	"9+98uQLU5llF2agiQG1Aly2R5C+RVXO2bKyNi/LbmE3vCbh3cTIUDMeTLA4f7oxMmIFMmLSNwnopQOEk",
+	"ej6Pc7RgNCn2YOngEoFjv09iAJVTlUR4dqP5wO6PCpfvczzh+Z0drFOQQOGkCgrmCk1vI0yaVOtweScr",
+	"CeqsF53FF/hoxR+qrQ4uYgAoUz9/jLMrZ4hxNqhafv4q+QU68lKIYaLEZuTIO7+I6ZvE2nrLdSZZYbpI",
+	"9K0BFP6+BBiJ8mJVlMKs0cjocEMAbUVdCaVCwEFkcpNrpIXRt3j4OnaKjhP6jbrsIvUp1TkwI8EJSvgY",
+	"gnfsLJTqOPjjJD940saCGgcgAG8thNYAuPrCHZku8vt40DQN1oFny4yRnUWXO+neyL8pkZyDzo2ztKb9",
+	"BAAZfx2q9WbkO90STtaMetfMktlxIpqVdAVsTAz0e4IR6nbUiTc5R16ZWkhrwc0xwiV7CyOIowDd2xCg",
+	"47xwFKG7YPMWknJiULMUux2kuJD72TPr9DkoD2tvKhLaegldm9AuQlnwdF0pI6eDSdeH4Qa5ZDGvfnSO",
+	"wi/DTfKf/yd29v/n68umpv+FhFC5zwVil3aXXB6n3AEeL4HzAGIS72bKkpUgCZzfxIGs9sMtvJpt5e2+",
+	"BZRI89fc/GCpUFEjKDmpHAQ8lDyyxKTwOZfFJFXNOWS14QlGAjewUvOCag0XRDgmv9pQ6naiGmP6qq7P",
+	"BsIMMEfFjT+N4sbzrcYbuqbOs/1WnZfFrTorq3VnZTUwSgYK2Ooy/mNP12rs8wT/gig/M46mXmEItPc3",
+	"XWtnGp7lfmqUJsd/Nvn+z6+8P5VbfReR4xRlcTiAj53+pXHiJYUiod/Eihi3jc65LO6zEmu3A0+2HBdQ",
+	"0uu25Qek6drkYdMblcmdR5kcijigg6ZAjiMYCek3qpMbDr0CNZpNIbw5PsqBrGnwNqQJPQx6sXYTalb4",
+	"xQDaZ2u54XCg8OKZ4fhCDjOB3Uhj1fMkVmpY0AZncygSxbpZwJKptKtjLLYFT4OMFyUiJce0t+QiyaIA",
+	"nmigpyBZAXAGd1Sx0EskREU2vfSIQ9FyLErWCjfTaBldjPYqg0o7yAp4NxZk0g/VZq/RwCtZJUwSqa7L",
+	"UsZgY4nYmlIGPS5rN3nRHcA/cqrwnusfT899NLMgODUrjsruzMeHd6ocLOm1RXCQ7vKL+weJxGN/GkEi",
+	"9YwoTs4vzxk/kxOSC+hlm0zPz5dv3525QZoe0eyUN52mdHV4CT1EVslFSO0U0y0cGYnUuFHUbtCo3buU",
+	"WJMfqRvpgafTA79NKTOZWkZx/a61Vku0TUhM8j/UyFRKyY/RxI5jSwiu2Bkn9Ltwi2+AKN0f29IQjrT+",
+	"O6GEQHgIcAF36H7MqjAHSDxPwBjIPsaOeM5GVCopF60W0LHusOmfU75Lltl/v1WvjwX2o0ByAKABV1n2",
+	"LBecvcZ92wpanj0RXRBY3oodxBc0LAdm1/LqRslYDYI1vzQxseIE43xs49VmQ7RRxhX3J/rFtZSV12ha",
+	"9iN2ok6fMV8U1D9BDc0VCXJorkDKvJOROfotfckz4F9H3ToOztsF0/LqsY5nucRa9pv1VmAT2JyX/Mvk",
+	"TvnmO5eEPlJcRorLuxYDjblPV3bdSEK7Szi8QVs5r4U684Gn3J9gxlNDICHrI6NfIdIAZNq0Y03jkCNS",
+	"PBfA/rSXsn3QhSPZP1HCy4loz8gCrkfYO0hrZ32Bvfji1tSY9K828Qu34hSpnohZqliLCtg7JiGFiKwQ",
+	"9SegR/RIBEHVtgwQXwX4LNHIfQ8YUDSnHXqUEVJcAPpOS+Q9U2m3vF7B+g/5jHwiVaxVmy146D/gwFzx",
+	"8T2MagIudxzBKhlrdSsADLyxwHOWMaTVWg48265oniZ+SjxVfhzXlRAoPPmESXU8k7rxuK16fdgxJKYE",
+	"B6tQRCder+vw3HnbW8QqmzR0wvI61oX1W4CrpmEFlaq1ZlXxzPPWVdBWB4fPnBm+mLU8pSklz3tKS89r",
+	"icezwHL66VfVR19NppCfnj5gaGljXoUQH3m+24bAtWFYQmAM/ZYd2zh/Dl0sTOxPXWwudrg5wWDf8Nzz",
+	"KqQI2o0l6tEDZtAdMuVkYJQaGVU73EgSJtxiLC/JWqNQhZ537kAKLvB6OBrQqD+/qx9s9elabRgjrmE3",
+	"lqNGCn6F10TxbansZvZR2HJ1p2rjBs+76Yp60wfNZdy4yrm31hmzLSzkF6MqujNuJiXY85smScSOcwxW",
+	"MdYChCpysNV8CgWoqH2uplM077eotdSQqZOLM9O3dO2b4qmeXwun5EJmt3N6tzX6rIZISkXbFgKzJrsg",
+	"MnDWS/EZgVzBCdqLVP/XUXRUx+IB6kPGJYNDKhR8wfRvIQPK8Ux+w6PgnayXyGBjcXZ8ch9wHE9e+8n6",
+	"h79WsU0TBMGAOpd20lUi2UFUjXYwDN1N9Hai7fDZkgsAbkcR2mqEP8IeC0ugUFJbFiDodDlqAKGgHmTJ",
+	"1sRguNSWDLYNtnU2+Ffw34MsTyoXvXypLkAAv68Kjo88q2pni45syRG9r2DKFEyUz/IJZrfNsrum0hq2",
+	"NJJ+3sf4UjMa0cV7IAeSosnhF8sHUxmyhIKQrg/5kZQtvv26/EX3h/xTflPIEfhnQbPq60gKcbMqKXvR",
+	"E7WrlWMhepkUaZUnZWt23c4N/v2poCIQJ13F5SWafKtwg0CZeYXzukrQJGPaDssnclFfuCOk0i6Wz4Vb",
+	"iSlCYBDFLBfKzHvGjq5anK+v7hsn9AcSNT0WE+BYXO20yGTFtLSdEpNm6hu9eE0QAYoNftARAc5yN66T",
+	"S6tlm/gOVG9l3AG88oB5F2M3Zlzw0I0VCIZ5wEQba/GcUJ2TqG0sfSS/fyW+QQHOiNu+83oRuBpdqHmq",
+	"xQ22P4fRK1RKS8qBqjLU7RWrup6rMSSflJ+OHzWKZdmE7GDqt7XmACT2YI91N0jFQE+jaVwIDlke2eG3",
+	"WiVW+Dgw2XvGvTNYEnBHWgjHw1THFM0Sb88EEIuXClI7v4zwYHTceCDs2tOqh8q4T6F0RXy5cyHuDLJq",
+	"+UQM9w06M+IOyymIxA63tdLnDyMtSUklAJqkhJeRKlhIFewKLG/5Wz15UwpjMUdUcZ/pdQTKFn0CdINP",
+	"SGI5DgjoAiJkp1fLkvjjfFoSeg+0f45EO9MjX4w040Ka8Q+KZllczeXRYf5PKuIJ139kaxo16mgRX4Lb",
+	"ac5q2L/EOrnhq9reGo/74DGIdP5R+N8x8p3QWrdHZulPt6luQa9x3knNbaSrWKP9a8IxcQLtjUE65JIz",
+	"apDLk1WLdL8lhZrf6nI1gH76HrPvSDvNf258+Bvryt3Wr6e5PRf1m3ci7UJKtVC/uGYaarbB+xn88I12",
+	"vuSzGsBPvcDPaL+KXvbkUzW5lM/6W+KwLVJ+O2Lzw3VpVHw8wOAx9x4hR44yFOJuIulN+DKyJIDsj8pF",
+	"/coobhJxuS/1cbmEh1ATmxsn9N8EfBarb2Y9kLkPDtn/Kd1+XLTsMp+iKqOW3OjReOcuLGj4jLsnWXsF",
+	"hfysd0HUfSvVXEFTvYVmLZdV82WVIWENRjuq0Thg0joOKR6kcU6Zr/QIV+K5qCxeci8l+zqEO8Sz3Fqz",
+	"YWKIlVzJQBNjMHF9XIumuC7ZqI2lLcOGW7g5fdkUNlY0YUaqKMTLUGmSoV3wgP5RWvQIFliwkg5y7mPa",
+	"k1+QhTh4essRotSy93AbvewsnSqeDmJ5Ryi4Bxg05pIlsVhjSy5PHO0iFkOk5YgTnIh3x4pMh7w3+fM8",
+	"H21ZPrBDeGp1kjcR+u2TCZRdTlIYK1b2uL05lNhRQHYUkD03L1zhfgjAheAWgO7qc1u//jenyijDlsiO",
+	"uxLr558Mgi59z0xpb8CAK+WZu7Mzv1KT0uBOdFjz3gXzZSIVGJD7TY8EqzZ2LiiR1hUO2eUT8e4nT8wz",
+	"dUF+d+YCZeSKPFtXpKwkSJhv2nyrbkJ1U+BvNQqwEGMZqu8PmmhFUnUD2LuEHkU7ui0hBWmxTVdmmDbW",
+	"GJ6J4CUR4AFMQ+wQkdMGCgR6O1ATFpYjw0D8HFH+cvUJLn9PrUlA7xtZm6g2PXtMH/YtkCmWeNrjMwrD",
+	"mokHvyNaBhguxzxv8yg2fzr0aKRm/JTzvs41n/wCU8k3srbvKMv8zLBzBVW5GB0o1JeGs9BLmeFxH+5b",
+	"9TqIj4pwO0fFe8Y9Vsomdd+7aqpNY9CtLj5V/MCzAntlHZNeLD+o1JtWzS4kq/oxbz7P88jxEfJjYEIM",
+	"Fea8d5aUvLjSohQigyav8FxzclIkKpGW+6nbfOgS8c2brzkaCddCNryq2mszVEdh7tMadpH7KwYvUE2r",
+	"pBl1SZMYlkBCikxvBUopqwAKmJ4PlT13XN60z6nj2HOjIYcMHIDDDdAOCwxETUakphvM+N8VQ+6iVn6J",
+	"A11CHfOhGeG1c1hz0RhZ3mUi5H5I28LdrvFZYBh8oBhFJBNEmzTRDE2lIu3Eydv0b2K75/V40RRt7bOz",
+	"0s1OQ4bQmD+dWoshFAfbrfkVKwaSnhqben9xcrKE//81PtnyudSIl4RhRHtB4tbJq8qtRRusRWPQFNXB",
+	"Ysm+jRiNwjANEOs4AFArxgIHLdmURSxm8BjE/03bXQlWjdKVa9c0l0qTelzw6YW9+eJC+S1mNPfTWdxT",
+	"Q1jcrdR5zuOEiR2Xmpz68wANitmBl8ubz1Xz4PSWsKHuB7ZH4iUZqR1vndrx3SBNk69dqNfmYouvFBmZ",
+	"buzF/s4Oq+xIIh4liSLil1v1TxfsYNaf5jZQPxBGlNyyfMyCz2BOZ87//DgHPtzgvop0Yl5SrYkMJihW",
+	"lpSbKLOCFRONhVhRxP29l4s4fwkegqwq7HjQuyp8kxygdptBBXHuTQT5TlV9ZdWDpzQKbZl1ri7wQWLR",
+	"htAEJOuX4+sM6JuWHhDJwuVms25bbj/HdCRG1dyy1FUN65EodZ6EvMDcjLJ4PBdSZRTtApSHwCHvsWml",
+	"nAs55O2fJZ0XHIhHkFFJ5JsCZV5O5wifZexRLY8dpLyIT79gumAmClJSjfINU5rsvdOCUGYfzfOFpuRr",
+	"USL2I6sa1NexLUjzfsxsLLdGFBSWiAAj7eSnXGn+Iyl8SaUTvoC2ZhCrfaXBD9E12uqyEG6GvpCrptRs",
+	"ZKNWYE+7tTJvQJqjrHzVTwPQdFJlgZK8ILrERgX8IuTBxb07XrGqvp4Guh60BHBAnLBOKjE0TUY3f86n",
+	"SDKTMeX8kZNFD4VfhOHo8J0Zbpo5CYJR81bhzVhyw42MnrnjhH4rbSgcYEToI94mHs8CiBmlQ0lKQzIF",
+	"jmSPo7Gl0mb5+xPZYNlIMLhtbmh3yhAaUlF/xsAugQvRT+5bTh1fi1UPa3Wratf6ZjGJCyvLcPRb1zDk",
+	"0XKjltqlT/jFV4S2U1yV7Kvr5JBYTOZxCjLXVDNVO/o2o7rDhpws53yktyXPOIFc7ozW5YX0HtYMRuzQ",
+	"Dy2n3vJsnToVL9vjwZ6Mt2FfG52SJi2njp7hRmFtUXT713EM5poON9O8qUd3Lw+sVhZTJjUHTtrVhjJ5",
+	"U+yqgt6rzH6cGX25RRaU3E1UC+I7CDT6SBn8qbuq3nH4Ie1h6pfjmMPgRQVGAo4mV+dcsYOZRyh9fDuv",
+	"dhvv/Ei+eNAqbnjCbO2sarjFjFaahmn4n9WNewmZm5dygPc+HpArDxgJwZcUYrd/YTpv5qKPjvpPvqRb",
+	"2D0k/D22B8Qjjpxhn1eADezzXrGlZpL9TjW/8s0eaaUZIr4+1R241rLlsOzk2JX3ldbATeiB14p189P0",
+	"DIoaAUWZPNayb7tVm43pXKPKSqgRB6yCvF9FMid6GA/A+RIkHkjVjqHdz4hDqoMpVh4t1cjOl/8u6hWR",
+	"xTZHvGl43jRf/rtwO3b75HjT+7c1zOVYTmOt6QWFc3H+FBkWrC6KdbBFn9Ldmbszc4vM9mA4XGCNPQ13",
+	"yKVxp+pfzq0a7saVGJlhSJMs3Ll1a7r8z2QsKnvFiBdQLULypd/JXW6ZTg9OqBOGpUH0KM7RGBBGkYmG",
+	"IwY9eGf2xjihf+AOpWN8GdYv0w5HLZb66oY7ItSHs7tULt+5OXMZu6VIjqs4YCDTEKmBS8b5VxwVRIwP",
+	"0FK3I0uYZz8xzxUDPYzzodg1LAyaQE0OdxA4jO00Ne54Z/F6rgNsVrdXhmnda9Vtt2Z54J+Z+Wh2rnT3",
+	"+vTNmbkb0+Ulb8nlX+Gugs93Zm+UHljsaWNT8A3fDiWZ58P3NxYXFqfLi7+4O33zzsw/3phenCkB75+a",
+	"mrzKfp6Zu5H+cep9+HFm7ob0TvwkD2qALKR4do/THLXH+9X8DfcmnCY4I+D2BjXvNW2fUWpQNIiLr8Bh",
+	"nGUA51IyJahfOFY8v5AQ+2PMAmQfShy3kzjAuUbrHPeBVXdqRKxMidQd1ybXSoRv2xIRl0CeGFmS9JYl",
+	"460AG0xy+Bcj780o0SiRZ6w5bamUI5BmOUlHkOTjXBcMLE+JYbAq2UpMhjwr624bJp7Tz5LIj/Kk75Zy",
+	"Rh03+Nl7Rhqt6lRSIf2qixcPb+F08/JJBWTxQP3kR870t4wdq9mOCgsm4dYZWLU/SqjR88wC9ROu7j4s",
+	"eUF1dp8eb+dH7Kk+4zh93BAxWgaVLqkyxbMOrg8UXj19pDTcUI17VomIaTopBy98fb4o4daKXyLwXMtx",
+	"edszElgro4y7UZD1px5k/QOrxBsiyEIuSZkemLkuV/91aedyP6GjKzvIljnnk+9eVOD0SXQ/hXS54FT1",
+	"i8vTOmNRosviPsh0t4/454h/vqUJ0adUzW9Zj26v2W5ZYNANikhrEt6uisdbeI+pCDhX3yAZPsbN4ffE",
+	"LOgrdovcFH7QAutxAj1yUtVVLMwTPTiZ55wX9FhIUWmYhk26HuBFxUT65scKkHlGd6DhfCepl74pgZLT",
+	"RP2nZK8w6KX4CPQGwQ47hZ2SJKhUyBwQRJkhUyObZSRz3yWbRRIUiazOgQTtk+i7x6KHBANCeWJGX7CL",
+	"pS/mW/V6WaTnSN/ffujanr/qrMlffmxb9WAVijj/3wARGlaSTVIBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -6,6 +6,7 @@ import (
 	"github.com/kimvlry/avito-internship-assignment/internal/delivery/http"
 	"github.com/kimvlry/avito-internship-assignment/internal/delivery/http/handler"
 	"github.com/kimvlry/avito-internship-assignment/internal/domain/service"
+	"github.com/kimvlry/avito-internship-assignment/internal/infrastructure/notify"
 	"github.com/kimvlry/avito-internship-assignment/internal/infrastructure/postgres"
 	"github.com/kimvlry/avito-internship-assignment/pkg/logger"
 	"log"
@@ -35,7 +36,18 @@ func main() {
 	}
 	defer repos.Close(db)
 
-	services := service.NewServices(repos.Team, repos.User, repos.PullRequest, repos.Transactor)
+	services := service.NewServices(
+		repos.Team,
+		repos.User,
+		repos.PullRequest,
+		repos.Ownership,
+		notify.LogNotifier{},
+		repos.Transactor,
+	)
+
+	workerCtx, stopWorker := context.WithCancel(ctx)
+	defer stopWorker()
+	go services.EscalationService.Run(workerCtx, cfg.Escalation.Interval)
 
 	handlers := handler.NewHandlers(services)
 	server := http.NewServer(cfg.Http, handlers)
@@ -52,6 +64,7 @@ func main() {
 	<-quit
 
 	log.Println("Shutting down server...")
+	stopWorker()
 
 	shutdownCtx, shutdownCancel := context.WithTimeout(ctx, 5*time.Second)
 	defer shutdownCancel()
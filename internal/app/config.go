@@ -16,8 +16,9 @@ const (
 type Config struct {
 	AppMode string `env:"APP_MODE" env-default:"dev"`
 
-	Postgres PostgresConfig
-	Http     HttpConfig
+	Postgres   PostgresConfig
+	Http       HttpConfig
+	Escalation EscalationConfig
 }
 
 type PostgresConfig struct {
@@ -35,6 +36,11 @@ type HttpConfig struct {
 	JwtSecret    string        `env:"JWT_SECRET" validate:"required"`
 }
 
+type EscalationConfig struct {
+	// Interval between searches for overdue reviews
+	Interval time.Duration `env:"ESCALATION_INTERVAL" env-default:"1m" validate:"required"`
+}
+
 func (h HttpConfig) Addr() string {
 	return ":" + HttpPort
 }
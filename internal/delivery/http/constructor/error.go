@@ -2,6 +2,7 @@ package constructor
 
 import (
     "github.com/kimvlry/avito-internship-assignment/api"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain"
 )
 
 func ErrorResponse(code api.ErrorResponseErrorCode, message string) struct {
@@ -16,3 +17,20 @@ func ErrorResponse(code api.ErrorResponseErrorCode, message string) struct {
         Message: message,
     }
 }
+
+// TeamChangeBlocked lists the OPEN reviews next to the usual error body
+func TeamChangeBlocked(err *domain.TeamChangeBlockedError) api.TeamChangeBlocked {
+    reviews := make([]api.BlockingReview, 0, len(err.Reviews))
+    for _, review := range err.Reviews {
+        reviews = append(reviews, api.BlockingReview{
+            UserId:        review.UserID,
+            PullRequestId: review.PullRequestID,
+        })
+    }
+
+    var response api.TeamChangeBlocked
+    response.Error.Code = api.OPENREVIEWS
+    response.Error.Message = err.Error()
+    response.BlockingReviews = reviews
+    return response
+}
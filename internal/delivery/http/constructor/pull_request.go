@@ -0,0 +1,132 @@
+package constructor
+
+import (
+    "time"
+
+    "github.com/kimvlry/avito-internship-assignment/api"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
+)
+
+func PullRequest(pr *entity.PullRequest) api.PullRequest {
+    fallbackReviewers := pr.FallbackReviewers
+    if fallbackReviewers == nil {
+        fallbackReviewers = []string{}
+    }
+
+    return api.PullRequest{
+        PullRequestId:     pr.ID,
+        PullRequestName:   pr.Name,
+        Description:       optional(pr.Description),
+        Url:               optional(pr.URL),
+        SourceBranch:      optional(pr.SourceBranch),
+        TargetBranch:      optional(pr.TargetBranch),
+        ExternalId:        optional(pr.ExternalID),
+        AuthorId:          pr.AuthorID,
+        Status:            api.PullRequestStatus(pr.Status),
+        AssignedReviewers: pr.AssignedReviewers,
+        FallbackReviewers: &fallbackReviewers,
+        CreatedAt:         &pr.CreatedAt,
+        MergedAt:          pr.MergedAt,
+        ClosedAt:          pr.ClosedAt,
+        Reviews:           reviews(pr.Reviews),
+        MergeOverride:     mergeOverride(pr.MergeOverride),
+    }
+}
+
+func mergeOverride(override *entity.MergeOverride) *api.MergeOverride {
+    if override == nil {
+        return nil
+    }
+    return &api.MergeOverride{
+        By:              override.By,
+        UnmetConditions: override.Unmet,
+    }
+}
+
+func PullRequestShort(pr *entity.PullRequest) api.PullRequestShort {
+    return api.PullRequestShort{
+        PullRequestId:   pr.ID,
+        PullRequestName: pr.Name,
+        AuthorId:        pr.AuthorID,
+        Status:          api.PullRequestShortStatus(pr.Status),
+        CreatedAt:       &pr.CreatedAt,
+    }
+}
+
+// ReviewAssignment is the PR as seen by the reviewer it was read for
+// ReviewAssignment lists absences of the PR's reviewers out of those looked up for all assignments
+func ReviewAssignment(pr *entity.PullRequest, now time.Time, absences map[string][]entity.Unavailability) api.ReviewAssignment {
+    reviewerAbsences := make([]api.Unavailability, 0)
+    for _, reviewerID := range pr.AssignedReviewers {
+        for _, period := range absences[reviewerID] {
+            reviewerAbsences = append(reviewerAbsences, Unavailability(period))
+        }
+    }
+
+    return api.ReviewAssignment{
+        PullRequestId:    pr.ID,
+        PullRequestName:  pr.Name,
+        AuthorId:         pr.AuthorID,
+        Status:           api.ReviewAssignmentStatus(pr.Status),
+        CreatedAt:        &pr.CreatedAt,
+        DueAt:            pr.ReviewDueAt,
+        Overdue:          pr.IsReviewOverdue(now),
+        ReviewerAbsences: &reviewerAbsences,
+    }
+}
+
+func Unavailability(period entity.Unavailability) api.Unavailability {
+    return api.Unavailability{
+        UnavailabilityId: period.ID,
+        UserId:           period.UserID,
+        StartsAt:         period.StartsAt,
+        EndsAt:           period.EndsAt,
+        Reason:           period.Reason,
+        ExternalUid:      optional(period.ExternalUID),
+    }
+}
+
+func ReviewerEvent(event entity.ReviewerEvent) api.ReviewerEvent {
+    var verdict *api.ReviewerEventVerdict
+    if event.Verdict != "" {
+        v := api.ReviewerEventVerdict(event.Verdict)
+        verdict = &v
+    }
+
+    return api.ReviewerEvent{
+        EventId:            event.ID,
+        Type:               api.ReviewerEventType(event.Type),
+        ReviewerId:         optional(event.ReviewerID),
+        PreviousReviewerId: optional(event.PreviousReviewerID),
+        ActorId:            optional(event.ActorID),
+        Reason:             event.Reason,
+        Verdict:            verdict,
+        CreatedAt:          event.CreatedAt,
+    }
+}
+
+// reviews omits the field for PRs read without their verdicts
+func reviews(prReviews []entity.Review) *[]api.Review {
+    if prReviews == nil {
+        return nil
+    }
+
+    result := make([]api.Review, 0, len(prReviews))
+    for _, review := range prReviews {
+        result = append(result, api.Review{
+            ReviewerId:  review.ReviewerID,
+            Verdict:     api.ReviewVerdict(review.Verdict),
+            Comment:     optional(review.Comment),
+            SubmittedAt: review.SubmittedAt,
+        })
+    }
+    return &result
+}
+
+// optional omits empty strings from the response
+func optional(value string) *string {
+    if value == "" {
+        return nil
+    }
+    return &value
+}
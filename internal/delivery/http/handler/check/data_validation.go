@@ -3,7 +3,10 @@ package check
 import (
     "fmt"
     "github.com/kimvlry/avito-internship-assignment/api"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
+    "net/url"
     "strings"
+    "unicode"
 )
 
 type ValidationError struct {
@@ -25,6 +28,142 @@ func ValidPullRequestCreate(req api.PostPullRequestCreateRequestObject) error {
     if strings.TrimSpace(req.Body.AuthorId) == "" {
         return ValidationError{"author_id", "empty"}
     }
+    if req.Body.Draft != nil && *req.Body.Draft &&
+        (req.Body.ChangedPaths != nil || req.Body.RequiredTags != nil || req.Body.Reviewers != nil) {
+        return ValidationError{"draft", "reviewer options of a draft are passed to /pullRequest/ready"}
+    }
+    return validReviewerOptions(req.Body.ChangedPaths, req.Body.Reviewers)
+}
+
+func ValidPullRequestReady(req api.PostPullRequestReadyRequestObject) error {
+    if strings.TrimSpace(req.Body.PullRequestId) == "" {
+        return ValidationError{"pull_request_id", "empty"}
+    }
+    return validReviewerOptions(req.Body.ChangedPaths, req.Body.Reviewers)
+}
+
+func validReviewerOptions(changedPaths, reviewers *[]string) error {
+    if changedPaths != nil {
+        for _, path := range *changedPaths {
+            if strings.TrimSpace(path) == "" {
+                return ValidationError{"changed_paths", "contains empty path"}
+            }
+        }
+    }
+    if reviewers != nil {
+        seen := make(map[string]struct{}, len(*reviewers))
+        for _, reviewer := range *reviewers {
+            if strings.TrimSpace(reviewer) == "" {
+                return ValidationError{"reviewers", "contains empty user_id"}
+            }
+            if _, ok := seen[reviewer]; ok {
+                return ValidationError{"reviewers", fmt.Sprintf("%s listed twice", reviewer)}
+            }
+            seen[reviewer] = struct{}{}
+        }
+    }
+    return nil
+}
+
+func ValidPullRequestReassign(req api.PostPullRequestReassignRequestObject) error {
+    if strings.TrimSpace(req.Body.PullRequestId) == "" {
+        return ValidationError{"pull_request_id", "empty"}
+    }
+    if strings.TrimSpace(req.Body.OldUserId) == "" {
+        return ValidationError{"old_user_id", "empty"}
+    }
+    if req.Body.NewUserId != nil && strings.TrimSpace(*req.Body.NewUserId) == "" {
+        return ValidationError{"new_user_id", "empty"}
+    }
+    return nil
+}
+
+func ValidPullRequestList(req api.GetPullRequestListRequestObject) error {
+    if req.Params.Status != nil {
+        for _, status := range *req.Params.Status {
+            if !entity.PullRequestStatus(status).IsValid() {
+                return ValidationError{"status", fmt.Sprintf("unknown status %s", status)}
+            }
+        }
+    }
+    if req.Params.Limit != nil && (*req.Params.Limit < 1 || *req.Params.Limit > 100) {
+        return ValidationError{"limit", "must be between 1 and 100"}
+    }
+    if req.Params.CreatedFrom != nil && req.Params.CreatedTo != nil &&
+        !req.Params.CreatedFrom.Before(*req.Params.CreatedTo) {
+        return ValidationError{"created_from", "must be before created_to"}
+    }
+    return nil
+}
+
+func ValidPullRequestSearch(req api.GetPullRequestSearchRequestObject) error {
+    if !strings.ContainsFunc(req.Params.Q, func(r rune) bool {
+        return unicode.IsLetter(r) || unicode.IsDigit(r)
+    }) {
+        return ValidationError{"q", "must contain at least one word"}
+    }
+    if req.Params.Status != nil {
+        for _, status := range *req.Params.Status {
+            if !entity.PullRequestStatus(status).IsValid() {
+                return ValidationError{"status", fmt.Sprintf("unknown status %s", status)}
+            }
+        }
+    }
+    if req.Params.Limit != nil && (*req.Params.Limit < 1 || *req.Params.Limit > 100) {
+        return ValidationError{"limit", "must be between 1 and 100"}
+    }
+    return nil
+}
+
+func ValidPullRequestUpdate(req api.PostPullRequestUpdateRequestObject) error {
+    if strings.TrimSpace(req.Body.PullRequestId) == "" {
+        return ValidationError{"pull_request_id", "empty"}
+    }
+    if req.Body.PullRequestName != nil && strings.TrimSpace(*req.Body.PullRequestName) == "" {
+        return ValidationError{"pull_request_name", "empty"}
+    }
+    if req.Body.Url != nil && *req.Body.Url != "" {
+        parsed, err := url.Parse(*req.Body.Url)
+        if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+            return ValidationError{"url", "must be an absolute http(s) URL"}
+        }
+    }
+    if req.Body.SourceBranch != nil && strings.ContainsFunc(*req.Body.SourceBranch, unicode.IsSpace) {
+        return ValidationError{"source_branch", "cannot contain whitespace"}
+    }
+    if req.Body.TargetBranch != nil && strings.ContainsFunc(*req.Body.TargetBranch, unicode.IsSpace) {
+        return ValidationError{"target_branch", "cannot contain whitespace"}
+    }
+    return nil
+}
+
+func ValidPullRequestAddReviewer(req api.PostPullRequestAddReviewerRequestObject) error {
+    if strings.TrimSpace(req.Body.PullRequestId) == "" {
+        return ValidationError{"pull_request_id", "empty"}
+    }
+    if req.Body.UserId != nil && strings.TrimSpace(*req.Body.UserId) == "" {
+        return ValidationError{"user_id", "empty"}
+    }
+    return nil
+}
+
+func ValidPullRequestRemoveReviewer(req api.PostPullRequestRemoveReviewerRequestObject) error {
+    if strings.TrimSpace(req.Body.PullRequestId) == "" {
+        return ValidationError{"pull_request_id", "empty"}
+    }
+    if strings.TrimSpace(req.Body.UserId) == "" {
+        return ValidationError{"user_id", "empty"}
+    }
+    return nil
+}
+
+func ValidSubmitReview(req api.PostPullRequestSubmitReviewRequestObject) error {
+    if strings.TrimSpace(req.Body.PullRequestId) == "" {
+        return ValidationError{"pull_request_id", "empty"}
+    }
+    if !entity.ReviewVerdict(req.Body.Verdict).IsValid() {
+        return ValidationError{"verdict", "must be APPROVED or CHANGES_REQUESTED"}
+    }
     return nil
 }
 
@@ -32,6 +171,160 @@ func ValidTeamCreate(req api.PostTeamAddRequestObject) error {
     if strings.TrimSpace(req.Body.TeamName) == "" {
         return ValidationError{"team_name", "cannot be empty"}
     }
+    if req.Body.ReviewerStrategy != nil && !entity.ReviewerStrategy(*req.Body.ReviewerStrategy).IsValid() {
+        return ValidationError{"reviewer_strategy", "unknown strategy"}
+    }
+    if req.Body.MinReviewers != nil && *req.Body.MinReviewers < 0 {
+        return ValidationError{"min_reviewers", "cannot be negative"}
+    }
+    if req.Body.MaxReviewers != nil && *req.Body.MaxReviewers < 1 {
+        return ValidationError{"max_reviewers", "must be at least 1"}
+    }
+    if req.Body.DefaultMaxOpenReviews != nil && *req.Body.DefaultMaxOpenReviews < 0 {
+        return ValidationError{"default_max_open_reviews", "cannot be negative"}
+    }
+    if req.Body.RequiredApprovals != nil && *req.Body.RequiredApprovals < 0 {
+        return ValidationError{"required_approvals", "cannot be negative"}
+    }
+    return nil
+}
+
+func ValidTeamUpdate(req api.PostTeamUpdateRequestObject) error {
+    if strings.TrimSpace(req.Body.TeamName) == "" {
+        return ValidationError{"team_name", "cannot be empty"}
+    }
+    if req.Body.ReviewerStrategy != nil && !entity.ReviewerStrategy(*req.Body.ReviewerStrategy).IsValid() {
+        return ValidationError{"reviewer_strategy", "unknown strategy"}
+    }
+    if req.Body.MinReviewers != nil && *req.Body.MinReviewers < 0 {
+        return ValidationError{"min_reviewers", "cannot be negative"}
+    }
+    if req.Body.MaxReviewers != nil && *req.Body.MaxReviewers < 1 {
+        return ValidationError{"max_reviewers", "must be at least 1"}
+    }
+    if req.Body.DefaultMaxOpenReviews != nil && *req.Body.DefaultMaxOpenReviews < 0 {
+        return ValidationError{"default_max_open_reviews", "cannot be negative"}
+    }
+    if req.Body.RequiredApprovals != nil && *req.Body.RequiredApprovals < 0 {
+        return ValidationError{"required_approvals", "cannot be negative"}
+    }
+    return nil
+}
+
+func ValidTeamList(req api.GetTeamListRequestObject) error {
+    if req.Params.Limit != nil && (*req.Params.Limit < 1 || *req.Params.Limit > 100) {
+        return ValidationError{"limit", "must be between 1 and 100"}
+    }
+    return nil
+}
+
+func ValidTeamAddMember(req api.PostTeamAddMemberRequestObject) error {
+    if strings.TrimSpace(req.Body.TeamName) == "" {
+        return ValidationError{"team_name", "cannot be empty"}
+    }
+    if len(req.Body.Members) == 0 {
+        return ValidationError{"members", "cannot be empty"}
+    }
+    for _, member := range req.Body.Members {
+        if err := ValidUserID(member.UserId); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+func ValidTeamRemoveMember(req api.PostTeamRemoveMemberRequestObject) error {
+    if strings.TrimSpace(req.Body.TeamName) == "" {
+        return ValidationError{"team_name", "cannot be empty"}
+    }
+    return ValidUserID(req.Body.UserId)
+}
+
+func ValidTeamRename(req api.PostTeamRenameRequestObject) error {
+    if strings.TrimSpace(req.Body.TeamName) == "" {
+        return ValidationError{"team_name", "cannot be empty"}
+    }
+    if strings.TrimSpace(req.Body.NewTeamName) == "" {
+        return ValidationError{"new_team_name", "cannot be empty"}
+    }
+    if req.Body.NewTeamName == req.Body.TeamName {
+        return ValidationError{"new_team_name", "must differ from team_name"}
+    }
+    return nil
+}
+
+func ValidTeamDelete(req api.PostTeamDeleteRequestObject) error {
+    if strings.TrimSpace(req.Body.TeamName) == "" {
+        return ValidationError{"team_name", "cannot be empty"}
+    }
+    if req.Body.MoveMembersTo != nil && *req.Body.MoveMembersTo == req.Body.TeamName {
+        return ValidationError{"move_members_to", "must differ from team_name"}
+    }
+    return nil
+}
+
+func ValidBulkSetIsActive(req api.PostUsersBulkSetIsActiveRequestObject) error {
+    hasIDs := req.Body.UserIds != nil && len(*req.Body.UserIds) > 0
+    hasTeam := req.Body.TeamName != nil && strings.TrimSpace(*req.Body.TeamName) != ""
+    if hasIDs == hasTeam {
+        return ValidationError{"user_ids", "exactly one of user_ids and team_name is required"}
+    }
+    if !hasIDs {
+        return nil
+    }
+    if len(*req.Body.UserIds) > 1000 {
+        return ValidationError{"user_ids", "must contain at most 1000 ids"}
+    }
+    for _, id := range *req.Body.UserIds {
+        if strings.TrimSpace(id) == "" {
+            return ValidationError{"user_ids", "contains empty id"}
+        }
+    }
+    return nil
+}
+
+func ValidSetExpertise(req api.PostUsersSetExpertiseRequestObject) error {
+    if strings.TrimSpace(req.Body.UserId) == "" {
+        return ValidationError{"user_id", "cannot be empty"}
+    }
+    for _, tag := range req.Body.Tags {
+        if strings.TrimSpace(tag) == "" {
+            return ValidationError{"tags", "contains empty tag"}
+        }
+    }
+    return nil
+}
+
+func ValidSetMaxOpenReviews(req api.PostUsersSetMaxOpenReviewsRequestObject) error {
+    if strings.TrimSpace(req.Body.UserId) == "" {
+        return ValidationError{"user_id", "cannot be empty"}
+    }
+    if req.Body.MaxOpenReviews != nil && *req.Body.MaxOpenReviews < 1 {
+        return ValidationError{"max_open_reviews", "must be at least 1"}
+    }
+    return nil
+}
+
+func ValidAddUnavailability(req api.PostUsersAddUnavailabilityRequestObject) error {
+    if strings.TrimSpace(req.Body.UserId) == "" {
+        return ValidationError{"user_id", "cannot be empty"}
+    }
+    if !req.Body.EndsAt.After(req.Body.StartsAt) {
+        return ValidationError{"ends_at", "must be after starts_at"}
+    }
+    if req.Body.Reason != nil && len(*req.Body.Reason) > 255 {
+        return ValidationError{"reason", "must be at most 255 characters"}
+    }
+    return nil
+}
+
+func ValidImportUnavailability(req api.PostUsersImportUnavailabilityRequestObject) error {
+    if strings.TrimSpace(req.Body.UserId) == "" {
+        return ValidationError{"user_id", "cannot be empty"}
+    }
+    if strings.TrimSpace(req.Body.Calendar) == "" {
+        return ValidationError{"calendar", "cannot be empty"}
+    }
     return nil
 }
 
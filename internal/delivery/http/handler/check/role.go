@@ -8,3 +8,8 @@ import (
 func IsAdmin(ctx context.Context) bool {
     return middleware.IsAdmin(ctx)
 }
+
+// CallerID returns the user_id of the token the request was made with
+func CallerID(ctx context.Context) string {
+    return middleware.GetUserID(ctx)
+}
@@ -10,6 +10,7 @@ type Handlers struct {
     *pullRequestHandler
     *teamHandler
     *userHandler
+    *ownershipHandler
     *statsHandler
 }
 
@@ -20,6 +21,7 @@ func NewHandlers(services *service.Services) *Handlers {
         newPullRequestHandler(services.PullRequestService),
         newTeamHandler(services.TeamService),
         newUserHandler(services.UserService),
+        newOwnershipHandler(services.OwnershipService),
         newStatsHandler(services.StatsService),
     }
 }
@@ -0,0 +1,92 @@
+package handler
+
+import (
+    "context"
+    "errors"
+
+    "github.com/kimvlry/avito-internship-assignment/api"
+    "github.com/kimvlry/avito-internship-assignment/internal/delivery/http/constructor"
+    "github.com/kimvlry/avito-internship-assignment/internal/delivery/http/handler/check"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/service"
+)
+
+type ownershipHandler struct {
+    svc *service.Ownership
+}
+
+func newOwnershipHandler(svc *service.Ownership) *ownershipHandler {
+    return &ownershipHandler{svc: svc}
+}
+
+func (h *ownershipHandler) PostOwnershipUpload(
+    ctx context.Context,
+    req api.PostOwnershipUploadRequestObject,
+) (api.PostOwnershipUploadResponseObject, error) {
+
+    if !check.IsAdmin(ctx) {
+        return api.PostOwnershipUpload404JSONResponse{
+            Error: constructor.ErrorResponse(api.NOTFOUND, "resource not found"),
+        }, nil
+    }
+
+    rules, err := h.svc.UploadRules(ctx, req.Body.Content)
+    if err != nil {
+        if errors.Is(err, domain.ErrInvalidOwnershipRules) {
+            return api.PostOwnershipUpload400JSONResponse{
+                Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+            }, nil
+        }
+        return api.PostOwnershipUpload500JSONResponse{
+            Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+        }, nil
+    }
+
+    return api.PostOwnershipUpload200JSONResponse{
+        Rules: toApiOwnershipRules(rules),
+    }, nil
+}
+
+func (h *ownershipHandler) GetOwnershipGet(
+    ctx context.Context,
+    _ api.GetOwnershipGetRequestObject,
+) (api.GetOwnershipGetResponseObject, error) {
+
+    if !check.IsAdmin(ctx) {
+        return api.GetOwnershipGet404JSONResponse{
+            Error: constructor.ErrorResponse(api.NOTFOUND, "resource not found"),
+        }, nil
+    }
+
+    rules, err := h.svc.GetRules(ctx)
+    if err != nil {
+        return api.GetOwnershipGet500JSONResponse{
+            Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+        }, nil
+    }
+
+    return api.GetOwnershipGet200JSONResponse{
+        Rules: toApiOwnershipRules(rules),
+    }, nil
+}
+
+func toApiOwnershipRules(rules []entity.OwnershipRule) []api.OwnershipRule {
+    res := make([]api.OwnershipRule, 0, len(rules))
+    for _, rule := range rules {
+        users := rule.UserIDs
+        if users == nil {
+            users = []string{}
+        }
+        teams := rule.TeamNames
+        if teams == nil {
+            teams = []string{}
+        }
+        res = append(res, api.OwnershipRule{
+            Pattern: rule.Pattern,
+            Users:   users,
+            Teams:   teams,
+        })
+    }
+    return res
+}
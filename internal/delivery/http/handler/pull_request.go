@@ -2,12 +2,17 @@ package handler
 
 import (
     "context"
+    "encoding/base64"
     "errors"
     "github.com/kimvlry/avito-internship-assignment/internal/delivery/http/handler/check"
+    "strings"
+    "time"
 
     "github.com/kimvlry/avito-internship-assignment/api"
     "github.com/kimvlry/avito-internship-assignment/internal/delivery/http/constructor"
     "github.com/kimvlry/avito-internship-assignment/internal/domain"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/repository"
     "github.com/kimvlry/avito-internship-assignment/internal/domain/service"
 )
 
@@ -40,6 +45,7 @@ func (h *pullRequestHandler) PostPullRequestCreate(
         req.Body.PullRequestId,
         req.Body.PullRequestName,
         req.Body.AuthorId,
+        createOptions(req),
     )
     if err != nil {
         switch {
@@ -47,10 +53,18 @@ func (h *pullRequestHandler) PostPullRequestCreate(
             return api.PostPullRequestCreate404JSONResponse{
                 Error: constructor.ErrorResponse(api.NOTFOUND, err.Error()),
             }, nil
+        case errors.Is(err, domain.ErrInvalidReviewerLimits):
+            return api.PostPullRequestCreate400JSONResponse{
+                Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+            }, nil
         case errors.Is(err, domain.ErrPullRequestAlreadyExists):
             return api.PostPullRequestCreate409JSONResponse{
                 Error: constructor.ErrorResponse(api.PREXISTS, err.Error()),
             }, nil
+        case errors.Is(err, domain.ErrNoReviewerCandidate):
+            return api.PostPullRequestCreate409JSONResponse{
+                Error: constructor.ErrorResponse(api.NOCANDIDATE, err.Error()),
+            }, nil
         default:
             return api.PostPullRequestCreate500JSONResponse{
                 Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
@@ -58,16 +72,187 @@ func (h *pullRequestHandler) PostPullRequestCreate(
         }
     }
 
+    responsePr := constructor.PullRequest(pr)
     return api.PostPullRequestCreate201JSONResponse{
-        Pr: &api.PullRequest{
-            PullRequestId:     pr.ID,
-            PullRequestName:   pr.Name,
-            AuthorId:          pr.AuthorID,
-            Status:            api.PullRequestStatus(pr.Status),
-            AssignedReviewers: pr.AssignedReviewers,
-            CreatedAt:         &pr.CreatedAt,
-            MergedAt:          pr.MergedAt,
-        },
+        Pr: &responsePr,
+    }, nil
+}
+
+func (h *pullRequestHandler) PostPullRequestUpdate(
+    ctx context.Context,
+    req api.PostPullRequestUpdateRequestObject,
+) (api.PostPullRequestUpdateResponseObject, error) {
+    if !check.IsAdmin(ctx) {
+        return api.PostPullRequestUpdate404JSONResponse{
+            Error: constructor.ErrorResponse(api.NOTFOUND, "resource not found"),
+        }, nil
+    }
+
+    if err := check.ValidPullRequestUpdate(req); err != nil {
+        return api.PostPullRequestUpdate400JSONResponse{
+            Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+        }, nil
+    }
+
+    pr, err := h.svc.UpdatePullRequest(ctx, req.Body.PullRequestId, service.PullRequestUpdate{
+        Name:         req.Body.PullRequestName,
+        Description:  req.Body.Description,
+        URL:          req.Body.Url,
+        SourceBranch: req.Body.SourceBranch,
+        TargetBranch: req.Body.TargetBranch,
+        ExternalID:   req.Body.ExternalId,
+    })
+    if err != nil {
+        switch {
+        case errors.Is(err, domain.ErrPullRequestNotFound):
+            return api.PostPullRequestUpdate404JSONResponse{
+                Error: constructor.ErrorResponse(api.NOTFOUND, err.Error()),
+            }, nil
+        case errors.Is(err, domain.ErrPullRequestIsMerged):
+            return api.PostPullRequestUpdate409JSONResponse{
+                Error: constructor.ErrorResponse(api.PRMERGED, err.Error()),
+            }, nil
+        case errors.Is(err, domain.ErrPullRequestNotOpen):
+            return api.PostPullRequestUpdate409JSONResponse{
+                Error: constructor.ErrorResponse(api.PRNOTOPEN, err.Error()),
+            }, nil
+        default:
+            return api.PostPullRequestUpdate500JSONResponse{
+                Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+            }, nil
+        }
+    }
+
+    responsePr := constructor.PullRequest(pr)
+    return api.PostPullRequestUpdate200JSONResponse{
+        Pr: &responsePr,
+    }, nil
+}
+
+func (h *pullRequestHandler) GetPullRequestList(
+    ctx context.Context,
+    req api.GetPullRequestListRequestObject,
+) (api.GetPullRequestListResponseObject, error) {
+    if !callerParticipates(ctx, listFilter(req.Params)) {
+        return api.GetPullRequestList404JSONResponse{
+            Error: constructor.ErrorResponse(api.NOTFOUND, "resource not found"),
+        }, nil
+    }
+
+    if err := check.ValidPullRequestList(req); err != nil {
+        return api.GetPullRequestList400JSONResponse{
+            Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+        }, nil
+    }
+
+    var after *repository.PullRequestCursor
+    if req.Params.Cursor != nil {
+        cursor, err := decodeCursor(*req.Params.Cursor)
+        if err != nil {
+            return api.GetPullRequestList400JSONResponse{
+                Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+            }, nil
+        }
+        after = cursor
+    }
+
+    limit := defaultPageLimit
+    if req.Params.Limit != nil {
+        limit = *req.Params.Limit
+    }
+
+    prs, next, err := h.svc.ListPullRequests(ctx, listFilter(req.Params), after, limit)
+    if err != nil {
+        return api.GetPullRequestList500JSONResponse{
+            Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+        }, nil
+    }
+
+    items := make([]api.PullRequestShort, 0, len(prs))
+    for _, pr := range prs {
+        items = append(items, constructor.PullRequestShort(pr))
+    }
+
+    response := api.GetPullRequestList200JSONResponse{PullRequests: items}
+    if next != nil {
+        cursor := encodeCursor(next)
+        response.NextCursor = &cursor
+    }
+    return response, nil
+}
+
+func (h *pullRequestHandler) GetPullRequestSearch(
+    ctx context.Context,
+    req api.GetPullRequestSearchRequestObject,
+) (api.GetPullRequestSearchResponseObject, error) {
+    if !callerParticipates(ctx, searchFilter(req.Params)) {
+        return api.GetPullRequestSearch404JSONResponse{
+            Error: constructor.ErrorResponse(api.NOTFOUND, "resource not found"),
+        }, nil
+    }
+
+    if err := check.ValidPullRequestSearch(req); err != nil {
+        return api.GetPullRequestSearch400JSONResponse{
+            Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+        }, nil
+    }
+
+    limit := defaultPageLimit
+    if req.Params.Limit != nil {
+        limit = *req.Params.Limit
+    }
+
+    hits, err := h.svc.SearchPullRequests(ctx, req.Params.Q, searchFilter(req.Params), limit)
+    if err != nil {
+        if errors.Is(err, domain.ErrInvalidSearchQuery) {
+            return api.GetPullRequestSearch400JSONResponse{
+                Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+            }, nil
+        }
+        return api.GetPullRequestSearch500JSONResponse{
+            Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+        }, nil
+    }
+
+    results := make([]api.PullRequestSearchHit, 0, len(hits))
+    for _, hit := range hits {
+        results = append(results, api.PullRequestSearchHit{
+            Pr:        constructor.PullRequestShort(hit.PullRequest),
+            Rank:      hit.Rank,
+            Highlight: hit.Highlight,
+        })
+    }
+    return api.GetPullRequestSearch200JSONResponse{Results: results}, nil
+}
+
+func (h *pullRequestHandler) GetPullRequestHistory(
+    ctx context.Context,
+    req api.GetPullRequestHistoryRequestObject,
+) (api.GetPullRequestHistoryResponseObject, error) {
+    var viewerId string
+    if !check.IsAdmin(ctx) {
+        viewerId = check.CallerID(ctx)
+    }
+
+    events, err := h.svc.GetHistory(ctx, req.Params.PullRequestId, viewerId)
+    if err != nil {
+        if errors.Is(err, domain.ErrPullRequestNotFound) {
+            return api.GetPullRequestHistory404JSONResponse{
+                Error: constructor.ErrorResponse(api.NOTFOUND, "resource not found"),
+            }, nil
+        }
+        return api.GetPullRequestHistory500JSONResponse{
+            Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+        }, nil
+    }
+
+    result := make([]api.ReviewerEvent, 0, len(events))
+    for _, event := range events {
+        result = append(result, constructor.ReviewerEvent(event))
+    }
+    return api.GetPullRequestHistory200JSONResponse{
+        PullRequestId: req.Params.PullRequestId,
+        Events:        result,
     }, nil
 }
 
@@ -81,28 +266,38 @@ func (h *pullRequestHandler) PostPullRequestMerge(
         }, nil
     }
 
-    pr, err := h.svc.Merge(ctx, req.Body.PullRequestId)
+    opts := service.MergeOptions{ActorID: check.CallerID(ctx)}
+    if req.Body.Override != nil {
+        opts.Override = *req.Body.Override
+    }
+
+    pr, err := h.svc.Merge(ctx, req.Body.PullRequestId, opts)
     if err != nil {
-        if errors.Is(err, domain.ErrPullRequestNotFound) {
+        switch {
+        case errors.Is(err, domain.ErrPullRequestNotFound),
+            errors.Is(err, domain.ErrUserNotFound),
+            errors.Is(err, domain.ErrTeamNotFound):
             return api.PostPullRequestMerge404JSONResponse{
                 Error: constructor.ErrorResponse(api.NOTFOUND, err.Error()),
             }, nil
+        case errors.Is(err, domain.ErrInvalidStatusTransition):
+            return api.PostPullRequestMerge409JSONResponse{
+                Error: constructor.ErrorResponse(api.INVALIDTRANSITION, err.Error()),
+            }, nil
+        case errors.Is(err, domain.ErrMergePolicyNotSatisfied):
+            return api.PostPullRequestMerge409JSONResponse{
+                Error: constructor.ErrorResponse(api.MERGEBLOCKED, err.Error()),
+            }, nil
+        default:
+            return api.PostPullRequestMerge500JSONResponse{
+                Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+            }, nil
         }
-        return api.PostPullRequestMerge500JSONResponse{
-            Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
-        }, nil
     }
 
+    responsePr := constructor.PullRequest(pr)
     return api.PostPullRequestMerge200JSONResponse{
-        Pr: &api.PullRequest{
-            PullRequestId:     pr.ID,
-            PullRequestName:   pr.Name,
-            AuthorId:          pr.AuthorID,
-            Status:            api.PullRequestStatus(pr.Status),
-            AssignedReviewers: pr.AssignedReviewers,
-            CreatedAt:         &pr.CreatedAt,
-            MergedAt:          pr.MergedAt,
-        },
+        Pr: &responsePr,
     }, nil
 }
 
@@ -116,7 +311,18 @@ func (h *pullRequestHandler) PostPullRequestReassign(
         }, nil
     }
 
-    pr, newID, err := h.svc.ReassignReviewer(ctx, req.Body.PullRequestId, req.Body.OldUserId)
+    if err := check.ValidPullRequestReassign(req); err != nil {
+        return api.PostPullRequestReassign400JSONResponse{
+            Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+        }, nil
+    }
+
+    var newUserID string
+    if req.Body.NewUserId != nil {
+        newUserID = *req.Body.NewUserId
+    }
+
+    pr, newID, err := h.svc.ReassignReviewer(ctx, req.Body.PullRequestId, req.Body.OldUserId, newUserID)
     if err != nil {
         switch {
         case errors.Is(err, domain.ErrPullRequestNotFound), errors.Is(err, domain.ErrUserNotFound):
@@ -127,6 +333,10 @@ func (h *pullRequestHandler) PostPullRequestReassign(
             return api.PostPullRequestReassign409JSONResponse{
                 Error: constructor.ErrorResponse(api.PRMERGED, err.Error()),
             }, nil
+        case errors.Is(err, domain.ErrPullRequestNotOpen):
+            return api.PostPullRequestReassign409JSONResponse{
+                Error: constructor.ErrorResponse(api.PRNOTOPEN, err.Error()),
+            }, nil
         case errors.Is(err, domain.ErrReviewerNotAssigned):
             return api.PostPullRequestReassign409JSONResponse{
                 Error: constructor.ErrorResponse(api.NOTASSIGNED, err.Error()),
@@ -143,15 +353,402 @@ func (h *pullRequestHandler) PostPullRequestReassign(
     }
 
     return api.PostPullRequestReassign200JSONResponse{
-        Pr: api.PullRequest{
-            PullRequestId:     pr.ID,
-            PullRequestName:   pr.Name,
-            AuthorId:          pr.AuthorID,
-            Status:            api.PullRequestStatus(pr.Status),
-            AssignedReviewers: pr.AssignedReviewers,
-            CreatedAt:         &pr.CreatedAt,
-            MergedAt:          pr.MergedAt,
-        },
+        Pr:         constructor.PullRequest(pr),
         ReplacedBy: newID,
     }, nil
 }
+
+func (h *pullRequestHandler) PostPullRequestReady(
+    ctx context.Context,
+    req api.PostPullRequestReadyRequestObject,
+) (api.PostPullRequestReadyResponseObject, error) {
+    if !check.IsAdmin(ctx) {
+        return api.PostPullRequestReady404JSONResponse{
+            Error: constructor.ErrorResponse(api.NOTFOUND, "resource not found"),
+        }, nil
+    }
+
+    if err := check.ValidPullRequestReady(req); err != nil {
+        return api.PostPullRequestReady400JSONResponse{
+            Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+        }, nil
+    }
+
+    pr, err := h.svc.MarkReady(ctx, req.Body.PullRequestId, readyOptions(req))
+    if err != nil {
+        switch {
+        case errors.Is(err, domain.ErrPullRequestNotFound),
+            errors.Is(err, domain.ErrUserNotFound),
+            errors.Is(err, domain.ErrTeamNotFound):
+            return api.PostPullRequestReady404JSONResponse{
+                Error: constructor.ErrorResponse(api.NOTFOUND, err.Error()),
+            }, nil
+        case errors.Is(err, domain.ErrInvalidReviewerLimits):
+            return api.PostPullRequestReady400JSONResponse{
+                Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+            }, nil
+        case errors.Is(err, domain.ErrInvalidStatusTransition):
+            return api.PostPullRequestReady409JSONResponse{
+                Error: constructor.ErrorResponse(api.INVALIDTRANSITION, err.Error()),
+            }, nil
+        case errors.Is(err, domain.ErrNoReviewerCandidate):
+            return api.PostPullRequestReady409JSONResponse{
+                Error: constructor.ErrorResponse(api.NOCANDIDATE, err.Error()),
+            }, nil
+        default:
+            return api.PostPullRequestReady500JSONResponse{
+                Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+            }, nil
+        }
+    }
+
+    responsePr := constructor.PullRequest(pr)
+    return api.PostPullRequestReady200JSONResponse{
+        Pr: &responsePr,
+    }, nil
+}
+
+func (h *pullRequestHandler) PostPullRequestClose(
+    ctx context.Context,
+    req api.PostPullRequestCloseRequestObject,
+) (api.PostPullRequestCloseResponseObject, error) {
+    if !check.IsAdmin(ctx) {
+        return api.PostPullRequestClose404JSONResponse{
+            Error: constructor.ErrorResponse(api.NOTFOUND, "resource not found"),
+        }, nil
+    }
+
+    pr, err := h.svc.Close(ctx, req.Body.PullRequestId)
+    if err != nil {
+        switch {
+        case errors.Is(err, domain.ErrPullRequestNotFound):
+            return api.PostPullRequestClose404JSONResponse{
+                Error: constructor.ErrorResponse(api.NOTFOUND, err.Error()),
+            }, nil
+        case errors.Is(err, domain.ErrInvalidStatusTransition):
+            return api.PostPullRequestClose409JSONResponse{
+                Error: constructor.ErrorResponse(api.INVALIDTRANSITION, err.Error()),
+            }, nil
+        default:
+            return api.PostPullRequestClose500JSONResponse{
+                Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+            }, nil
+        }
+    }
+
+    responsePr := constructor.PullRequest(pr)
+    return api.PostPullRequestClose200JSONResponse{
+        Pr: &responsePr,
+    }, nil
+}
+
+func (h *pullRequestHandler) PostPullRequestReopen(
+    ctx context.Context,
+    req api.PostPullRequestReopenRequestObject,
+) (api.PostPullRequestReopenResponseObject, error) {
+    if !check.IsAdmin(ctx) {
+        return api.PostPullRequestReopen404JSONResponse{
+            Error: constructor.ErrorResponse(api.NOTFOUND, "resource not found"),
+        }, nil
+    }
+
+    pr, err := h.svc.Reopen(ctx, req.Body.PullRequestId)
+    if err != nil {
+        switch {
+        case errors.Is(err, domain.ErrPullRequestNotFound),
+            errors.Is(err, domain.ErrUserNotFound),
+            errors.Is(err, domain.ErrTeamNotFound):
+            return api.PostPullRequestReopen404JSONResponse{
+                Error: constructor.ErrorResponse(api.NOTFOUND, err.Error()),
+            }, nil
+        case errors.Is(err, domain.ErrInvalidStatusTransition):
+            return api.PostPullRequestReopen409JSONResponse{
+                Error: constructor.ErrorResponse(api.INVALIDTRANSITION, err.Error()),
+            }, nil
+        case errors.Is(err, domain.ErrNoReviewerCandidate):
+            return api.PostPullRequestReopen409JSONResponse{
+                Error: constructor.ErrorResponse(api.NOCANDIDATE, err.Error()),
+            }, nil
+        default:
+            return api.PostPullRequestReopen500JSONResponse{
+                Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+            }, nil
+        }
+    }
+
+    responsePr := constructor.PullRequest(pr)
+    return api.PostPullRequestReopen200JSONResponse{
+        Pr: &responsePr,
+    }, nil
+}
+
+func (h *pullRequestHandler) PostPullRequestAddReviewer(
+    ctx context.Context,
+    req api.PostPullRequestAddReviewerRequestObject,
+) (api.PostPullRequestAddReviewerResponseObject, error) {
+    if !check.IsAdmin(ctx) {
+        return api.PostPullRequestAddReviewer404JSONResponse{
+            Error: constructor.ErrorResponse(api.NOTFOUND, "resource not found"),
+        }, nil
+    }
+
+    if err := check.ValidPullRequestAddReviewer(req); err != nil {
+        return api.PostPullRequestAddReviewer400JSONResponse{
+            Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+        }, nil
+    }
+
+    var userID string
+    if req.Body.UserId != nil {
+        userID = *req.Body.UserId
+    }
+
+    pr, addedID, err := h.svc.AddReviewer(ctx, req.Body.PullRequestId, userID)
+    if err != nil {
+        switch {
+        case errors.Is(err, domain.ErrPullRequestNotFound),
+            errors.Is(err, domain.ErrUserNotFound),
+            errors.Is(err, domain.ErrTeamNotFound):
+            return api.PostPullRequestAddReviewer404JSONResponse{
+                Error: constructor.ErrorResponse(api.NOTFOUND, err.Error()),
+            }, nil
+        case errors.Is(err, domain.ErrPullRequestIsMerged):
+            return api.PostPullRequestAddReviewer409JSONResponse{
+                Error: constructor.ErrorResponse(api.PRMERGED, err.Error()),
+            }, nil
+        case errors.Is(err, domain.ErrPullRequestNotOpen):
+            return api.PostPullRequestAddReviewer409JSONResponse{
+                Error: constructor.ErrorResponse(api.PRNOTOPEN, err.Error()),
+            }, nil
+        case errors.Is(err, domain.ErrReviewerLimitReached):
+            return api.PostPullRequestAddReviewer409JSONResponse{
+                Error: constructor.ErrorResponse(api.REVIEWERLIMIT, err.Error()),
+            }, nil
+        case errors.Is(err, domain.ErrNoReviewerCandidate):
+            return api.PostPullRequestAddReviewer409JSONResponse{
+                Error: constructor.ErrorResponse(api.NOCANDIDATE, err.Error()),
+            }, nil
+        default:
+            return api.PostPullRequestAddReviewer500JSONResponse{
+                Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+            }, nil
+        }
+    }
+
+    return api.PostPullRequestAddReviewer200JSONResponse{
+        Pr:    constructor.PullRequest(pr),
+        Added: addedID,
+    }, nil
+}
+
+func (h *pullRequestHandler) PostPullRequestRemoveReviewer(
+    ctx context.Context,
+    req api.PostPullRequestRemoveReviewerRequestObject,
+) (api.PostPullRequestRemoveReviewerResponseObject, error) {
+    if !check.IsAdmin(ctx) {
+        return api.PostPullRequestRemoveReviewer404JSONResponse{
+            Error: constructor.ErrorResponse(api.NOTFOUND, "resource not found"),
+        }, nil
+    }
+
+    if err := check.ValidPullRequestRemoveReviewer(req); err != nil {
+        return api.PostPullRequestRemoveReviewer400JSONResponse{
+            Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+        }, nil
+    }
+
+    pr, err := h.svc.RemoveReviewer(ctx, req.Body.PullRequestId, req.Body.UserId)
+    if err != nil {
+        switch {
+        case errors.Is(err, domain.ErrPullRequestNotFound):
+            return api.PostPullRequestRemoveReviewer404JSONResponse{
+                Error: constructor.ErrorResponse(api.NOTFOUND, err.Error()),
+            }, nil
+        case errors.Is(err, domain.ErrPullRequestIsMerged):
+            return api.PostPullRequestRemoveReviewer409JSONResponse{
+                Error: constructor.ErrorResponse(api.PRMERGED, err.Error()),
+            }, nil
+        case errors.Is(err, domain.ErrPullRequestNotOpen):
+            return api.PostPullRequestRemoveReviewer409JSONResponse{
+                Error: constructor.ErrorResponse(api.PRNOTOPEN, err.Error()),
+            }, nil
+        case errors.Is(err, domain.ErrReviewerNotAssigned):
+            return api.PostPullRequestRemoveReviewer409JSONResponse{
+                Error: constructor.ErrorResponse(api.NOTASSIGNED, err.Error()),
+            }, nil
+        default:
+            return api.PostPullRequestRemoveReviewer500JSONResponse{
+                Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+            }, nil
+        }
+    }
+
+    responsePr := constructor.PullRequest(pr)
+    return api.PostPullRequestRemoveReviewer200JSONResponse{
+        Pr: &responsePr,
+    }, nil
+}
+
+func (h *pullRequestHandler) PostPullRequestSubmitReview(
+    ctx context.Context,
+    req api.PostPullRequestSubmitReviewRequestObject,
+) (api.PostPullRequestSubmitReviewResponseObject, error) {
+    if err := check.ValidSubmitReview(req); err != nil {
+        return api.PostPullRequestSubmitReview400JSONResponse{
+            Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+        }, nil
+    }
+
+    var comment string
+    if req.Body.Comment != nil {
+        comment = *req.Body.Comment
+    }
+
+    pr, err := h.svc.SubmitReview(
+        ctx,
+        req.Body.PullRequestId,
+        check.CallerID(ctx),
+        entity.ReviewVerdict(req.Body.Verdict),
+        comment,
+    )
+    if err != nil {
+        switch {
+        case errors.Is(err, domain.ErrPullRequestNotFound):
+            return api.PostPullRequestSubmitReview404JSONResponse{
+                Error: constructor.ErrorResponse(api.NOTFOUND, err.Error()),
+            }, nil
+        case errors.Is(err, domain.ErrInvalidReviewVerdict):
+            return api.PostPullRequestSubmitReview400JSONResponse{
+                Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+            }, nil
+        case errors.Is(err, domain.ErrReviewerNotAssigned):
+            return api.PostPullRequestSubmitReview403JSONResponse{
+                Error: constructor.ErrorResponse(api.NOTASSIGNED, err.Error()),
+            }, nil
+        case errors.Is(err, domain.ErrPullRequestIsMerged):
+            return api.PostPullRequestSubmitReview409JSONResponse{
+                Error: constructor.ErrorResponse(api.PRMERGED, err.Error()),
+            }, nil
+        case errors.Is(err, domain.ErrPullRequestNotOpen):
+            return api.PostPullRequestSubmitReview409JSONResponse{
+                Error: constructor.ErrorResponse(api.PRNOTOPEN, err.Error()),
+            }, nil
+        default:
+            return api.PostPullRequestSubmitReview500JSONResponse{
+                Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+            }, nil
+        }
+    }
+
+    responsePr := constructor.PullRequest(pr)
+    return api.PostPullRequestSubmitReview200JSONResponse{
+        Pr: &responsePr,
+    }, nil
+}
+
+func createOptions(req api.PostPullRequestCreateRequestObject) service.CreateOptions {
+    var opts service.CreateOptions
+    if req.Body.ChangedPaths != nil {
+        opts.ChangedPaths = *req.Body.ChangedPaths
+    }
+    if req.Body.RequiredTags != nil {
+        opts.RequiredTags = *req.Body.RequiredTags
+    }
+    if req.Body.Reviewers != nil {
+        opts.Reviewers = *req.Body.Reviewers
+    }
+    if req.Body.Draft != nil {
+        opts.Draft = *req.Body.Draft
+    }
+    return opts
+}
+
+func readyOptions(req api.PostPullRequestReadyRequestObject) service.CreateOptions {
+    var opts service.CreateOptions
+    if req.Body.ChangedPaths != nil {
+        opts.ChangedPaths = *req.Body.ChangedPaths
+    }
+    if req.Body.RequiredTags != nil {
+        opts.RequiredTags = *req.Body.RequiredTags
+    }
+    if req.Body.Reviewers != nil {
+        opts.Reviewers = *req.Body.Reviewers
+    }
+    return opts
+}
+
+const defaultPageLimit = 50
+
+// callerParticipates reports whether the caller is an admin
+// or limited the filter to the PRs they author or review
+func callerParticipates(ctx context.Context, filter repository.PullRequestFilter) bool {
+    if check.IsAdmin(ctx) {
+        return true
+    }
+    callerId := check.CallerID(ctx)
+    return callerId != "" && (filter.AuthorID == callerId || filter.ReviewerID == callerId)
+}
+
+func listFilter(params api.GetPullRequestListParams) repository.PullRequestFilter {
+    var filter repository.PullRequestFilter
+    if params.Status != nil {
+        for _, status := range *params.Status {
+            filter.Statuses = append(filter.Statuses, entity.PullRequestStatus(status))
+        }
+    }
+    if params.AuthorId != nil {
+        filter.AuthorID = *params.AuthorId
+    }
+    if params.ReviewerId != nil {
+        filter.ReviewerID = *params.ReviewerId
+    }
+    if params.TeamName != nil {
+        filter.TeamName = *params.TeamName
+    }
+    filter.CreatedFrom = params.CreatedFrom
+    filter.CreatedTo = params.CreatedTo
+    return filter
+}
+
+func searchFilter(params api.GetPullRequestSearchParams) repository.PullRequestFilter {
+    var filter repository.PullRequestFilter
+    if params.Status != nil {
+        for _, status := range *params.Status {
+            filter.Statuses = append(filter.Statuses, entity.PullRequestStatus(status))
+        }
+    }
+    if params.AuthorId != nil {
+        filter.AuthorID = *params.AuthorId
+    }
+    if params.ReviewerId != nil {
+        filter.ReviewerID = *params.ReviewerId
+    }
+    if params.TeamName != nil {
+        filter.TeamName = *params.TeamName
+    }
+    return filter
+}
+
+// encodeCursor makes an opaque page token out of the position of the last PR of a page
+func encodeCursor(cursor *repository.PullRequestCursor) string {
+    raw := cursor.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + cursor.ID
+    return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(token string) (*repository.PullRequestCursor, error) {
+    malformed := check.ValidationError{Field: "cursor", Message: "malformed"}
+
+    raw, err := base64.RawURLEncoding.DecodeString(token)
+    if err != nil {
+        return nil, malformed
+    }
+    createdAt, id, ok := strings.Cut(string(raw), "|")
+    if !ok || id == "" {
+        return nil, malformed
+    }
+    parsed, err := time.Parse(time.RFC3339Nano, createdAt)
+    if err != nil {
+        return nil, malformed
+    }
+    return &repository.PullRequestCursor{CreatedAt: parsed, ID: id}, nil
+}
@@ -19,15 +19,44 @@ func (h *statsHandler) GetStatsAssignments(ctx context.Context, request api.GetS
     if err != nil {
         return nil, err
     }
+    teamStats, err := h.statsSvc.GetTeamAssignmentStats(ctx, userStats)
+    if err != nil {
+        return nil, err
+    }
 
     resp := api.GetStatsAssignments200JSONResponse{
         ByUser: func() *[]api.AssignmentCountPerUser {
             res := make([]api.AssignmentCountPerUser, 0, len(userStats))
             for _, stat := range userStats {
-                res = append(res, api.AssignmentCountPerUser{
+                item := api.AssignmentCountPerUser{
                     UserId:        stat.UserID,
                     AssignedCount: stat.AssignedPRs,
-                })
+                    OpenCount:     stat.OpenPRs,
+                    AtCapacity:    stat.AtCapacity,
+                }
+                if stat.Capacity > 0 {
+                    capacity := stat.Capacity
+                    item.MaxOpenReviews = &capacity
+                }
+                res = append(res, item)
+            }
+            return &res
+        }(),
+        ByTeam: func() *[]api.AssignmentCountPerTeam {
+            res := make([]api.AssignmentCountPerTeam, 0, len(teamStats))
+            for _, stat := range teamStats {
+                item := api.AssignmentCountPerTeam{
+                    TeamName:             stat.TeamName,
+                    AssignedCount:        stat.AssignedPRs,
+                    OpenCount:            stat.OpenPRs,
+                    SubtreeAssignedCount: stat.SubtreeAssignedPRs,
+                    SubtreeOpenCount:     stat.SubtreeOpenPRs,
+                }
+                if stat.ParentName != "" {
+                    parent := stat.ParentName
+                    item.ParentTeamName = &parent
+                }
+                res = append(res, item)
             }
             return &res
         }(),
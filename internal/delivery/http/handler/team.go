@@ -2,6 +2,7 @@ package handler
 
 import (
     "context"
+    "encoding/base64"
     "errors"
     "github.com/kimvlry/avito-internship-assignment/internal/delivery/http/handler/check"
 
@@ -40,6 +41,51 @@ func (h *teamHandler) PostTeamAdd(
     }
 
     team := &entity.Team{Name: req.Body.TeamName}
+    if req.Body.ReviewerStrategy != nil {
+        team.ReviewerStrategy = entity.ReviewerStrategy(*req.Body.ReviewerStrategy)
+    }
+    if req.Body.MinReviewers != nil {
+        team.MinReviewers = *req.Body.MinReviewers
+    }
+    if req.Body.MaxReviewers != nil {
+        team.MaxReviewers = *req.Body.MaxReviewers
+    }
+    if req.Body.FallbackTeams != nil {
+        team.FallbackTeams = *req.Body.FallbackTeams
+    }
+    if req.Body.DefaultMaxOpenReviews != nil {
+        team.DefaultMaxOpenReviews = *req.Body.DefaultMaxOpenReviews
+    }
+    if req.Body.RequiredApprovals != nil {
+        team.MergePolicy.RequiredApprovals = *req.Body.RequiredApprovals
+    }
+    if req.Body.BlockOnChangesRequested != nil {
+        team.MergePolicy.BlockOnChangesRequested = *req.Body.BlockOnChangesRequested
+    }
+    if req.Body.ReviewSlaHours != nil {
+        team.ReviewSLA.Hours = *req.Body.ReviewSlaHours
+    }
+    if req.Body.ReviewSlaBusinessHours != nil {
+        team.ReviewSLA.BusinessHours = *req.Body.ReviewSlaBusinessHours
+    }
+    if req.Body.ReviewSlaWorkdayStart != nil {
+        team.ReviewSLA.WorkdayStart = *req.Body.ReviewSlaWorkdayStart
+    }
+    if req.Body.ReviewSlaWorkdayEnd != nil {
+        team.ReviewSLA.WorkdayEnd = *req.Body.ReviewSlaWorkdayEnd
+    }
+    if req.Body.ReviewSlaTimezone != nil {
+        team.ReviewSLA.TimeZone = *req.Body.ReviewSlaTimezone
+    }
+    if req.Body.EscalationPolicy != nil {
+        team.ReviewSLA.Escalation = entity.EscalationPolicy(*req.Body.EscalationPolicy)
+    }
+    if req.Body.LeadId != nil {
+        team.LeadID = *req.Body.LeadId
+    }
+    if req.Body.ParentTeamName != nil {
+        team.ParentName = *req.Body.ParentTeamName
+    }
     members := make([]entity.User, 0, len(req.Body.Members))
     for _, m := range req.Body.Members {
         members = append(members, entity.User{
@@ -51,30 +97,33 @@ func (h *teamHandler) PostTeamAdd(
 
     createdTeam, err := h.svc.CreateTeam(ctx, team, members)
     if err != nil {
-        if errors.Is(err, domain.ErrTeamAlreadyExists) {
+        switch {
+        case errors.Is(err, domain.ErrTeamAlreadyExists):
             return api.PostTeamAdd400JSONResponse{
                 Error: constructor.ErrorResponse(api.TEAMEXISTS, err.Error()),
             }, nil
+        case errors.Is(err, domain.ErrInvalidReviewerStrategy),
+            errors.Is(err, domain.ErrInvalidReviewerLimits),
+            errors.Is(err, domain.ErrInvalidFallbackTeams),
+            errors.Is(err, domain.ErrInvalidReviewCapacity),
+            errors.Is(err, domain.ErrInvalidMergePolicy),
+            errors.Is(err, domain.ErrInvalidReviewSLA),
+            errors.Is(err, domain.ErrInvalidParentTeam),
+            errors.Is(err, domain.ErrTeamNotFound),
+            errors.Is(err, domain.ErrUserNotFound):
+            return api.PostTeamAdd400JSONResponse{
+                Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+            }, nil
+        default:
+            return api.PostTeamAdd500JSONResponse{
+                Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+            }, nil
         }
-        return api.PostTeamAdd500JSONResponse{
-            Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
-        }, nil
-    }
-
-    responseMembers := make([]api.TeamMember, 0, len(createdTeam.Members))
-    for _, m := range createdTeam.Members {
-        responseMembers = append(responseMembers, api.TeamMember{
-            UserId:   m.ID,
-            Username: m.Username,
-            IsActive: m.IsActive,
-        })
     }
 
+    responseTeam := toApiTeam(createdTeam, createdTeam.Members)
     return api.PostTeamAdd201JSONResponse{
-        Team: &api.Team{
-            TeamName: createdTeam.Name,
-            Members:  responseMembers,
-        },
+        Team: &responseTeam,
     }, nil
 }
 
@@ -95,8 +144,318 @@ func (h *teamHandler) GetTeamGet(
         }, nil
     }
 
-    apiMembers := make([]api.TeamMember, 0, len(users))
-    for _, m := range users {
+    return api.GetTeamGet200JSONResponse(toApiTeam(team, users)), nil
+}
+
+func (h *teamHandler) GetTeamList(
+    ctx context.Context,
+    req api.GetTeamListRequestObject,
+) (api.GetTeamListResponseObject, error) {
+
+    if err := check.ValidTeamList(req); err != nil {
+        return api.GetTeamList400JSONResponse{
+            Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+        }, nil
+    }
+
+    var after string
+    if req.Params.Cursor != nil {
+        name, err := base64.RawURLEncoding.DecodeString(*req.Params.Cursor)
+        if err != nil || len(name) == 0 {
+            return api.GetTeamList400JSONResponse{
+                Error: constructor.ErrorResponse(api.BADREQUEST, check.ValidationError{Field: "cursor", Message: "malformed"}.Error()),
+            }, nil
+        }
+        after = string(name)
+    }
+
+    limit := defaultPageLimit
+    if req.Params.Limit != nil {
+        limit = *req.Params.Limit
+    }
+
+    teams, next, err := h.svc.ListTeams(ctx, after, limit)
+    if err != nil {
+        return api.GetTeamList500JSONResponse{
+            Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+        }, nil
+    }
+
+    items := make([]api.TeamSummary, 0, len(teams))
+    for _, team := range teams {
+        items = append(items, api.TeamSummary{
+            TeamName:          team.Name,
+            MemberCount:       team.MemberCount,
+            ActiveMemberCount: team.ActiveMemberCount,
+            OpenReviews:       team.OpenReviews,
+        })
+    }
+
+    response := api.GetTeamList200JSONResponse{Teams: items}
+    if next != "" {
+        cursor := base64.RawURLEncoding.EncodeToString([]byte(next))
+        response.NextCursor = &cursor
+    }
+    return response, nil
+}
+
+func (h *teamHandler) PostTeamUpdate(
+    ctx context.Context,
+    req api.PostTeamUpdateRequestObject,
+) (api.PostTeamUpdateResponseObject, error) {
+
+    if !check.IsAdmin(ctx) {
+        return api.PostTeamUpdate401JSONResponse{
+            Error: constructor.ErrorResponse("UNAUTHORIZED", "admin access required"),
+        }, nil
+    }
+
+    if err := check.ValidTeamUpdate(req); err != nil {
+        return api.PostTeamUpdate400JSONResponse{
+            Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+        }, nil
+    }
+
+    var update service.TeamUpdate
+    if req.Body.ReviewerStrategy != nil {
+        strategy := entity.ReviewerStrategy(*req.Body.ReviewerStrategy)
+        update.ReviewerStrategy = &strategy
+    }
+    update.MinReviewers = req.Body.MinReviewers
+    update.MaxReviewers = req.Body.MaxReviewers
+    update.FallbackTeams = req.Body.FallbackTeams
+    update.DefaultMaxOpenReviews = req.Body.DefaultMaxOpenReviews
+    update.RequiredApprovals = req.Body.RequiredApprovals
+    update.BlockOnChangesRequested = req.Body.BlockOnChangesRequested
+    update.ReviewSLAHours = req.Body.ReviewSlaHours
+    update.ReviewSLABusinessHours = req.Body.ReviewSlaBusinessHours
+    update.ReviewSLAWorkdayStart = req.Body.ReviewSlaWorkdayStart
+    update.ReviewSLAWorkdayEnd = req.Body.ReviewSlaWorkdayEnd
+    update.ReviewSLATimeZone = req.Body.ReviewSlaTimezone
+    if req.Body.EscalationPolicy != nil {
+        policy := entity.EscalationPolicy(*req.Body.EscalationPolicy)
+        update.EscalationPolicy = &policy
+    }
+    update.LeadID = req.Body.LeadId
+    update.ParentName = req.Body.ParentTeamName
+
+    team, err := h.svc.UpdateTeam(ctx, req.Body.TeamName, update)
+    if err != nil {
+        switch {
+        case errors.Is(err, domain.ErrTeamNotFound),
+            errors.Is(err, domain.ErrUserNotFound):
+            return api.PostTeamUpdate404JSONResponse{
+                Error: constructor.ErrorResponse(api.NOTFOUND, err.Error()),
+            }, nil
+        case errors.Is(err, domain.ErrInvalidReviewerStrategy),
+            errors.Is(err, domain.ErrInvalidReviewerLimits),
+            errors.Is(err, domain.ErrInvalidFallbackTeams),
+            errors.Is(err, domain.ErrInvalidReviewCapacity),
+            errors.Is(err, domain.ErrInvalidMergePolicy),
+            errors.Is(err, domain.ErrInvalidReviewSLA),
+            errors.Is(err, domain.ErrInvalidParentTeam):
+            return api.PostTeamUpdate400JSONResponse{
+                Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+            }, nil
+        default:
+            return api.PostTeamUpdate500JSONResponse{
+                Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+            }, nil
+        }
+    }
+
+    responseTeam := toApiTeam(team, team.Members)
+    return api.PostTeamUpdate200JSONResponse{
+        Team: &responseTeam,
+    }, nil
+}
+
+func (h *teamHandler) PostTeamAddMember(
+    ctx context.Context,
+    req api.PostTeamAddMemberRequestObject,
+) (api.PostTeamAddMemberResponseObject, error) {
+
+    if !check.IsAdmin(ctx) {
+        return api.PostTeamAddMember401JSONResponse{
+            Error: constructor.ErrorResponse("UNAUTHORIZED", "admin access required"),
+        }, nil
+    }
+
+    if err := check.ValidTeamAddMember(req); err != nil {
+        return api.PostTeamAddMember400JSONResponse{
+            Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+        }, nil
+    }
+
+    members := make([]entity.User, 0, len(req.Body.Members))
+    for _, m := range req.Body.Members {
+        members = append(members, entity.User{
+            ID:       m.UserId,
+            Username: m.Username,
+            IsActive: m.IsActive,
+        })
+    }
+
+    team, err := h.svc.AddMembers(ctx, req.Body.TeamName, members)
+    if err != nil {
+        switch {
+        case errors.Is(err, domain.ErrTeamNotFound):
+            return api.PostTeamAddMember404JSONResponse{
+                Error: constructor.ErrorResponse(api.NOTFOUND, err.Error()),
+            }, nil
+        default:
+            return api.PostTeamAddMember500JSONResponse{
+                Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+            }, nil
+        }
+    }
+
+    return api.PostTeamAddMember200JSONResponse{
+        Team: toApiTeam(team, team.Members),
+    }, nil
+}
+
+func (h *teamHandler) PostTeamRemoveMember(
+    ctx context.Context,
+    req api.PostTeamRemoveMemberRequestObject,
+) (api.PostTeamRemoveMemberResponseObject, error) {
+
+    if !check.IsAdmin(ctx) {
+        return api.PostTeamRemoveMember401JSONResponse{
+            Error: constructor.ErrorResponse("UNAUTHORIZED", "admin access required"),
+        }, nil
+    }
+
+    if err := check.ValidTeamRemoveMember(req); err != nil {
+        return api.PostTeamRemoveMember400JSONResponse{
+            Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+        }, nil
+    }
+
+    team, err := h.svc.RemoveMember(ctx, req.Body.TeamName, req.Body.UserId)
+    if err != nil {
+        var blocked *domain.TeamChangeBlockedError
+        switch {
+        case errors.As(err, &blocked):
+            return api.PostTeamRemoveMember409JSONResponse(constructor.TeamChangeBlocked(blocked)), nil
+        case errors.Is(err, domain.ErrTeamNotFound),
+            errors.Is(err, domain.ErrUserNotFound),
+            errors.Is(err, domain.ErrUserNotInTeam):
+            return api.PostTeamRemoveMember404JSONResponse{
+                Error: constructor.ErrorResponse(api.NOTFOUND, err.Error()),
+            }, nil
+        default:
+            return api.PostTeamRemoveMember500JSONResponse{
+                Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+            }, nil
+        }
+    }
+
+    return api.PostTeamRemoveMember200JSONResponse{
+        Team: toApiTeam(team, team.Members),
+    }, nil
+}
+
+func (h *teamHandler) PostTeamRename(
+    ctx context.Context,
+    req api.PostTeamRenameRequestObject,
+) (api.PostTeamRenameResponseObject, error) {
+
+    if !check.IsAdmin(ctx) {
+        return api.PostTeamRename401JSONResponse{
+            Error: constructor.ErrorResponse("UNAUTHORIZED", "admin access required"),
+        }, nil
+    }
+
+    if err := check.ValidTeamRename(req); err != nil {
+        return api.PostTeamRename400JSONResponse{
+            Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+        }, nil
+    }
+
+    team, err := h.svc.RenameTeam(ctx, req.Body.TeamName, req.Body.NewTeamName)
+    if err != nil {
+        switch {
+        case errors.Is(err, domain.ErrTeamNotFound):
+            return api.PostTeamRename404JSONResponse{
+                Error: constructor.ErrorResponse(api.NOTFOUND, err.Error()),
+            }, nil
+        case errors.Is(err, domain.ErrTeamAlreadyExists):
+            return api.PostTeamRename409JSONResponse{
+                Error: constructor.ErrorResponse(api.TEAMEXISTS, err.Error()),
+            }, nil
+        default:
+            return api.PostTeamRename500JSONResponse{
+                Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+            }, nil
+        }
+    }
+
+    return api.PostTeamRename200JSONResponse{
+        Team: toApiTeam(team, team.Members),
+    }, nil
+}
+
+func (h *teamHandler) PostTeamDelete(
+    ctx context.Context,
+    req api.PostTeamDeleteRequestObject,
+) (api.PostTeamDeleteResponseObject, error) {
+
+    if !check.IsAdmin(ctx) {
+        return api.PostTeamDelete401JSONResponse{
+            Error: constructor.ErrorResponse("UNAUTHORIZED", "admin access required"),
+        }, nil
+    }
+
+    if err := check.ValidTeamDelete(req); err != nil {
+        return api.PostTeamDelete400JSONResponse{
+            Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+        }, nil
+    }
+
+    var moveMembersTo string
+    if req.Body.MoveMembersTo != nil {
+        moveMembersTo = *req.Body.MoveMembersTo
+    }
+
+    moved, err := h.svc.DeleteTeam(ctx, req.Body.TeamName, moveMembersTo)
+    if err != nil {
+        var blocked *domain.TeamChangeBlockedError
+        switch {
+        case errors.As(err, &blocked):
+            return api.PostTeamDelete409JSONResponse(constructor.TeamChangeBlocked(blocked)), nil
+        case errors.Is(err, domain.ErrTeamNotFound):
+            return api.PostTeamDelete404JSONResponse{
+                Error: constructor.ErrorResponse(api.NOTFOUND, err.Error()),
+            }, nil
+        case errors.Is(err, domain.ErrTeamNotEmpty):
+            return api.PostTeamDelete400JSONResponse{
+                Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+            }, nil
+        default:
+            return api.PostTeamDelete500JSONResponse{
+                Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+            }, nil
+        }
+    }
+
+    movedMembers := make([]string, 0, len(moved))
+    for _, member := range moved {
+        movedMembers = append(movedMembers, member.ID)
+    }
+    resp := api.PostTeamDelete200JSONResponse{
+        TeamName:     req.Body.TeamName,
+        MovedMembers: movedMembers,
+    }
+    if len(moved) > 0 {
+        resp.MoveMembersTo = req.Body.MoveMembersTo
+    }
+    return resp, nil
+}
+
+func toApiTeam(team *entity.Team, members []entity.User) api.Team {
+    apiMembers := make([]api.TeamMember, 0, len(members))
+    for _, m := range members {
         apiMembers = append(apiMembers, api.TeamMember{
             UserId:   m.ID,
             Username: m.Username,
@@ -104,8 +463,46 @@ func (h *teamHandler) GetTeamGet(
         })
     }
 
-    return api.GetTeamGet200JSONResponse{
-        TeamName: team.Name,
-        Members:  apiMembers,
-    }, nil
+    strategy := api.ReviewerStrategy(team.ReviewerStrategy)
+    fallbackTeams := team.FallbackTeams
+    if fallbackTeams == nil {
+        fallbackTeams = []string{}
+    }
+    escalation := api.EscalationPolicy(team.ReviewSLA.Escalation)
+    var leadID *string
+    if team.LeadID != "" {
+        leadID = &team.LeadID
+    }
+    var parentName *string
+    if team.ParentName != "" {
+        parentName = &team.ParentName
+    }
+    var ancestors, children *[]string
+    if team.Ancestors != nil {
+        ancestors = &team.Ancestors
+    }
+    if team.Children != nil {
+        children = &team.Children
+    }
+    return api.Team{
+        TeamName:                team.Name,
+        Members:                 apiMembers,
+        ReviewerStrategy:        &strategy,
+        MinReviewers:            &team.MinReviewers,
+        MaxReviewers:            &team.MaxReviewers,
+        FallbackTeams:           &fallbackTeams,
+        DefaultMaxOpenReviews:   &team.DefaultMaxOpenReviews,
+        RequiredApprovals:       &team.MergePolicy.RequiredApprovals,
+        BlockOnChangesRequested: &team.MergePolicy.BlockOnChangesRequested,
+        ReviewSlaHours:          &team.ReviewSLA.Hours,
+        ReviewSlaBusinessHours:  &team.ReviewSLA.BusinessHours,
+        ReviewSlaWorkdayStart:   &team.ReviewSLA.WorkdayStart,
+        ReviewSlaWorkdayEnd:     &team.ReviewSLA.WorkdayEnd,
+        ReviewSlaTimezone:       &team.ReviewSLA.TimeZone,
+        EscalationPolicy:        &escalation,
+        LeadId:                  leadID,
+        ParentTeamName:          parentName,
+        Ancestors:               ancestors,
+        Children:                children,
+    }
 }
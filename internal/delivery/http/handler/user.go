@@ -2,7 +2,12 @@ package handler
 
 import (
     "context"
+    "errors"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
     "github.com/kimvlry/avito-internship-assignment/pkg/logger"
+    "strings"
+    "time"
 
     "github.com/kimvlry/avito-internship-assignment/api"
     "github.com/kimvlry/avito-internship-assignment/internal/delivery/http/constructor"
@@ -41,11 +46,69 @@ func (h *userHandler) PostUsersSetIsActive(
             UserId:   user.ID,
             Username: user.Username,
             TeamName: user.TeamName,
+            Teams:    nonNilTeams(user),
             IsActive: user.IsActive,
         },
     }, nil
 }
 
+func (h *userHandler) PostUsersBulkSetIsActive(
+    ctx context.Context,
+    req api.PostUsersBulkSetIsActiveRequestObject,
+) (api.PostUsersBulkSetIsActiveResponseObject, error) {
+
+    if !check.IsAdmin(ctx) {
+        return api.PostUsersBulkSetIsActive401JSONResponse{
+            Error: constructor.ErrorResponse("UNAUTHORIZED", "admin access required"),
+        }, nil
+    }
+
+    if err := check.ValidBulkSetIsActive(req); err != nil {
+        return api.PostUsersBulkSetIsActive400JSONResponse{
+            Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+        }, nil
+    }
+
+    var (
+        userIDs  []string
+        teamName string
+    )
+    if req.Body.UserIds != nil {
+        userIDs = *req.Body.UserIds
+    }
+    if req.Body.TeamName != nil {
+        teamName = *req.Body.TeamName
+    }
+
+    users, notFound, err := h.svc.BulkSetIsActive(ctx, userIDs, teamName, req.Body.IsActive)
+    if err != nil {
+        if errors.Is(err, domain.ErrTeamNotFound) {
+            return api.PostUsersBulkSetIsActive404JSONResponse{
+                Error: constructor.ErrorResponse(api.NOTFOUND, err.Error()),
+            }, nil
+        }
+        return api.PostUsersBulkSetIsActive500JSONResponse{
+            Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+        }, nil
+    }
+
+    updated := make([]api.User, 0, len(users))
+    for i := range users {
+        updated = append(updated, api.User{
+            UserId:   users[i].ID,
+            Username: users[i].Username,
+            TeamName: users[i].TeamName,
+            Teams:    nonNilTeams(&users[i]),
+            IsActive: users[i].IsActive,
+        })
+    }
+
+    return api.PostUsersBulkSetIsActive200JSONResponse{
+        Users:    updated,
+        NotFound: notFound,
+    }, nil
+}
+
 func (h *userHandler) GetUsersGetReview(
     ctx context.Context,
     req api.GetUsersGetReviewRequestObject,
@@ -61,7 +124,7 @@ func (h *userHandler) GetUsersGetReview(
         logger.Debug(ctx, "GetUsersGetReview", "err", err)
         return api.GetUsersGetReview200JSONResponse{
             UserId:       req.Params.UserId,
-            PullRequests: []api.PullRequestShort{},
+            PullRequests: []api.ReviewAssignment{},
         }, nil
     }
 
@@ -69,7 +132,7 @@ func (h *userHandler) GetUsersGetReview(
         logger.Debug(ctx, "GetUsersGetReview", "user", u, "isActive", u.IsActive)
         return api.GetUsersGetReview200JSONResponse{
             UserId:       req.Params.UserId,
-            PullRequests: []api.PullRequestShort{},
+            PullRequests: []api.ReviewAssignment{},
         }, nil
     }
 
@@ -77,18 +140,21 @@ func (h *userHandler) GetUsersGetReview(
     if err != nil {
         return api.GetUsersGetReview200JSONResponse{
             UserId:       req.Params.UserId,
-            PullRequests: []api.PullRequestShort{},
+            PullRequests: []api.ReviewAssignment{},
+        }, nil
+    }
+
+    now := time.Now()
+    absences, err := h.svc.GetUpcomingUnavailability(ctx, reviewerIDs(reviews), now)
+    if err != nil {
+        return api.GetUsersGetReview500JSONResponse{
+            Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
         }, nil
     }
 
-    prs := make([]api.PullRequestShort, 0, len(reviews))
+    prs := make([]api.ReviewAssignment, 0, len(reviews))
     for _, r := range reviews {
-        prs = append(prs, api.PullRequestShort{
-            PullRequestId:   r.ID,
-            PullRequestName: r.Name,
-            AuthorId:        r.AuthorID,
-            Status:          api.PullRequestShortStatus(r.Status),
-        })
+        prs = append(prs, constructor.ReviewAssignment(r, now, absences))
     }
 
     return api.GetUsersGetReview200JSONResponse{
@@ -96,3 +162,333 @@ func (h *userHandler) GetUsersGetReview(
         PullRequests: prs,
     }, nil
 }
+
+func (h *userHandler) PostUsersSetExpertise(
+    ctx context.Context,
+    req api.PostUsersSetExpertiseRequestObject,
+) (api.PostUsersSetExpertiseResponseObject, error) {
+
+    if !check.IsAdmin(ctx) {
+        return api.PostUsersSetExpertise401JSONResponse{
+            Error: constructor.ErrorResponse("UNAUTHORIZED", "admin access required"),
+        }, nil
+    }
+
+    if err := check.ValidSetExpertise(req); err != nil {
+        return api.PostUsersSetExpertise400JSONResponse{
+            Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+        }, nil
+    }
+
+    user, err := h.svc.SetExpertise(ctx, req.Body.UserId, req.Body.Tags)
+    if err != nil {
+        switch {
+        case errors.Is(err, domain.ErrUserNotFound):
+            return api.PostUsersSetExpertise404JSONResponse{
+                Error: constructor.ErrorResponse(api.NOTFOUND, err.Error()),
+            }, nil
+        case errors.Is(err, domain.ErrInvalidExpertiseTag):
+            return api.PostUsersSetExpertise400JSONResponse{
+                Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+            }, nil
+        default:
+            return api.PostUsersSetExpertise500JSONResponse{
+                Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+            }, nil
+        }
+    }
+
+    responseUser := toApiUser(user)
+    return api.PostUsersSetExpertise200JSONResponse{
+        User: &responseUser,
+    }, nil
+}
+
+func (h *userHandler) GetUsersGetExpertise(
+    ctx context.Context,
+    req api.GetUsersGetExpertiseRequestObject,
+) (api.GetUsersGetExpertiseResponseObject, error) {
+
+    u, err := h.svc.GetByID(ctx, req.Params.UserId)
+    if err != nil {
+        if errors.Is(err, domain.ErrUserNotFound) {
+            return api.GetUsersGetExpertise404JSONResponse{
+                Error: constructor.ErrorResponse(api.NOTFOUND, err.Error()),
+            }, nil
+        }
+        return api.GetUsersGetExpertise500JSONResponse{
+            Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+        }, nil
+    }
+
+    return api.GetUsersGetExpertise200JSONResponse{
+        UserId: u.ID,
+        Tags:   u.Expertise,
+    }, nil
+}
+
+func (h *userHandler) PostUsersSetMaxOpenReviews(
+    ctx context.Context,
+    req api.PostUsersSetMaxOpenReviewsRequestObject,
+) (api.PostUsersSetMaxOpenReviewsResponseObject, error) {
+
+    if !check.IsAdmin(ctx) {
+        return api.PostUsersSetMaxOpenReviews401JSONResponse{
+            Error: constructor.ErrorResponse("UNAUTHORIZED", "admin access required"),
+        }, nil
+    }
+
+    if err := check.ValidSetMaxOpenReviews(req); err != nil {
+        return api.PostUsersSetMaxOpenReviews400JSONResponse{
+            Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+        }, nil
+    }
+
+    user, err := h.svc.SetMaxOpenReviews(ctx, req.Body.UserId, req.Body.MaxOpenReviews)
+    if err != nil {
+        switch {
+        case errors.Is(err, domain.ErrUserNotFound):
+            return api.PostUsersSetMaxOpenReviews404JSONResponse{
+                Error: constructor.ErrorResponse(api.NOTFOUND, err.Error()),
+            }, nil
+        case errors.Is(err, domain.ErrInvalidReviewCapacity):
+            return api.PostUsersSetMaxOpenReviews400JSONResponse{
+                Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+            }, nil
+        default:
+            return api.PostUsersSetMaxOpenReviews500JSONResponse{
+                Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+            }, nil
+        }
+    }
+
+    responseUser := toApiUser(user)
+    return api.PostUsersSetMaxOpenReviews200JSONResponse{
+        User: &responseUser,
+    }, nil
+}
+
+func (h *userHandler) PostUsersDeactivateAndReassign(
+    ctx context.Context,
+    req api.PostUsersDeactivateAndReassignRequestObject,
+) (api.PostUsersDeactivateAndReassignResponseObject, error) {
+
+    if !check.IsAdmin(ctx) {
+        return api.PostUsersDeactivateAndReassign401JSONResponse{
+            Error: constructor.ErrorResponse("UNAUTHORIZED", "admin access required"),
+        }, nil
+    }
+
+    if err := check.ValidUserID(req.Body.UserId); err != nil {
+        return api.PostUsersDeactivateAndReassign400JSONResponse{
+            Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+        }, nil
+    }
+
+    user, report, err := h.svc.DeactivateAndReassign(ctx, req.Body.UserId)
+    if err != nil {
+        if errors.Is(err, domain.ErrUserNotFound) {
+            return api.PostUsersDeactivateAndReassign404JSONResponse{
+                Error: constructor.ErrorResponse(api.NOTFOUND, err.Error()),
+            }, nil
+        }
+        return api.PostUsersDeactivateAndReassign500JSONResponse{
+            Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+        }, nil
+    }
+
+    replaced := make([]api.ReviewReplacement, 0, len(report.Replaced))
+    for _, r := range report.Replaced {
+        replaced = append(replaced, api.ReviewReplacement{
+            PullRequestId: r.PullRequestID,
+            ReplacedBy:    r.ReplacedBy,
+        })
+    }
+    failed := make([]api.ReviewReassignFailure, 0, len(report.Failed))
+    for _, f := range report.Failed {
+        failed = append(failed, api.ReviewReassignFailure{
+            PullRequestId: f.PullRequestID,
+            Reason:        f.Reason,
+        })
+    }
+
+    return api.PostUsersDeactivateAndReassign200JSONResponse{
+        User: api.User{
+            UserId:   user.ID,
+            Username: user.Username,
+            TeamName: user.TeamName,
+            Teams:    nonNilTeams(user),
+            IsActive: user.IsActive,
+        },
+        Replaced:   replaced,
+        Unassigned: report.Unassigned,
+        Failed:     failed,
+    }, nil
+}
+
+func (h *userHandler) PostUsersAddUnavailability(
+    ctx context.Context,
+    req api.PostUsersAddUnavailabilityRequestObject,
+) (api.PostUsersAddUnavailabilityResponseObject, error) {
+
+    if !check.IsAdmin(ctx) {
+        return api.PostUsersAddUnavailability401JSONResponse{
+            Error: constructor.ErrorResponse("UNAUTHORIZED", "admin access required"),
+        }, nil
+    }
+
+    if err := check.ValidAddUnavailability(req); err != nil {
+        return api.PostUsersAddUnavailability400JSONResponse{
+            Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+        }, nil
+    }
+
+    period := &entity.Unavailability{
+        UserID:   req.Body.UserId,
+        StartsAt: req.Body.StartsAt,
+        EndsAt:   req.Body.EndsAt,
+    }
+    if req.Body.Reason != nil {
+        period.Reason = *req.Body.Reason
+    }
+
+    if err := h.svc.AddUnavailability(ctx, period); err != nil {
+        switch {
+        case errors.Is(err, domain.ErrUserNotFound):
+            return api.PostUsersAddUnavailability404JSONResponse{
+                Error: constructor.ErrorResponse(api.NOTFOUND, err.Error()),
+            }, nil
+        case errors.Is(err, domain.ErrInvalidUnavailability):
+            return api.PostUsersAddUnavailability400JSONResponse{
+                Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+            }, nil
+        default:
+            return api.PostUsersAddUnavailability500JSONResponse{
+                Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+            }, nil
+        }
+    }
+
+    return api.PostUsersAddUnavailability201JSONResponse{
+        Unavailability: constructor.Unavailability(*period),
+    }, nil
+}
+
+func (h *userHandler) PostUsersImportUnavailability(
+    ctx context.Context,
+    req api.PostUsersImportUnavailabilityRequestObject,
+) (api.PostUsersImportUnavailabilityResponseObject, error) {
+
+    if !check.IsAdmin(ctx) {
+        return api.PostUsersImportUnavailability401JSONResponse{
+            Error: constructor.ErrorResponse("UNAUTHORIZED", "admin access required"),
+        }, nil
+    }
+
+    if err := check.ValidImportUnavailability(req); err != nil {
+        return api.PostUsersImportUnavailability400JSONResponse{
+            Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+        }, nil
+    }
+
+    periods, err := h.svc.ImportUnavailability(ctx, req.Body.UserId, strings.NewReader(req.Body.Calendar), time.Now())
+    if err != nil {
+        switch {
+        case errors.Is(err, domain.ErrUserNotFound):
+            return api.PostUsersImportUnavailability404JSONResponse{
+                Error: constructor.ErrorResponse(api.NOTFOUND, err.Error()),
+            }, nil
+        case errors.Is(err, domain.ErrInvalidCalendar), errors.Is(err, domain.ErrInvalidUnavailability):
+            return api.PostUsersImportUnavailability400JSONResponse{
+                Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+            }, nil
+        default:
+            return api.PostUsersImportUnavailability500JSONResponse{
+                Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+            }, nil
+        }
+    }
+
+    imported := make([]api.Unavailability, 0, len(periods))
+    for _, period := range periods {
+        imported = append(imported, constructor.Unavailability(period))
+    }
+    return api.PostUsersImportUnavailability200JSONResponse{
+        Imported: imported,
+    }, nil
+}
+
+func (h *userHandler) PostUsersRemoveUnavailability(
+    ctx context.Context,
+    req api.PostUsersRemoveUnavailabilityRequestObject,
+) (api.PostUsersRemoveUnavailabilityResponseObject, error) {
+
+    if !check.IsAdmin(ctx) {
+        return api.PostUsersRemoveUnavailability401JSONResponse{
+            Error: constructor.ErrorResponse("UNAUTHORIZED", "admin access required"),
+        }, nil
+    }
+
+    if err := check.ValidUserID(req.Body.UserId); err != nil {
+        return api.PostUsersRemoveUnavailability400JSONResponse{
+            Error: constructor.ErrorResponse(api.BADREQUEST, err.Error()),
+        }, nil
+    }
+
+    if err := h.svc.RemoveUnavailability(ctx, req.Body.UserId, req.Body.UnavailabilityId); err != nil {
+        if errors.Is(err, domain.ErrUnavailabilityNotFound) {
+            return api.PostUsersRemoveUnavailability404JSONResponse{
+                Error: constructor.ErrorResponse(api.NOTFOUND, err.Error()),
+            }, nil
+        }
+        return api.PostUsersRemoveUnavailability500JSONResponse{
+            Error: constructor.ErrorResponse("INTERNAL_SERVER_ERROR", err.Error()),
+        }, nil
+    }
+
+    return api.PostUsersRemoveUnavailability200JSONResponse{
+        UserId:           req.Body.UserId,
+        UnavailabilityId: req.Body.UnavailabilityId,
+    }, nil
+}
+
+// reviewerIDs lists everyone assigned to review any of the PRs, each once
+func reviewerIDs(prs []*entity.PullRequest) []string {
+    seen := make(map[string]struct{})
+    ids := make([]string, 0)
+    for _, pr := range prs {
+        for _, id := range pr.AssignedReviewers {
+            if _, ok := seen[id]; ok {
+                continue
+            }
+            seen[id] = struct{}{}
+            ids = append(ids, id)
+        }
+    }
+    return ids
+}
+
+func toApiUser(user *entity.User) api.User {
+    expertise := user.Expertise
+    if expertise == nil {
+        expertise = []string{}
+    }
+    return api.User{
+        UserId:           user.ID,
+        Username:         user.Username,
+        TeamName:         user.TeamName,
+        Teams:            nonNilTeams(user),
+        IsActive:         user.IsActive,
+        Expertise:        &expertise,
+        MaxOpenReviews:   user.MaxOpenReviews,
+        UnavailableUntil: user.UnavailableUntil,
+    }
+}
+
+func nonNilTeams(user *entity.User) *[]string {
+    teams := user.Teams
+    if teams == nil {
+        teams = []string{}
+    }
+    return &teams
+}
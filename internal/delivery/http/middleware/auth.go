@@ -6,6 +6,7 @@ import (
     "strings"
 
     "github.com/golang-jwt/jwt/v5"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain"
 )
 
 type contextKey string
@@ -57,6 +58,7 @@ func NewJWTMiddleware(secret string) func(http.Handler) http.Handler {
 
             ctx := context.WithValue(r.Context(), ContextUserID, userID)
             ctx = context.WithValue(ctx, ContextIsAdmin, isAdmin)
+            ctx = domain.WithActor(ctx, userID)
 
             next.ServeHTTP(w, r.WithContext(ctx))
         })
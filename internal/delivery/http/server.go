@@ -65,6 +65,13 @@ func setupRouter(jwtSecret string, handlers *handler.Handlers) http.Handler {
     jwtAuth := middleware.NewJWTMiddleware(jwtSecret)
 
     strictHandler := api.NewStrictHandler(handlers, nil)
+    // paramsHandler binds query parameters of GET endpoints with several optional ones
+    paramsHandler := api.ServerInterfaceWrapper{
+        Handler: strictHandler,
+        ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+            writeError(w, http.StatusBadRequest, "BAD_REQUEST", err.Error())
+        },
+    }
 
     r.Group(func(r chi.Router) {
         r.Post("/team/add", strictHandler.PostTeamAdd)
@@ -74,8 +81,35 @@ func setupRouter(jwtSecret string, handlers *handler.Handlers) http.Handler {
         r.Use(jwtAuth)
 
         r.Post("/pullRequest/create", strictHandler.PostPullRequestCreate)
+        r.Get("/pullRequest/list", paramsHandler.GetPullRequestList)
+        r.Get("/pullRequest/search", paramsHandler.GetPullRequestSearch)
+        r.Post("/pullRequest/update", strictHandler.PostPullRequestUpdate)
         r.Post("/pullRequest/merge", strictHandler.PostPullRequestMerge)
+        r.Post("/pullRequest/ready", strictHandler.PostPullRequestReady)
+        r.Post("/pullRequest/close", strictHandler.PostPullRequestClose)
+        r.Post("/pullRequest/reopen", strictHandler.PostPullRequestReopen)
         r.Post("/pullRequest/reassign", strictHandler.PostPullRequestReassign)
+        r.Post("/pullRequest/addReviewer", strictHandler.PostPullRequestAddReviewer)
+        r.Post("/pullRequest/removeReviewer", strictHandler.PostPullRequestRemoveReviewer)
+        r.Post("/pullRequest/submitReview", strictHandler.PostPullRequestSubmitReview)
+        r.Get("/pullRequest/history", handleGetWithQuery(
+            "pull_request_id",
+            func(ctx context.Context, prID string) (api.GetPullRequestHistoryResponseObject, error) {
+                return handlers.GetPullRequestHistory(ctx, api.GetPullRequestHistoryRequestObject{
+                    Params: api.GetPullRequestHistoryParams{PullRequestId: prID},
+                })
+            },
+        ))
+
+        r.Get("/team/list", paramsHandler.GetTeamList)
+        r.Post("/team/update", strictHandler.PostTeamUpdate)
+        r.Post("/team/addMember", strictHandler.PostTeamAddMember)
+        r.Post("/team/removeMember", strictHandler.PostTeamRemoveMember)
+        r.Post("/team/rename", strictHandler.PostTeamRename)
+        r.Post("/team/delete", strictHandler.PostTeamDelete)
+
+        r.Post("/ownership/upload", strictHandler.PostOwnershipUpload)
+        r.Get("/ownership/get", strictHandler.GetOwnershipGet)
 
         r.Get("/team/get", handleGetWithQuery(
             "team_name",
@@ -87,6 +121,7 @@ func setupRouter(jwtSecret string, handlers *handler.Handlers) http.Handler {
         ))
 
         r.Post("/users/setIsActive", strictHandler.PostUsersSetIsActive)
+        r.Post("/users/bulkSetIsActive", strictHandler.PostUsersBulkSetIsActive)
         r.Get("/users/getReview", handleGetWithQuery(
             "user_id",
             func(ctx context.Context, userID string) (api.GetUsersGetReviewResponseObject, error) {
@@ -95,6 +130,20 @@ func setupRouter(jwtSecret string, handlers *handler.Handlers) http.Handler {
                 })
             },
         ))
+        r.Post("/users/setExpertise", strictHandler.PostUsersSetExpertise)
+        r.Post("/users/setMaxOpenReviews", strictHandler.PostUsersSetMaxOpenReviews)
+        r.Post("/users/deactivateAndReassign", strictHandler.PostUsersDeactivateAndReassign)
+        r.Post("/users/addUnavailability", strictHandler.PostUsersAddUnavailability)
+        r.Post("/users/importUnavailability", strictHandler.PostUsersImportUnavailability)
+        r.Post("/users/removeUnavailability", strictHandler.PostUsersRemoveUnavailability)
+        r.Get("/users/getExpertise", handleGetWithQuery(
+            "user_id",
+            func(ctx context.Context, userID string) (api.GetUsersGetExpertiseResponseObject, error) {
+                return handlers.GetUsersGetExpertise(ctx, api.GetUsersGetExpertiseRequestObject{
+                    Params: api.GetUsersGetExpertiseParams{UserId: userID},
+                })
+            },
+        ))
         r.Get("/stats/assignments", strictHandler.GetStatsAssignments)
     })
     return r
@@ -103,6 +152,8 @@ func setupRouter(jwtSecret string, handlers *handler.Handlers) http.Handler {
 type ResponseVisitor interface {
     VisitGetTeamGetResponse(w http.ResponseWriter) error
     VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
+    VisitGetUsersGetExpertiseResponse(w http.ResponseWriter) error
+    VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error
 }
 
 func handleGetWithQuery[T any](
@@ -140,6 +191,24 @@ func handleGetWithQuery[T any](
             }
             return
         }
+
+        if visitor, ok := any(resp).(interface {
+            VisitGetUsersGetExpertiseResponse(w http.ResponseWriter) error
+        }); ok {
+            if err := visitor.VisitGetUsersGetExpertiseResponse(w); err != nil {
+                writeError(w, http.StatusInternalServerError, "INTERNAL_SERVER_ERROR", "failed to write response")
+            }
+            return
+        }
+
+        if visitor, ok := any(resp).(interface {
+            VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error
+        }); ok {
+            if err := visitor.VisitGetPullRequestHistoryResponse(w); err != nil {
+                writeError(w, http.StatusInternalServerError, "INTERNAL_SERVER_ERROR", "failed to write response")
+            }
+            return
+        }
         writeError(w, http.StatusInternalServerError, "INTERNAL_SERVER_ERROR", "unknown response type")
     }
 }
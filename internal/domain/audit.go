@@ -0,0 +1,35 @@
+package domain
+
+import "context"
+
+type auditKey int
+
+const (
+    actorKey auditKey = iota
+    reasonKey
+)
+
+// WithActor marks changes made within ctx as done on behalf of the user
+func WithActor(ctx context.Context, actorID string) context.Context {
+    return context.WithValue(ctx, actorKey, actorID)
+}
+
+// ActorFrom returns the user changes within ctx are made by, empty for the service itself
+func ActorFrom(ctx context.Context) string {
+    actorID, _ := ctx.Value(actorKey).(string)
+    return actorID
+}
+
+// WithReason explains why changes within ctx are made. It replaces the reason
+// an operation records by default, e.g. a reassignment caused by a deactivation
+func WithReason(ctx context.Context, reason string) context.Context {
+    return context.WithValue(ctx, reasonKey, reason)
+}
+
+// ReasonFrom returns the reason set for ctx, or fallback when there is none
+func ReasonFrom(ctx context.Context, fallback string) string {
+    if reason, ok := ctx.Value(reasonKey).(string); ok && reason != "" {
+        return reason
+    }
+    return fallback
+}
@@ -0,0 +1,8 @@
+package entity
+
+// OwnershipRule assigns the owners of paths matching Pattern, a CODEOWNERS-style glob
+type OwnershipRule struct {
+    Pattern   string
+    UserIDs   []string
+    TeamNames []string
+}
@@ -1,31 +1,130 @@
 package entity
 
-import "time"
+import (
+    "fmt"
+    "slices"
+    "time"
+
+    "github.com/kimvlry/avito-internship-assignment/internal/domain"
+)
 
 type PullRequestStatus string
 
+// A PR is created OPEN or DRAFT. DRAFT has no reviewers until it is marked ready,
+// OPEN and DRAFT can be closed without merge, CLOSED can be reopened, MERGED is final
 const (
+    PRDraft  PullRequestStatus = "DRAFT"
     PROpen   PullRequestStatus = "OPEN"
     PRMerged PullRequestStatus = "MERGED"
+    PRClosed PullRequestStatus = "CLOSED"
+)
+
+func (s PullRequestStatus) IsValid() bool {
+    switch s {
+    case PRDraft, PROpen, PRMerged, PRClosed:
+        return true
+    }
+    return false
+}
+
+type ReviewVerdict string
+
+const (
+    VerdictApproved         ReviewVerdict = "APPROVED"
+    VerdictChangesRequested ReviewVerdict = "CHANGES_REQUESTED"
 )
 
+func (v ReviewVerdict) IsValid() bool {
+    return v == VerdictApproved || v == VerdictChangesRequested
+}
+
+// Review is the latest verdict an assigned reviewer has submitted
+type Review struct {
+    ReviewerID  string
+    Verdict     ReviewVerdict
+    Comment     string
+    SubmittedAt time.Time
+}
+
+// MergeOverride records an admin merge that bypassed the merge policy
+type MergeOverride struct {
+    By    string
+    Unmet []string
+}
+
 type PullRequest struct {
-    ID                string
-    Name              string
+    ID   string
+    Name string
+    // Description, URL, branches and ExternalID come from the code hosting, all optional
+    Description       string
+    URL               string
+    SourceBranch      string
+    TargetBranch      string
+    ExternalID        string
     AuthorID          string
     Status            PullRequestStatus
     AssignedReviewers []string
+    // FallbackReviewers are the assigned reviewers taken from fallback teams
+    FallbackReviewers []string
     CreatedAt         time.Time
     MergedAt          *time.Time
+    ClosedAt          *time.Time
+    // Reviews and MergeOverride are filled only when the PR is read by id
+    Reviews       []Review
+    MergeOverride *MergeOverride
+    // ReviewDueAt is the deadline of the reviewer the PRs were read for, filled only by reviewer lookups
+    ReviewDueAt *time.Time
 }
 
 func (p *PullRequest) SetMerged() error {
+    if err := p.transition(PRMerged, PROpen); err != nil {
+        return err
+    }
     now := time.Now()
-    p.Status = PRMerged
     p.MergedAt = &now
     return nil
 }
 
+// MarkReady moves a draft to review
+func (p *PullRequest) MarkReady() error {
+    return p.transition(PROpen, PRDraft)
+}
+
+// Close abandons the PR without merge
+func (p *PullRequest) Close() error {
+    if err := p.transition(PRClosed, PRDraft, PROpen); err != nil {
+        return err
+    }
+    now := time.Now()
+    p.ClosedAt = &now
+    return nil
+}
+
+func (p *PullRequest) Reopen() error {
+    if err := p.transition(PROpen, PRClosed); err != nil {
+        return err
+    }
+    p.ClosedAt = nil
+    return nil
+}
+
+func (p *PullRequest) transition(to PullRequestStatus, from ...PullRequestStatus) error {
+    if !slices.Contains(from, p.Status) {
+        return fmt.Errorf("%w: %s -> %s", domain.ErrInvalidStatusTransition, p.Status, to)
+    }
+    p.Status = to
+    return nil
+}
+
+// IsReviewOverdue reports whether the review the PR was read for is still expected and past its deadline
+func (p *PullRequest) IsReviewOverdue(now time.Time) bool {
+    return p.IsOpen() && p.ReviewDueAt != nil && now.After(*p.ReviewDueAt)
+}
+
+func (p *PullRequest) IsOpen() bool {
+    return p.Status == PROpen
+}
+
 func (p *PullRequest) IsMerged() bool {
     return p.Status == PRMerged
 }
@@ -38,3 +137,23 @@ func (p *PullRequest) HasReviewer(userId string) bool {
     }
     return false
 }
+
+// ReviewersWithVerdict returns the reviewers whose latest verdict is v
+func (p *PullRequest) ReviewersWithVerdict(v ReviewVerdict) []string {
+    var reviewers []string
+    for _, review := range p.Reviews {
+        if review.Verdict == v {
+            reviewers = append(reviewers, review.ReviewerID)
+        }
+    }
+    return reviewers
+}
+
+func (p *PullRequest) IsFallbackReviewer(userId string) bool {
+    for _, reviewer := range p.FallbackReviewers {
+        if reviewer == userId {
+            return true
+        }
+    }
+    return false
+}
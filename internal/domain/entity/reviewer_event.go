@@ -0,0 +1,30 @@
+package entity
+
+import "time"
+
+type ReviewerEventType string
+
+const (
+    EventAssigned   ReviewerEventType = "ASSIGNED"
+    EventReassigned ReviewerEventType = "REASSIGNED"
+    EventUnassigned ReviewerEventType = "UNASSIGNED"
+    EventVerdict    ReviewerEventType = "VERDICT"
+    EventMerged     ReviewerEventType = "MERGED"
+    EventClosed     ReviewerEventType = "CLOSED"
+    EventReopened   ReviewerEventType = "REOPENED"
+)
+
+// ReviewerEvent is an entry of the append-only history of a PR's reviewers
+type ReviewerEvent struct {
+    ID            int64
+    PullRequestID string
+    Type          ReviewerEventType
+    // ReviewerID is empty for MERGED, CLOSED and REOPENED, for REASSIGNED it is the new reviewer
+    ReviewerID         string
+    PreviousReviewerID string
+    // ActorID is empty when the service made the change itself
+    ActorID   string
+    Reason    string
+    Verdict   ReviewVerdict
+    CreatedAt time.Time
+}
@@ -1,6 +1,172 @@
 package entity
 
+import (
+    "fmt"
+    "strings"
+    "time"
+)
+
+type ReviewerStrategy string
+
+const (
+    ReviewerStrategyRandom      ReviewerStrategy = "random"
+    ReviewerStrategyLeastLoaded ReviewerStrategy = "least_loaded"
+    ReviewerStrategyRoundRobin  ReviewerStrategy = "round_robin"
+)
+
+func (s ReviewerStrategy) IsValid() bool {
+    switch s {
+    case ReviewerStrategyRandom, ReviewerStrategyLeastLoaded, ReviewerStrategyRoundRobin:
+        return true
+    }
+    return false
+}
+
+// EscalationPolicy is what happens to a review assignment once it is overdue
+type EscalationPolicy string
+
+const (
+    EscalationReassign   EscalationPolicy = "reassign"
+    EscalationNotifyLead EscalationPolicy = "notify_lead"
+)
+
+func (p EscalationPolicy) IsValid() bool {
+    return p == EscalationReassign || p == EscalationNotifyLead
+}
+
+const (
+    DefaultMinReviewers = 0
+    DefaultMaxReviewers = 2
+
+    DefaultWorkdayStart = 9
+    DefaultWorkdayEnd   = 18
+    DefaultTimeZone     = "UTC"
+)
+
+// NoTeam returns the settings PRs of a user without a team follow: the defaults of a new team
+// that has no members to pick reviewers from
+func NoTeam() *Team {
+    return &Team{
+        ReviewerStrategy: ReviewerStrategyRandom,
+        MinReviewers:     DefaultMinReviewers,
+        MaxReviewers:     DefaultMaxReviewers,
+        ReviewSLA: ReviewSLA{
+            WorkdayStart: DefaultWorkdayStart,
+            WorkdayEnd:   DefaultWorkdayEnd,
+            TimeZone:     DefaultTimeZone,
+            Escalation:   EscalationReassign,
+        },
+    }
+}
+
 type Team struct {
-    Name    string
-    Members []User
+    Name             string
+    ReviewerStrategy ReviewerStrategy
+    MinReviewers     int
+    MaxReviewers     int
+    // FallbackTeams are asked for reviewers in order when the team cannot fill MaxReviewers itself
+    FallbackTeams []string
+    // DefaultMaxOpenReviews limits OPEN reviews of members without their own limit, 0 means unlimited
+    DefaultMaxOpenReviews int
+    // MergePolicy has to be satisfied by PRs of the team members before merge
+    MergePolicy MergePolicy
+    ReviewSLA   ReviewSLA
+    // LeadID is notified about overdue reviews, empty when the team has no lead
+    LeadID string
+    // ParentName is the team this one rolls up into, empty for a root team
+    ParentName string
+    Members    []User
+    // Ancestors (nearest first) and Children are filled when the team is read with its place in the tree
+    Ancestors []string
+    Children  []string
+}
+
+// ReviewSLA is the time a reviewer has for a review of a team member's PR
+type ReviewSLA struct {
+    // Hours of 0 leaves reviews without a deadline
+    Hours int
+    // BusinessHours count only the working hours from Monday to Friday
+    BusinessHours bool
+    // WorkdayStart and WorkdayEnd are the hours of the day working hours run between, in TimeZone
+    WorkdayStart int
+    WorkdayEnd   int
+    // TimeZone is an IANA name such as Europe/Moscow
+    TimeZone   string
+    Escalation EscalationPolicy
+}
+
+// HasValidWorkday reports whether the working hours fit into a day and the time zone is known
+func (s ReviewSLA) HasValidWorkday() bool {
+    if s.WorkdayStart < 0 || s.WorkdayStart >= s.WorkdayEnd || s.WorkdayEnd > 24 {
+        return false
+    }
+    _, err := time.LoadLocation(s.TimeZone)
+    return err == nil
+}
+
+// DueAt returns the deadline of a review assigned at from, nil when the SLA sets none.
+// With BusinessHours the time outside working hours and weekends does not count,
+// so a review assigned on Friday evening is due after Hours of work from Monday morning
+func (s ReviewSLA) DueAt(from time.Time) *time.Time {
+    if s.Hours <= 0 {
+        return nil
+    }
+
+    remaining := time.Duration(s.Hours) * time.Hour
+    if !s.BusinessHours {
+        due := from.Add(remaining)
+        return &due
+    }
+
+    // working hours are checked when the team is saved, the defaults only keep the loop finite
+    if !s.HasValidWorkday() {
+        s.WorkdayStart, s.WorkdayEnd, s.TimeZone = DefaultWorkdayStart, DefaultWorkdayEnd, DefaultTimeZone
+    }
+    loc, _ := time.LoadLocation(s.TimeZone)
+
+    due := from.In(loc)
+    for remaining > 0 {
+        year, month, day := due.Date()
+        dayStart := time.Date(year, month, day, s.WorkdayStart, 0, 0, 0, loc)
+        dayEnd := time.Date(year, month, day, s.WorkdayEnd, 0, 0, 0, loc)
+        weekday := due.Weekday()
+        if weekday == time.Saturday || weekday == time.Sunday || !due.Before(dayEnd) {
+            due = time.Date(year, month, day+1, s.WorkdayStart, 0, 0, 0, loc)
+            continue
+        }
+        if due.Before(dayStart) {
+            due = dayStart
+        }
+        step := min(remaining, dayEnd.Sub(due))
+        due = due.Add(step)
+        remaining -= step
+    }
+    due = due.UTC()
+    return &due
+}
+
+// MergePolicy lists the conditions a PR has to meet to be merged
+type MergePolicy struct {
+    // RequiredApprovals of 0 lets PRs merge without approvals
+    RequiredApprovals       int
+    BlockOnChangesRequested bool
+}
+
+// Unmet describes the conditions of the policy the PR does not satisfy
+func (p MergePolicy) Unmet(pr *PullRequest) []string {
+    var unmet []string
+    if approvals := len(pr.ReviewersWithVerdict(VerdictApproved)); approvals < p.RequiredApprovals {
+        unmet = append(unmet, fmt.Sprintf("%d of %d required approvals", approvals, p.RequiredApprovals))
+    }
+    if p.BlockOnChangesRequested {
+        if requested := pr.ReviewersWithVerdict(VerdictChangesRequested); len(requested) > 0 {
+            unmet = append(unmet, "changes requested by "+strings.Join(requested, ", "))
+        }
+    }
+    return unmet
+}
+
+// HasValidReviewerLimits reports whether the team asks for a sane number of reviewers per PR
+func (t *Team) HasValidReviewerLimits() bool {
+    return t.MinReviewers >= 0 && t.MaxReviewers >= 1 && t.MinReviewers <= t.MaxReviewers
 }
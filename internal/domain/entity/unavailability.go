@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+// Unavailability is a period the user is out of review rotation, EndsAt is exclusive
+type Unavailability struct {
+    ID       int64
+    UserID   string
+    StartsAt time.Time
+    EndsAt   time.Time
+    Reason   string
+    // ExternalUID is the UID of the iCalendar event the period was imported from, empty otherwise
+    ExternalUID string
+}
+
+// Covers reports whether t falls within the period
+func (u *Unavailability) Covers(t time.Time) bool {
+    return !t.Before(u.StartsAt) && t.Before(u.EndsAt)
+}
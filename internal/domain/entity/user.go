@@ -1,12 +1,56 @@
 package entity
 
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
 type User struct {
 	ID       string
 	Username string
-	TeamName string
-	IsActive bool
+	// TeamName is the primary team, its settings apply to the user's own pull requests
+	// and review limit. Teams lists every team the user reviews for, the primary one first
+	TeamName  string
+	Teams     []string
+	IsActive  bool
+	Expertise []string
+	// MaxOpenReviews is the user's own limit of OPEN reviews, nil means the team default applies
+	MaxOpenReviews *int
+	// OpenReviews and ReviewCapacity are filled on reads: the current OPEN review load
+	// and the effective limit, 0 means unlimited
+	OpenReviews    int
+	ReviewCapacity int
+	// UnavailableUntil is filled on reads: the end of the unavailability period the user
+	// is in right now, nil when the user is available
+	UnavailableUntil *time.Time
 }
 
 func (u *User) CanReview() bool {
-	return u.IsActive
+	return u.IsActive && u.UnavailableUntil == nil && u.HasReviewCapacity()
+}
+
+// InTeam reports whether the user is a member of the team, primary or not
+func (u *User) InTeam(teamName string) bool {
+	return slices.Contains(u.Teams, teamName)
+}
+
+// HasReviewCapacity reports whether one more OPEN review fits into the user's limit
+func (u *User) HasReviewCapacity() bool {
+	return u.ReviewCapacity == 0 || u.OpenReviews < u.ReviewCapacity
+}
+
+// NormalizeExpertise lowercases and deduplicates tags keeping their order
+func NormalizeExpertise(tags []string) []string {
+	seen := make(map[string]struct{}, len(tags))
+	res := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		res = append(res, tag)
+	}
+	return res
 }
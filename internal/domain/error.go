@@ -1,5 +1,10 @@
 package domain
 
+import (
+    "fmt"
+    "strings"
+)
+
 type Error string
 
 func (e Error) Error() string {
@@ -15,4 +20,49 @@ const (
     ErrNoReviewerCandidate      Error = "no reviewer candidate available"
     ErrPullRequestIsMerged      Error = "pull request is merged"
     ErrReviewerNotAssigned      Error = "reviewer not assigned"
+    ErrInvalidReviewerStrategy  Error = "unknown reviewer strategy"
+    ErrInvalidReviewerLimits    Error = "invalid reviewer limits"
+    ErrInvalidFallbackTeams     Error = "invalid fallback teams"
+    ErrInvalidOwnershipRules    Error = "invalid ownership rules"
+    ErrInvalidExpertiseTag      Error = "invalid expertise tag"
+    ErrInvalidReviewCapacity    Error = "invalid review capacity"
+    ErrInvalidStatusTransition  Error = "invalid pull request status transition"
+    ErrPullRequestNotOpen       Error = "pull request is not open"
+    ErrInvalidReviewVerdict     Error = "invalid review verdict"
+    ErrMergePolicyNotSatisfied  Error = "merge policy not satisfied"
+    ErrInvalidMergePolicy       Error = "invalid merge policy"
+    ErrReviewerLimitReached     Error = "reviewer limit reached"
+    ErrInvalidSearchQuery       Error = "invalid search query"
+    ErrInvalidReviewSLA         Error = "invalid review sla"
+    ErrUserHasActiveAssignments Error = "user has active PR assignments for the team"
+    ErrUserNotInTeam            Error = "user is not a member of the team"
+    ErrTeamNotEmpty             Error = "team has members"
+    ErrInvalidParentTeam        Error = "invalid parent team"
+    ErrInvalidUnavailability    Error = "invalid unavailability period"
+    ErrUnavailabilityNotFound   Error = "unavailability period not found"
+    ErrInvalidCalendar          Error = "invalid calendar"
 )
+
+// BlockingReview is an OPEN PR the user is assigned to review
+type BlockingReview struct {
+    UserID        string
+    PullRequestID string
+}
+
+// TeamChangeBlockedError lists the reviews keeping users in their current team.
+// It matches ErrUserHasActiveAssignments in errors.Is
+type TeamChangeBlockedError struct {
+    Reviews []BlockingReview
+}
+
+func (e *TeamChangeBlockedError) Error() string {
+    reviews := make([]string, 0, len(e.Reviews))
+    for _, review := range e.Reviews {
+        reviews = append(reviews, review.UserID+" reviews "+review.PullRequestID)
+    }
+    return fmt.Sprintf("%s: %s", ErrUserHasActiveAssignments, strings.Join(reviews, ", "))
+}
+
+func (e *TeamChangeBlockedError) Unwrap() error {
+    return ErrUserHasActiveAssignments
+}
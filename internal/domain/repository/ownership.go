@@ -0,0 +1,12 @@
+package repository
+
+import (
+    "context"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
+)
+
+type OwnershipRepository interface {
+    // ReplaceAll drops current rules and stores the new ones keeping their order
+    ReplaceAll(ctx context.Context, rules []entity.OwnershipRule) error
+    GetAll(ctx context.Context) ([]entity.OwnershipRule, error)
+}
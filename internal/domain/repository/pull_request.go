@@ -3,14 +3,78 @@ package repository
 import (
     "context"
     "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
+    "time"
 )
 
+// PullRequestFilter narrows a PR listing, zero fields do not filter
+type PullRequestFilter struct {
+    Statuses   []entity.PullRequestStatus
+    AuthorID   string
+    ReviewerID string
+    // TeamName is one of the author's teams
+    TeamName string
+    // CreatedFrom is inclusive, CreatedTo is exclusive
+    CreatedFrom *time.Time
+    CreatedTo   *time.Time
+}
+
+// PullRequestSearchHit is a PR matching a full-text query
+type PullRequestSearchHit struct {
+    PullRequest *entity.PullRequest
+    Rank        float64
+    // Highlight is a fragment of the name and the description with matches wrapped in <b></b>
+    Highlight string
+}
+
+// PullRequestCursor is the position of the last PR of a page in the listing order
+type PullRequestCursor struct {
+    CreatedAt time.Time
+    ID        string
+}
+
+// OverdueAssignment is a review assignment on an OPEN PR past its deadline
+type OverdueAssignment struct {
+    PullRequestID string
+    ReviewerID    string
+    // AuthorTeam owns the SLA and the escalation policy of the assignment, empty when the author has no team
+    AuthorTeam string
+    DueAt      time.Time
+    // Attempts counts escalations of the assignment that failed so far
+    Attempts int
+}
+
+// Reviewer assignments are stored with dueAt, nil when the author's team sets no review SLA
 type PullRequestRepository interface {
-    CreateWithReviewers(ctx context.Context, pr *entity.PullRequest) error
+    CreateWithReviewers(ctx context.Context, pr *entity.PullRequest, dueAt *time.Time) error
     GetByID(ctx context.Context, id string) (*entity.PullRequest, error)
     Exists(ctx context.Context, id string) (bool, error)
+    // UpdateDetails stores the name and the metadata of the PR
+    UpdateDetails(ctx context.Context, pr *entity.PullRequest) error
     UpdateStatus(ctx context.Context, prId string, status entity.PullRequestStatus) error
     GetByReviewer(ctx context.Context, userId string) ([]*entity.PullRequest, error)
-    ReplaceReviewer(ctx context.Context, prId, oldUserId, newUserId string) error
+    ReplaceReviewer(ctx context.Context, prId, oldUserId, newUserId string, isFallback bool, dueAt *time.Time) error
+    // AssignReviewers adds reviewers to an existing PR, fallbackIds mark those taken from fallback teams
+    AssignReviewers(ctx context.Context, prId string, reviewerIds, fallbackIds []string, dueAt *time.Time) error
+    RemoveReviewer(ctx context.Context, prId, userId string) error
+    // SubmitVerdict stores the verdict of an assigned reviewer replacing the previous one
+    SubmitVerdict(ctx context.Context, prId, reviewerId string, verdict entity.ReviewVerdict, comment string) error
+    // RecordMergeOverride stores who merged the PR despite the unmet merge policy conditions
+    RecordMergeOverride(ctx context.Context, prId, actorId string, unmet []string) error
+    // List returns up to limit PRs matching the filter ordered by creation time, newest first,
+    // starting right after the cursor
+    List(ctx context.Context, filter PullRequestFilter, after *PullRequestCursor, limit int) ([]*entity.PullRequest, error)
+    // Search returns up to limit PRs matching every word of the query as a prefix, best ranked first
+    Search(ctx context.Context, query string, filter PullRequestFilter, limit int) ([]PullRequestSearchHit, error)
     GetAll(ctx context.Context) ([]*entity.PullRequest, error)
+    // GetOverdueAssignments returns up to limit assignments due before now that were not escalated yet
+    // and do not wait for a retry, the most overdue first
+    GetOverdueAssignments(ctx context.Context, now time.Time, limit int) ([]OverdueAssignment, error)
+    MarkEscalated(ctx context.Context, prId, reviewerId string) error
+    // RecordEscalationFailure counts a failed escalation, the assignment is not returned
+    // by GetOverdueAssignments before retryAt
+    RecordEscalationFailure(ctx context.Context, prId, reviewerId string, retryAt time.Time) error
+    // AppendEvents adds entries to the reviewer history, it has to run in the transaction of the change
+    AppendEvents(ctx context.Context, events []entity.ReviewerEvent) error
+    // GetEvents returns the reviewer history of the PR oldest first
+    GetEvents(ctx context.Context, prId string) ([]entity.ReviewerEvent, error)
 }
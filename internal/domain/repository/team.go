@@ -5,8 +5,37 @@ import (
     "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
 )
 
+// TeamSummary is a team with the size of its roster and the OPEN reviews its members hold
+type TeamSummary struct {
+    Name              string
+    MemberCount       int
+    ActiveMemberCount int
+    OpenReviews       int
+}
+
 type TeamRepository interface {
     Create(ctx context.Context, team *entity.Team) error
     GetByName(ctx context.Context, name string) (*entity.Team, error)
+    Update(ctx context.Context, team *entity.Team) error
+    // SetLead sets the team lead, "" removes it. It fails with domain.ErrUserNotFound for an unknown user
+    SetLead(ctx context.Context, teamName, leadID string) error
     Exists(ctx context.Context, teamName string) (bool, error)
+    // List returns up to limit teams ordered by name, starting after afterName
+    List(ctx context.Context, afterName string, limit int) ([]TeamSummary, error)
+    // Rename changes the team name everywhere it is referenced, ownership rules included
+    Rename(ctx context.Context, oldName, newName string) error
+    // Delete removes an empty team, it is dropped from fallback lists and ownership rules.
+    // Its child teams are handed over to its parent
+    Delete(ctx context.Context, teamName string) error
+    // GetAncestors returns the parent chain of the team, nearest first
+    GetAncestors(ctx context.Context, teamName string) ([]string, error)
+    // GetChildren returns the teams directly under the team ordered by name
+    GetChildren(ctx context.Context, teamName string) ([]string, error)
+    // GetParents maps every team to its parent, "" for a root team
+    GetParents(ctx context.Context) (map[string]string, error)
+
+    // LockRotationCursor returns the last user handed out by round-robin selection
+    // and locks it until the surrounding transaction ends
+    LockRotationCursor(ctx context.Context, teamName string) (string, error)
+    SetRotationCursor(ctx context.Context, teamName, lastUserID string) error
 }
@@ -3,20 +3,63 @@ package repository
 import (
     "context"
     "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
+    "time"
 )
 
 type UserRepository interface {
+    // Create makes TeamName the user's primary team when it is set
     Create(ctx context.Context, user *entity.User) error
+    // Update changes the username and activity, team memberships are changed separately
     Update(ctx context.Context, user *entity.User) error
     Exists(ctx context.Context, id string) (bool, error)
     GetByID(ctx context.Context, id string) (*entity.User, error)
     GetByTeam(ctx context.Context, teamName string) ([]entity.User, error)
     SetIsActive(ctx context.Context, id string, isActive bool) (*entity.User, error)
+    // SetIsActiveBatch is SetIsActive for many users at once, it returns the users found ordered by id
+    SetIsActiveBatch(ctx context.Context, ids []string, isActive bool) ([]entity.User, error)
+    // SetMaxOpenReviews sets the user's own OPEN review limit, nil falls back to the team default
+    SetMaxOpenReviews(ctx context.Context, id string, maxOpenReviews *int) error
+    // GetAllReviewLoads returns every user with the current OPEN review load and capacity filled
+    GetAllReviewLoads(ctx context.Context) ([]entity.User, error)
+    // Get*ActiveTeamUsers skip users who have no review capacity left or are unavailable right now
     GetRandomActiveTeamUsers(
         ctx context.Context,
         teamName string,
         excludeUserIDs []string,
         maxCount int,
     ) ([]entity.User, error)
+    GetLeastLoadedActiveTeamUsers(
+        ctx context.Context,
+        teamName string,
+        excludeUserIDs []string,
+        maxCount int,
+    ) ([]entity.User, error)
+    // GetExpertActiveTeamUsers returns active members having at least one of the tags,
+    // those matching more tags first
+    GetExpertActiveTeamUsers(
+        ctx context.Context,
+        teamName string,
+        excludeUserIDs []string,
+        tags []string,
+        maxCount int,
+    ) ([]entity.User, error)
+    SetExpertise(ctx context.Context, userID string, tags []string) error
+    // AddToTeam makes the user a member of the team, the first team of a user becomes primary
+    AddToTeam(ctx context.Context, userID, teamName string) error
+    // RemoveFromTeam fails with domain.ErrUserNotInTeam for a non-member. When the primary
+    // team is left, the first remaining team by name becomes primary
+    RemoveFromTeam(ctx context.Context, userID, teamName string) error
+    // MoveTeamMembers moves all members of fromTeam to toTeam, a user whose primary team was
+    // fromTeam gets toTeam as primary
+    MoveTeamMembers(ctx context.Context, fromTeam, toTeam string) error
+    // CheckUsersAvailableForTeam fails with *domain.TeamChangeBlockedError when users review
+    // OPEN PRs they were picked for as members of teamName: the author is in teamName and
+    // shares no other team with the reviewer
     CheckUsersAvailableForTeam(ctx context.Context, userIDs []string, teamName string) error
+    // AddUnavailability stores the period and sets its ID. A period with ExternalUID replaces
+    // the one of the user imported with the same UID
+    AddUnavailability(ctx context.Context, period *entity.Unavailability) error
+    DeleteUnavailability(ctx context.Context, userID string, id int64) error
+    // GetUpcomingUnavailability returns periods of the users not over by now, ordered by user and start
+    GetUpcomingUnavailability(ctx context.Context, userIDs []string, now time.Time) ([]entity.Unavailability, error)
 }
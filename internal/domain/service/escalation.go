@@ -0,0 +1,201 @@
+package service
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "time"
+
+    "github.com/kimvlry/avito-internship-assignment/internal/domain"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/repository"
+    "github.com/kimvlry/avito-internship-assignment/pkg/logger"
+)
+
+// escalationBatchSize limits overdue assignments handled in one pass, the rest wait for the next one
+const escalationBatchSize = 100
+
+// Notifier tells a team lead that a review of a team member's PR is overdue
+type Notifier interface {
+    NotifyOverdueReview(ctx context.Context, leadID string, assignment repository.OverdueAssignment) error
+}
+
+// EscalationReport counts what a single escalation pass did
+type EscalationReport struct {
+    Reassigned int
+    // Escalated assignments stay with the reviewer, the team lead is notified if the team has one
+    Escalated int
+    Failed    int
+}
+
+// Escalation applies the escalation policy of the author's team to overdue review assignments
+type Escalation struct {
+    prRepository   repository.PullRequestRepository
+    teamRepository repository.TeamRepository
+    reassigner     ReviewReassigner
+    notifier       Notifier
+    tx             repository.Transactor
+}
+
+func NewEscalation(
+    prRepo repository.PullRequestRepository,
+    teamRepo repository.TeamRepository,
+    reassigner ReviewReassigner,
+    notifier Notifier,
+    tx repository.Transactor,
+) *Escalation {
+    return &Escalation{
+        prRepository:   prRepo,
+        teamRepository: teamRepo,
+        reassigner:     reassigner,
+        notifier:       notifier,
+        tx:             tx,
+    }
+}
+
+// Run escalates overdue reviews every interval until ctx is done
+func (s *Escalation) Run(ctx context.Context, interval time.Duration) {
+    ticker := time.NewTicker(interval)
+    defer ticker.Stop()
+
+    for {
+        select {
+        case <-ctx.Done():
+            return
+        case <-ticker.C:
+            report, err := s.EscalateOverdue(ctx, time.Now())
+            if err != nil {
+                logger.Error(ctx, "escalate overdue reviews", "err", err)
+                continue
+            }
+            if report != (EscalationReport{}) {
+                logger.Info(ctx, "escalated overdue reviews",
+                    "reassigned", report.Reassigned,
+                    "escalated", report.Escalated,
+                    "failed", report.Failed,
+                )
+            }
+        }
+    }
+}
+
+// EscalateOverdue handles assignments that were due before now. A failed escalation is logged
+// and retried after a backoff, it does not stop the others
+func (s *Escalation) EscalateOverdue(ctx context.Context, now time.Time) (EscalationReport, error) {
+    var report EscalationReport
+
+    assignments, err := s.prRepository.GetOverdueAssignments(ctx, now, escalationBatchSize)
+    if err != nil {
+        return report, fmt.Errorf("get overdue assignments: %w", err)
+    }
+
+    teams := make(map[string]*entity.Team)
+    teamErrs := make(map[string]error)
+    for _, assignment := range assignments {
+        var reassigned bool
+        team, err := s.authorTeam(ctx, assignment.AuthorTeam, teams, teamErrs)
+        if err == nil {
+            reassigned, err = s.escalate(ctx, team, assignment)
+        }
+
+        switch {
+        case err != nil:
+            logger.Error(ctx, "escalate overdue review",
+                "pull_request_id", assignment.PullRequestID,
+                "reviewer_id", assignment.ReviewerID,
+                "attempts", assignment.Attempts+1,
+                "err", err,
+            )
+            report.Failed++
+            s.postpone(ctx, assignment, now)
+        case reassigned:
+            report.Reassigned++
+        default:
+            report.Escalated++
+        }
+    }
+    return report, nil
+}
+
+// postpone puts a failed assignment aside so that it does not hold the batch back on the next passes
+func (s *Escalation) postpone(ctx context.Context, assignment repository.OverdueAssignment, now time.Time) {
+    retryAt := now.Add(escalationRetryDelay(assignment.Attempts))
+    err := s.prRepository.RecordEscalationFailure(ctx, assignment.PullRequestID, assignment.ReviewerID, retryAt)
+    if err != nil {
+        logger.Error(ctx, "record escalation failure",
+            "pull_request_id", assignment.PullRequestID,
+            "reviewer_id", assignment.ReviewerID,
+            "err", err,
+        )
+    }
+}
+
+// authorTeam looks the team up once per pass, a failed lookup fails every assignment of the team.
+// An author without a team gets entity.NoTeam
+func (s *Escalation) authorTeam(
+    ctx context.Context,
+    name string,
+    teams map[string]*entity.Team,
+    teamErrs map[string]error,
+) (*entity.Team, error) {
+    if name == "" {
+        return entity.NoTeam(), nil
+    }
+    if team, ok := teams[name]; ok {
+        return team, nil
+    }
+    if err, ok := teamErrs[name]; ok {
+        return nil, err
+    }
+
+    team, err := s.teamRepository.GetByName(ctx, name)
+    if err != nil {
+        err = fmt.Errorf("get author team: %w", err)
+        teamErrs[name] = err
+        return nil, err
+    }
+    teams[name] = team
+    return team, nil
+}
+
+// escalationRetryDelay doubles with every failed attempt starting at 5 minutes, up to a day
+func escalationRetryDelay(attempts int) time.Duration {
+    delay := 5 * time.Minute
+    for i := 0; i < attempts && delay < 24*time.Hour; i++ {
+        delay *= 2
+    }
+    return min(delay, 24*time.Hour)
+}
+
+// escalate reassigns the review when the team asks for it and a candidate exists,
+// otherwise the team lead is notified and the assignment is marked escalated
+func (s *Escalation) escalate(
+    ctx context.Context,
+    team *entity.Team,
+    assignment repository.OverdueAssignment,
+) (bool, error) {
+    if team.ReviewSLA.Escalation == entity.EscalationReassign {
+        reassignCtx := domain.WithReason(ctx, "review overdue")
+        _, _, err := s.reassigner.ReassignReviewer(reassignCtx, assignment.PullRequestID, assignment.ReviewerID, "")
+        if err == nil {
+            return true, nil
+        }
+        if !errors.Is(err, domain.ErrNoReviewerCandidate) {
+            return false, fmt.Errorf("reassign reviewer: %w", err)
+        }
+    }
+
+    err := s.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+        if err := s.prRepository.MarkEscalated(txCtx, assignment.PullRequestID, assignment.ReviewerID); err != nil {
+            return fmt.Errorf("mark escalated: %w", err)
+        }
+        if team.LeadID == "" {
+            return nil
+        }
+        if err := s.notifier.NotifyOverdueReview(txCtx, team.LeadID, assignment); err != nil {
+            return fmt.Errorf("notify team lead: %w", err)
+        }
+        return nil
+    })
+    return false, err
+}
@@ -0,0 +1,268 @@
+package service
+
+import (
+    "context"
+    "errors"
+    "testing"
+    "time"
+
+    "github.com/kimvlry/avito-internship-assignment/internal/domain"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/repository"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/service/mocks"
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/mock"
+    "github.com/stretchr/testify/require"
+)
+
+type notifyFunc func(ctx context.Context, leadID string, assignment repository.OverdueAssignment) error
+
+func (f notifyFunc) NotifyOverdueReview(ctx context.Context, leadID string, assignment repository.OverdueAssignment) error {
+    return f(ctx, leadID, assignment)
+}
+
+func TestReviewSLA_DueAt(t *testing.T) {
+    friday := time.Date(2025, time.October, 24, 18, 0, 0, 0, time.UTC)
+    saturday := friday.Add(24 * time.Hour)
+    at := func(day, hour int) *time.Time {
+        due := time.Date(2025, time.October, day, hour, 0, 0, 0, time.UTC)
+        return &due
+    }
+    workday := entity.ReviewSLA{
+        BusinessHours: true,
+        WorkdayStart:  entity.DefaultWorkdayStart,
+        WorkdayEnd:    entity.DefaultWorkdayEnd,
+        TimeZone:      entity.DefaultTimeZone,
+    }
+    withHours := func(sla entity.ReviewSLA, hours int) entity.ReviewSLA {
+        sla.Hours = hours
+        return sla
+    }
+    moscow := workday
+    moscow.TimeZone = "Europe/Moscow"
+
+    tests := []struct {
+        name     string
+        sla      entity.ReviewSLA
+        from     time.Time
+        expected *time.Time
+    }{
+        {
+            name:     "без срока",
+            sla:      entity.ReviewSLA{},
+            from:     friday,
+            expected: nil,
+        },
+        {
+            name:     "календарные часы",
+            sla:      entity.ReviewSLA{Hours: 24},
+            from:     friday,
+            expected: &saturday,
+        },
+        {
+            name:     "рабочие часы идут только с 9 до 18",
+            sla:      withHours(workday, 8),
+            from:     time.Date(2025, time.October, 21, 15, 0, 0, 0, time.UTC),
+            expected: at(22, 14),
+        },
+        {
+            name:     "назначение в пятницу вечером считается с утра понедельника",
+            sla:      withHours(workday, 24),
+            from:     time.Date(2025, time.October, 24, 23, 0, 0, 0, time.UTC),
+            expected: at(29, 15),
+        },
+        {
+            name:     "конец пятницы переносит остаток на понедельник",
+            sla:      withHours(workday, 8),
+            from:     time.Date(2025, time.October, 24, 17, 0, 0, 0, time.UTC),
+            expected: at(27, 16),
+        },
+        {
+            name:     "срок в конце рабочего дня не переносится",
+            sla:      withHours(workday, 1),
+            from:     time.Date(2025, time.October, 24, 17, 0, 0, 0, time.UTC),
+            expected: at(24, 18),
+        },
+        {
+            name:     "назначение в выходной считается с понедельника",
+            sla:      withHours(workday, 8),
+            from:     saturday.Add(-6 * time.Hour),
+            expected: at(27, 17),
+        },
+        {
+            name:     "рабочие часы в часовом поясе команды",
+            sla:      withHours(moscow, 2),
+            from:     time.Date(2025, time.October, 24, 14, 0, 0, 0, time.UTC),
+            expected: at(27, 7),
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            assert.Equal(t, tt.expected, tt.sla.DueAt(tt.from))
+        })
+    }
+}
+
+func TestEscalationService_EscalateOverdue(t *testing.T) {
+    ctx := context.Background()
+    now := time.Now()
+
+    overdue := func(prID string) repository.OverdueAssignment {
+        return repository.OverdueAssignment{
+            PullRequestID: prID,
+            ReviewerID:    "u2",
+            AuthorTeam:    "backend",
+            DueAt:         now.Add(-time.Hour),
+        }
+    }
+
+    tests := []struct {
+        name           string
+        team           *entity.Team
+        reassignErr    error
+        expectEscalate bool
+        expectPostpone bool
+        expectNotified []string
+        expectedReport EscalationReport
+    }{
+        {
+            name:           "переназначение",
+            team:           &entity.Team{Name: "backend", ReviewSLA: entity.ReviewSLA{Escalation: entity.EscalationReassign}},
+            expectedReport: EscalationReport{Reassigned: 1},
+        },
+        {
+            name: "нет замены - уведомляется тимлид",
+            team: &entity.Team{
+                Name:      "backend",
+                LeadID:    "lead",
+                ReviewSLA: entity.ReviewSLA{Escalation: entity.EscalationReassign},
+            },
+            reassignErr:    domain.ErrNoReviewerCandidate,
+            expectEscalate: true,
+            expectNotified: []string{"lead"},
+            expectedReport: EscalationReport{Escalated: 1},
+        },
+        {
+            name:           "уведомление без тимлида только отмечает назначение",
+            team:           &entity.Team{Name: "backend", ReviewSLA: entity.ReviewSLA{Escalation: entity.EscalationNotifyLead}},
+            expectEscalate: true,
+            expectedReport: EscalationReport{Escalated: 1},
+        },
+        {
+            name:           "ошибка переназначения откладывает эскалацию",
+            team:           &entity.Team{Name: "backend", ReviewSLA: entity.ReviewSLA{Escalation: entity.EscalationReassign}},
+            reassignErr:    domain.ErrPullRequestNotFound,
+            expectPostpone: true,
+            expectedReport: EscalationReport{Failed: 1},
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            mockPRRepo := mocks.NewPullRequestRepository(t)
+            mockTeamRepo := mocks.NewTeamRepository(t)
+            mockTx := mocks.NewTransactor(t)
+
+            mockPRRepo.On("GetOverdueAssignments", ctx, now, escalationBatchSize).
+                Return([]repository.OverdueAssignment{overdue("pr-1")}, nil)
+            mockTeamRepo.On("GetByName", ctx, "backend").Return(tt.team, nil)
+            if tt.expectEscalate {
+                mockTx.On(
+                    "WithinTransaction",
+                    mock.Anything,
+                    mock.AnythingOfType("func(context.Context) error"),
+                ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+                    return fn(ctx)
+                })
+                mockPRRepo.On("MarkEscalated", ctx, "pr-1", "u2").Return(nil)
+            }
+            if tt.expectPostpone {
+                mockPRRepo.On("RecordEscalationFailure", ctx, "pr-1", "u2", now.Add(5*time.Minute)).Return(nil)
+            }
+
+            reassigner := reassignFunc(func(ctx context.Context, prId, oldUserId, newUserId string) (*entity.PullRequest, string, error) {
+                assert.Equal(t, "review overdue", domain.ReasonFrom(ctx, ""))
+                if tt.reassignErr != nil {
+                    return nil, "", tt.reassignErr
+                }
+                return &entity.PullRequest{ID: prId}, "u3", nil
+            })
+            var notified []string
+            notifier := notifyFunc(func(ctx context.Context, leadID string, assignment repository.OverdueAssignment) error {
+                notified = append(notified, leadID)
+                return nil
+            })
+
+            svc := NewEscalation(mockPRRepo, mockTeamRepo, reassigner, notifier, mockTx)
+            report, err := svc.EscalateOverdue(ctx, now)
+
+            require.NoError(t, err)
+            assert.Equal(t, tt.expectedReport, report)
+            assert.Equal(t, tt.expectNotified, notified)
+        })
+    }
+}
+
+func TestEscalationService_EscalateOverdueSkipsFailures(t *testing.T) {
+    ctx := context.Background()
+    now := time.Now()
+
+    mockPRRepo := mocks.NewPullRequestRepository(t)
+    mockTeamRepo := mocks.NewTeamRepository(t)
+    mockTx := mocks.NewTransactor(t)
+
+    mockPRRepo.On("GetOverdueAssignments", ctx, now, escalationBatchSize).Return([]repository.OverdueAssignment{
+        {PullRequestID: "pr-1", ReviewerID: "u2", AuthorTeam: "legacy", DueAt: now.Add(-48 * time.Hour), Attempts: 2},
+        {PullRequestID: "pr-2", ReviewerID: "u2", AuthorTeam: "legacy", DueAt: now.Add(-24 * time.Hour)},
+        {PullRequestID: "pr-3", ReviewerID: "u2", AuthorTeam: "backend", DueAt: now.Add(-time.Hour)},
+    }, nil)
+    mockTeamRepo.On("GetByName", ctx, "legacy").Return(nil, errors.New("connection reset")).Once()
+    mockTeamRepo.On("GetByName", ctx, "backend").Return(&entity.Team{
+        Name:      "backend",
+        ReviewSLA: entity.ReviewSLA{Escalation: entity.EscalationReassign},
+    }, nil)
+    mockPRRepo.On("RecordEscalationFailure", ctx, "pr-1", "u2", now.Add(20*time.Minute)).Return(nil)
+    mockPRRepo.On("RecordEscalationFailure", ctx, "pr-2", "u2", now.Add(5*time.Minute)).Return(nil)
+
+    reassigner := reassignFunc(func(ctx context.Context, prId, oldUserId, newUserId string) (*entity.PullRequest, string, error) {
+        return &entity.PullRequest{ID: prId}, "u3", nil
+    })
+
+    svc := NewEscalation(mockPRRepo, mockTeamRepo, reassigner, nil, mockTx)
+    report, err := svc.EscalateOverdue(ctx, now)
+
+    require.NoError(t, err)
+    assert.Equal(t, EscalationReport{Reassigned: 1, Failed: 2}, report, "ошибка команды не останавливает проход")
+}
+
+func TestEscalationService_EscalateOverdueTeamlessAuthor(t *testing.T) {
+    ctx := context.Background()
+    now := time.Now()
+
+    mockPRRepo := mocks.NewPullRequestRepository(t)
+    mockTeamRepo := mocks.NewTeamRepository(t)
+
+    mockPRRepo.On("GetOverdueAssignments", ctx, now, escalationBatchSize).Return([]repository.OverdueAssignment{
+        {PullRequestID: "pr-1", ReviewerID: "u2", DueAt: now.Add(-time.Hour)},
+    }, nil)
+
+    var reassigned []string
+    reassigner := reassignFunc(func(ctx context.Context, prId, oldUserId, newUserId string) (*entity.PullRequest, string, error) {
+        reassigned = append(reassigned, prId)
+        return &entity.PullRequest{ID: prId}, "u3", nil
+    })
+
+    svc := NewEscalation(mockPRRepo, mockTeamRepo, reassigner, nil, nil)
+    report, err := svc.EscalateOverdue(ctx, now)
+
+    require.NoError(t, err)
+    assert.Equal(t, EscalationReport{Reassigned: 1}, report, "автор без команды получает политику по умолчанию")
+    assert.Equal(t, []string{"pr-1"}, reassigned)
+}
+
+func TestEscalationRetryDelay(t *testing.T) {
+    assert.Equal(t, 5*time.Minute, escalationRetryDelay(0))
+    assert.Equal(t, 40*time.Minute, escalationRetryDelay(3))
+    assert.Equal(t, 24*time.Hour, escalationRetryDelay(20))
+}
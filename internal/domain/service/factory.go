@@ -6,7 +6,9 @@ type Services struct {
     TeamService        *Team
     UserService        *User
     PullRequestService *PullRequest
+    OwnershipService   *Ownership
     StatsService       *StatsService
+    EscalationService  *Escalation
     Transactor         repository.Transactor
 }
 
@@ -14,12 +16,24 @@ func NewServices(
     teamRepository repository.TeamRepository,
     userRepository repository.UserRepository,
     pullRequestRepository repository.PullRequestRepository,
+    ownershipRepository repository.OwnershipRepository,
+    notifier Notifier,
     tx repository.Transactor,
 ) *Services {
+    pullRequestService := NewPullRequest(
+        pullRequestRepository,
+        userRepository,
+        teamRepository,
+        ownershipRepository,
+        NewReviewerSelectors(userRepository, teamRepository),
+        tx,
+    )
     return &Services{
         TeamService:        NewTeam(teamRepository, userRepository, tx),
-        UserService:        NewUser(userRepository, pullRequestRepository),
-        PullRequestService: NewPullRequest(pullRequestRepository, userRepository, tx),
-        StatsService:       NewStatsService(pullRequestRepository),
+        UserService:        NewUser(userRepository, pullRequestRepository, teamRepository, pullRequestService, tx),
+        PullRequestService: pullRequestService,
+        OwnershipService:   NewOwnership(ownershipRepository, userRepository, teamRepository, tx),
+        StatsService:       NewStatsService(pullRequestRepository, userRepository, teamRepository),
+        EscalationService:  NewEscalation(pullRequestRepository, teamRepository, pullRequestService, notifier, tx),
     }
 }
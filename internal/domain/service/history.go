@@ -0,0 +1,65 @@
+package service
+
+import (
+    "context"
+    "fmt"
+
+    "github.com/kimvlry/avito-internship-assignment/internal/domain"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/repository"
+)
+
+// GetHistory returns the reviewer history of the PR oldest first.
+// A non-empty viewerId restricts it to the author and the assigned reviewers of the PR,
+// for anyone else the PR is not found
+func (s *PullRequest) GetHistory(ctx context.Context, prId string, viewerId string) ([]entity.ReviewerEvent, error) {
+    if viewerId == "" {
+        exists, err := s.prRepository.Exists(ctx, prId)
+        if err != nil {
+            return nil, fmt.Errorf("check pr exists: %w", err)
+        }
+        if !exists {
+            return nil, domain.ErrPullRequestNotFound
+        }
+    } else {
+        pr, err := s.prRepository.GetByID(ctx, prId)
+        if err != nil {
+            return nil, fmt.Errorf("get pr: %w", err)
+        }
+        if pr.AuthorID != viewerId && !pr.HasReviewer(viewerId) {
+            return nil, domain.ErrPullRequestNotFound
+        }
+    }
+
+    events, err := s.prRepository.GetEvents(ctx, prId)
+    if err != nil {
+        return nil, fmt.Errorf("get reviewer events: %w", err)
+    }
+    return events, nil
+}
+
+// recordEvents appends events to the reviewer history on behalf of the actor of ctx.
+// It has to be called within the transaction of the change the events describe
+func recordEvents(ctx context.Context, prRepo repository.PullRequestRepository, events ...entity.ReviewerEvent) error {
+    actorID := domain.ActorFrom(ctx)
+    for i := range events {
+        events[i].ActorID = actorID
+    }
+    if err := prRepo.AppendEvents(ctx, events); err != nil {
+        return fmt.Errorf("record reviewer events: %w", err)
+    }
+    return nil
+}
+
+func assignedEvents(prId string, reviewerIds []string, reason string) []entity.ReviewerEvent {
+    events := make([]entity.ReviewerEvent, 0, len(reviewerIds))
+    for _, reviewerId := range reviewerIds {
+        events = append(events, entity.ReviewerEvent{
+            PullRequestID: prId,
+            Type:          entity.EventAssigned,
+            ReviewerID:    reviewerId,
+            Reason:        reason,
+        })
+    }
+    return events
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+    "context"
+    "testing"
+
+    "github.com/kimvlry/avito-internship-assignment/internal/domain"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/service/mocks"
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestPullRequestService_GetHistory(t *testing.T) {
+    ctx := context.Background()
+
+    t.Run("история PR", func(t *testing.T) {
+        events := []entity.ReviewerEvent{
+            {ID: 1, PullRequestID: "pr-1", Type: entity.EventAssigned, ReviewerID: "u2"},
+            {ID: 2, PullRequestID: "pr-1", Type: entity.EventReassigned, ReviewerID: "u3", PreviousReviewerID: "u2"},
+        }
+        mockPRRepo := mocks.NewPullRequestRepository(t)
+        mockPRRepo.On("Exists", ctx, "pr-1").Return(true, nil)
+        mockPRRepo.On("GetEvents", ctx, "pr-1").Return(events, nil)
+
+        svc := NewPullRequest(mockPRRepo, nil, nil, nil, nil, nil)
+        got, err := svc.GetHistory(ctx, "pr-1", "")
+
+        require.NoError(t, err)
+        assert.Equal(t, events, got)
+    })
+
+    t.Run("PR не найден", func(t *testing.T) {
+        mockPRRepo := mocks.NewPullRequestRepository(t)
+        mockPRRepo.On("Exists", ctx, "pr-x").Return(false, nil)
+
+        svc := NewPullRequest(mockPRRepo, nil, nil, nil, nil, nil)
+        _, err := svc.GetHistory(ctx, "pr-x", "")
+
+        assert.ErrorIs(t, err, domain.ErrPullRequestNotFound)
+    })
+
+    pr := &entity.PullRequest{ID: "pr-1", AuthorID: "u1", AssignedReviewers: []string{"u2"}}
+
+    t.Run("история PR для автора и ревьювера", func(t *testing.T) {
+        for _, viewerId := range []string{"u1", "u2"} {
+            mockPRRepo := mocks.NewPullRequestRepository(t)
+            mockPRRepo.On("GetByID", ctx, "pr-1").Return(pr, nil)
+            mockPRRepo.On("GetEvents", ctx, "pr-1").Return([]entity.ReviewerEvent{}, nil)
+
+            svc := NewPullRequest(mockPRRepo, nil, nil, nil, nil, nil)
+            _, err := svc.GetHistory(ctx, "pr-1", viewerId)
+
+            require.NoError(t, err, viewerId)
+        }
+    })
+
+    t.Run("история PR недоступна постороннему", func(t *testing.T) {
+        mockPRRepo := mocks.NewPullRequestRepository(t)
+        mockPRRepo.On("GetByID", ctx, "pr-1").Return(pr, nil)
+
+        svc := NewPullRequest(mockPRRepo, nil, nil, nil, nil, nil)
+        _, err := svc.GetHistory(ctx, "pr-1", "u9")
+
+        assert.ErrorIs(t, err, domain.ErrPullRequestNotFound)
+        mockPRRepo.AssertNotCalled(t, "GetEvents", ctx, "pr-1")
+    })
+}
+
+func TestRecordEvents_SetsActor(t *testing.T) {
+    ctx := domain.WithActor(context.Background(), "admin-1")
+
+    mockPRRepo := mocks.NewPullRequestRepository(t)
+    mockPRRepo.On("AppendEvents", ctx, []entity.ReviewerEvent{
+        {PullRequestID: "pr-1", Type: entity.EventAssigned, ReviewerID: "u2", ActorID: "admin-1", Reason: "reviewer added"},
+        {PullRequestID: "pr-1", Type: entity.EventAssigned, ReviewerID: "u3", ActorID: "admin-1", Reason: "reviewer added"},
+    }).Return(nil)
+
+    err := recordEvents(ctx, mockPRRepo, assignedEvents("pr-1", []string{"u2", "u3"}, "reviewer added")...)
+
+    require.NoError(t, err)
+}
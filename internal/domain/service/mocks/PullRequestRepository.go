@@ -4,8 +4,10 @@ package mocks
 
 import (
 	context "context"
+	time "time"
 
 	entity "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
+	repository "github.com/kimvlry/avito-internship-assignment/internal/domain/repository"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -14,17 +16,53 @@ type PullRequestRepository struct {
 	mock.Mock
 }
 
-// CreateWithReviewers provides a mock function with given fields: ctx, pr
-func (_m *PullRequestRepository) CreateWithReviewers(ctx context.Context, pr *entity.PullRequest) error {
-	ret := _m.Called(ctx, pr)
+// AppendEvents provides a mock function with given fields: ctx, events
+func (_m *PullRequestRepository) AppendEvents(ctx context.Context, events []entity.ReviewerEvent) error {
+	ret := _m.Called(ctx, events)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AppendEvents")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []entity.ReviewerEvent) error); ok {
+		r0 = rf(ctx, events)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AssignReviewers provides a mock function with given fields: ctx, prId, reviewerIds, fallbackIds, dueAt
+func (_m *PullRequestRepository) AssignReviewers(ctx context.Context, prId string, reviewerIds []string, fallbackIds []string, dueAt *time.Time) error {
+	ret := _m.Called(ctx, prId, reviewerIds, fallbackIds, dueAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignReviewers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, []string, *time.Time) error); ok {
+		r0 = rf(ctx, prId, reviewerIds, fallbackIds, dueAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateWithReviewers provides a mock function with given fields: ctx, pr, dueAt
+func (_m *PullRequestRepository) CreateWithReviewers(ctx context.Context, pr *entity.PullRequest, dueAt *time.Time) error {
+	ret := _m.Called(ctx, pr, dueAt)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateWithReviewers")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *entity.PullRequest) error); ok {
-		r0 = rf(ctx, pr)
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.PullRequest, *time.Time) error); ok {
+		r0 = rf(ctx, pr, dueAt)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -150,17 +188,245 @@ func (_m *PullRequestRepository) GetByReviewer(ctx context.Context, userId strin
 	return r0, r1
 }
 
-// ReplaceReviewer provides a mock function with given fields: ctx, prId, oldUserId, newUserId
-func (_m *PullRequestRepository) ReplaceReviewer(ctx context.Context, prId string, oldUserId string, newUserId string) error {
-	ret := _m.Called(ctx, prId, oldUserId, newUserId)
+// GetEvents provides a mock function with given fields: ctx, prId
+func (_m *PullRequestRepository) GetEvents(ctx context.Context, prId string) ([]entity.ReviewerEvent, error) {
+	ret := _m.Called(ctx, prId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEvents")
+	}
+
+	var r0 []entity.ReviewerEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]entity.ReviewerEvent, error)); ok {
+		return rf(ctx, prId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.ReviewerEvent); ok {
+		r0 = rf(ctx, prId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ReviewerEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, prId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOverdueAssignments provides a mock function with given fields: ctx, now, limit
+func (_m *PullRequestRepository) GetOverdueAssignments(ctx context.Context, now time.Time, limit int) ([]repository.OverdueAssignment, error) {
+	ret := _m.Called(ctx, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOverdueAssignments")
+	}
+
+	var r0 []repository.OverdueAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]repository.OverdueAssignment, error)); ok {
+		return rf(ctx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []repository.OverdueAssignment); ok {
+		r0 = rf(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.OverdueAssignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, filter, after, limit
+func (_m *PullRequestRepository) List(ctx context.Context, filter repository.PullRequestFilter, after *repository.PullRequestCursor, limit int) ([]*entity.PullRequest, error) {
+	ret := _m.Called(ctx, filter, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*entity.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.PullRequestFilter, *repository.PullRequestCursor, int) ([]*entity.PullRequest, error)); ok {
+		return rf(ctx, filter, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.PullRequestFilter, *repository.PullRequestCursor, int) []*entity.PullRequest); ok {
+		r0 = rf(ctx, filter, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.PullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.PullRequestFilter, *repository.PullRequestCursor, int) error); ok {
+		r1 = rf(ctx, filter, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkEscalated provides a mock function with given fields: ctx, prId, reviewerId
+func (_m *PullRequestRepository) MarkEscalated(ctx context.Context, prId string, reviewerId string) error {
+	ret := _m.Called(ctx, prId, reviewerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkEscalated")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, prId, reviewerId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RecordEscalationFailure provides a mock function with given fields: ctx, prId, reviewerId, retryAt
+func (_m *PullRequestRepository) RecordEscalationFailure(ctx context.Context, prId string, reviewerId string, retryAt time.Time) error {
+	ret := _m.Called(ctx, prId, reviewerId, retryAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordEscalationFailure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
+		r0 = rf(ctx, prId, reviewerId, retryAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RecordMergeOverride provides a mock function with given fields: ctx, prId, actorId, unmet
+func (_m *PullRequestRepository) RecordMergeOverride(ctx context.Context, prId string, actorId string, unmet []string) error {
+	ret := _m.Called(ctx, prId, actorId, unmet)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordMergeOverride")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string) error); ok {
+		r0 = rf(ctx, prId, actorId, unmet)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveReviewer provides a mock function with given fields: ctx, prId, userId
+func (_m *PullRequestRepository) RemoveReviewer(ctx context.Context, prId string, userId string) error {
+	ret := _m.Called(ctx, prId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveReviewer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, prId, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReplaceReviewer provides a mock function with given fields: ctx, prId, oldUserId, newUserId, isFallback, dueAt
+func (_m *PullRequestRepository) ReplaceReviewer(ctx context.Context, prId string, oldUserId string, newUserId string, isFallback bool, dueAt *time.Time) error {
+	ret := _m.Called(ctx, prId, oldUserId, newUserId, isFallback, dueAt)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ReplaceReviewer")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
-		r0 = rf(ctx, prId, oldUserId, newUserId)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, bool, *time.Time) error); ok {
+		r0 = rf(ctx, prId, oldUserId, newUserId, isFallback, dueAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Search provides a mock function with given fields: ctx, query, filter, limit
+func (_m *PullRequestRepository) Search(ctx context.Context, query string, filter repository.PullRequestFilter, limit int) ([]repository.PullRequestSearchHit, error) {
+	ret := _m.Called(ctx, query, filter, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []repository.PullRequestSearchHit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, repository.PullRequestFilter, int) ([]repository.PullRequestSearchHit, error)); ok {
+		return rf(ctx, query, filter, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, repository.PullRequestFilter, int) []repository.PullRequestSearchHit); ok {
+		r0 = rf(ctx, query, filter, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.PullRequestSearchHit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, repository.PullRequestFilter, int) error); ok {
+		r1 = rf(ctx, query, filter, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubmitVerdict provides a mock function with given fields: ctx, prId, reviewerId, verdict, comment
+func (_m *PullRequestRepository) SubmitVerdict(ctx context.Context, prId string, reviewerId string, verdict entity.ReviewVerdict, comment string) error {
+	ret := _m.Called(ctx, prId, reviewerId, verdict, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubmitVerdict")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, entity.ReviewVerdict, string) error); ok {
+		r0 = rf(ctx, prId, reviewerId, verdict, comment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateDetails provides a mock function with given fields: ctx, pr
+func (_m *PullRequestRepository) UpdateDetails(ctx context.Context, pr *entity.PullRequest) error {
+	ret := _m.Called(ctx, pr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDetails")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.PullRequest) error); ok {
+		r0 = rf(ctx, pr)
 	} else {
 		r0 = ret.Error(0)
 	}
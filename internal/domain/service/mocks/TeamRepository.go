@@ -6,6 +6,7 @@ import (
 	context "context"
 
 	entity "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
+	repository "github.com/kimvlry/avito-internship-assignment/internal/domain/repository"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -32,6 +33,24 @@ func (_m *TeamRepository) Create(ctx context.Context, team *entity.Team) error {
 	return r0
 }
 
+// Delete provides a mock function with given fields: ctx, teamName
+func (_m *TeamRepository) Delete(ctx context.Context, teamName string) error {
+	ret := _m.Called(ctx, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, teamName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Exists provides a mock function with given fields: ctx, teamName
 func (_m *TeamRepository) Exists(ctx context.Context, teamName string) (bool, error) {
 	ret := _m.Called(ctx, teamName)
@@ -60,6 +79,36 @@ func (_m *TeamRepository) Exists(ctx context.Context, teamName string) (bool, er
 	return r0, r1
 }
 
+// GetAncestors provides a mock function with given fields: ctx, teamName
+func (_m *TeamRepository) GetAncestors(ctx context.Context, teamName string) ([]string, error) {
+	ret := _m.Called(ctx, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAncestors")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return rf(ctx, teamName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, teamName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, teamName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByName provides a mock function with given fields: ctx, name
 func (_m *TeamRepository) GetByName(ctx context.Context, name string) (*entity.Team, error) {
 	ret := _m.Called(ctx, name)
@@ -90,6 +139,196 @@ func (_m *TeamRepository) GetByName(ctx context.Context, name string) (*entity.T
 	return r0, r1
 }
 
+// GetChildren provides a mock function with given fields: ctx, teamName
+func (_m *TeamRepository) GetChildren(ctx context.Context, teamName string) ([]string, error) {
+	ret := _m.Called(ctx, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChildren")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return rf(ctx, teamName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, teamName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, teamName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetParents provides a mock function with given fields: ctx
+func (_m *TeamRepository) GetParents(ctx context.Context) (map[string]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetParents")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, afterName, limit
+func (_m *TeamRepository) List(ctx context.Context, afterName string, limit int) ([]repository.TeamSummary, error) {
+	ret := _m.Called(ctx, afterName, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []repository.TeamSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]repository.TeamSummary, error)); ok {
+		return rf(ctx, afterName, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []repository.TeamSummary); ok {
+		r0 = rf(ctx, afterName, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.TeamSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, afterName, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LockRotationCursor provides a mock function with given fields: ctx, teamName
+func (_m *TeamRepository) LockRotationCursor(ctx context.Context, teamName string) (string, error) {
+	ret := _m.Called(ctx, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockRotationCursor")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, teamName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, teamName)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, teamName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Rename provides a mock function with given fields: ctx, oldName, newName
+func (_m *TeamRepository) Rename(ctx context.Context, oldName string, newName string) error {
+	ret := _m.Called(ctx, oldName, newName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rename")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, oldName, newName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetLead provides a mock function with given fields: ctx, teamName, leadID
+func (_m *TeamRepository) SetLead(ctx context.Context, teamName string, leadID string) error {
+	ret := _m.Called(ctx, teamName, leadID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLead")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, teamName, leadID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetRotationCursor provides a mock function with given fields: ctx, teamName, lastUserID
+func (_m *TeamRepository) SetRotationCursor(ctx context.Context, teamName string, lastUserID string) error {
+	ret := _m.Called(ctx, teamName, lastUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRotationCursor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, teamName, lastUserID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, team
+func (_m *TeamRepository) Update(ctx context.Context, team *entity.Team) error {
+	ret := _m.Called(ctx, team)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Team) error); ok {
+		r0 = rf(ctx, team)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewTeamRepository creates a new instance of TeamRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewTeamRepository(t interface {
@@ -4,6 +4,7 @@ package mocks
 
 import (
 	context "context"
+	time "time"
 
 	entity "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
 	mock "github.com/stretchr/testify/mock"
@@ -14,6 +15,42 @@ type UserRepository struct {
 	mock.Mock
 }
 
+// AddToTeam provides a mock function with given fields: ctx, userID, teamName
+func (_m *UserRepository) AddToTeam(ctx context.Context, userID string, teamName string) error {
+	ret := _m.Called(ctx, userID, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddToTeam")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, teamName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddUnavailability provides a mock function with given fields: ctx, period
+func (_m *UserRepository) AddUnavailability(ctx context.Context, period *entity.Unavailability) error {
+	ret := _m.Called(ctx, period)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddUnavailability")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Unavailability) error); ok {
+		r0 = rf(ctx, period)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CheckUsersAvailableForTeam provides a mock function with given fields: ctx, userIDs, teamName
 func (_m *UserRepository) CheckUsersAvailableForTeam(ctx context.Context, userIDs []string, teamName string) error {
 	ret := _m.Called(ctx, userIDs, teamName)
@@ -50,6 +87,24 @@ func (_m *UserRepository) Create(ctx context.Context, user *entity.User) error {
 	return r0
 }
 
+// DeleteUnavailability provides a mock function with given fields: ctx, userID, id
+func (_m *UserRepository) DeleteUnavailability(ctx context.Context, userID string, id int64) error {
+	ret := _m.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUnavailability")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) error); ok {
+		r0 = rf(ctx, userID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Exists provides a mock function with given fields: ctx, id
 func (_m *UserRepository) Exists(ctx context.Context, id string) (bool, error) {
 	ret := _m.Called(ctx, id)
@@ -78,6 +133,36 @@ func (_m *UserRepository) Exists(ctx context.Context, id string) (bool, error) {
 	return r0, r1
 }
 
+// GetAllReviewLoads provides a mock function with given fields: ctx
+func (_m *UserRepository) GetAllReviewLoads(ctx context.Context) ([]entity.User, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllReviewLoads")
+	}
+
+	var r0 []entity.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]entity.User, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []entity.User); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByID provides a mock function with given fields: ctx, id
 func (_m *UserRepository) GetByID(ctx context.Context, id string) (*entity.User, error) {
 	ret := _m.Called(ctx, id)
@@ -138,6 +223,66 @@ func (_m *UserRepository) GetByTeam(ctx context.Context, teamName string) ([]ent
 	return r0, r1
 }
 
+// GetExpertActiveTeamUsers provides a mock function with given fields: ctx, teamName, excludeUserIDs, tags, maxCount
+func (_m *UserRepository) GetExpertActiveTeamUsers(ctx context.Context, teamName string, excludeUserIDs []string, tags []string, maxCount int) ([]entity.User, error) {
+	ret := _m.Called(ctx, teamName, excludeUserIDs, tags, maxCount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExpertActiveTeamUsers")
+	}
+
+	var r0 []entity.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, []string, int) ([]entity.User, error)); ok {
+		return rf(ctx, teamName, excludeUserIDs, tags, maxCount)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, []string, int) []entity.User); ok {
+		r0 = rf(ctx, teamName, excludeUserIDs, tags, maxCount)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, []string, int) error); ok {
+		r1 = rf(ctx, teamName, excludeUserIDs, tags, maxCount)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLeastLoadedActiveTeamUsers provides a mock function with given fields: ctx, teamName, excludeUserIDs, maxCount
+func (_m *UserRepository) GetLeastLoadedActiveTeamUsers(ctx context.Context, teamName string, excludeUserIDs []string, maxCount int) ([]entity.User, error) {
+	ret := _m.Called(ctx, teamName, excludeUserIDs, maxCount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLeastLoadedActiveTeamUsers")
+	}
+
+	var r0 []entity.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, int) ([]entity.User, error)); ok {
+		return rf(ctx, teamName, excludeUserIDs, maxCount)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, int) []entity.User); ok {
+		r0 = rf(ctx, teamName, excludeUserIDs, maxCount)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, int) error); ok {
+		r1 = rf(ctx, teamName, excludeUserIDs, maxCount)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRandomActiveTeamUsers provides a mock function with given fields: ctx, teamName, excludeUserIDs, maxCount
 func (_m *UserRepository) GetRandomActiveTeamUsers(ctx context.Context, teamName string, excludeUserIDs []string, maxCount int) ([]entity.User, error) {
 	ret := _m.Called(ctx, teamName, excludeUserIDs, maxCount)
@@ -168,6 +313,90 @@ func (_m *UserRepository) GetRandomActiveTeamUsers(ctx context.Context, teamName
 	return r0, r1
 }
 
+// GetUpcomingUnavailability provides a mock function with given fields: ctx, userIDs, now
+func (_m *UserRepository) GetUpcomingUnavailability(ctx context.Context, userIDs []string, now time.Time) ([]entity.Unavailability, error) {
+	ret := _m.Called(ctx, userIDs, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUpcomingUnavailability")
+	}
+
+	var r0 []entity.Unavailability
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, time.Time) ([]entity.Unavailability, error)); ok {
+		return rf(ctx, userIDs, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, time.Time) []entity.Unavailability); ok {
+		r0 = rf(ctx, userIDs, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Unavailability)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, time.Time) error); ok {
+		r1 = rf(ctx, userIDs, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MoveTeamMembers provides a mock function with given fields: ctx, fromTeam, toTeam
+func (_m *UserRepository) MoveTeamMembers(ctx context.Context, fromTeam string, toTeam string) error {
+	ret := _m.Called(ctx, fromTeam, toTeam)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveTeamMembers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, fromTeam, toTeam)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveFromTeam provides a mock function with given fields: ctx, userID, teamName
+func (_m *UserRepository) RemoveFromTeam(ctx context.Context, userID string, teamName string) error {
+	ret := _m.Called(ctx, userID, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveFromTeam")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, teamName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetExpertise provides a mock function with given fields: ctx, userID, tags
+func (_m *UserRepository) SetExpertise(ctx context.Context, userID string, tags []string) error {
+	ret := _m.Called(ctx, userID, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetExpertise")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = rf(ctx, userID, tags)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetIsActive provides a mock function with given fields: ctx, id, isActive
 func (_m *UserRepository) SetIsActive(ctx context.Context, id string, isActive bool) (*entity.User, error) {
 	ret := _m.Called(ctx, id, isActive)
@@ -198,6 +427,54 @@ func (_m *UserRepository) SetIsActive(ctx context.Context, id string, isActive b
 	return r0, r1
 }
 
+// SetIsActiveBatch provides a mock function with given fields: ctx, ids, isActive
+func (_m *UserRepository) SetIsActiveBatch(ctx context.Context, ids []string, isActive bool) ([]entity.User, error) {
+	ret := _m.Called(ctx, ids, isActive)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetIsActiveBatch")
+	}
+
+	var r0 []entity.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, bool) ([]entity.User, error)); ok {
+		return rf(ctx, ids, isActive)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, bool) []entity.User); ok {
+		r0 = rf(ctx, ids, isActive)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, bool) error); ok {
+		r1 = rf(ctx, ids, isActive)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetMaxOpenReviews provides a mock function with given fields: ctx, id, maxOpenReviews
+func (_m *UserRepository) SetMaxOpenReviews(ctx context.Context, id string, maxOpenReviews *int) error {
+	ret := _m.Called(ctx, id, maxOpenReviews)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMaxOpenReviews")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *int) error); ok {
+		r0 = rf(ctx, id, maxOpenReviews)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: ctx, user
 func (_m *UserRepository) Update(ctx context.Context, user *entity.User) error {
 	ret := _m.Called(ctx, user)
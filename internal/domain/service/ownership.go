@@ -0,0 +1,103 @@
+package service
+
+import (
+    "context"
+    "fmt"
+    "strings"
+
+    "github.com/kimvlry/avito-internship-assignment/internal/domain"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/repository"
+    "github.com/kimvlry/avito-internship-assignment/pkg/codeowners"
+)
+
+type Ownership struct {
+    ownershipRepository repository.OwnershipRepository
+    userRepository      repository.UserRepository
+    teamRepository      repository.TeamRepository
+    tx                  repository.Transactor
+}
+
+func NewOwnership(
+    ownershipRepo repository.OwnershipRepository,
+    userRepo repository.UserRepository,
+    teamRepo repository.TeamRepository,
+    tx repository.Transactor,
+) *Ownership {
+    return &Ownership{
+        ownershipRepository: ownershipRepo,
+        userRepository:      userRepo,
+        teamRepository:      teamRepo,
+        tx:                  tx,
+    }
+}
+
+// UploadRules replaces ownership rules with the ones from a CODEOWNERS document.
+// Every owner has to exist, so a typo does not silently disable a rule
+func (s *Ownership) UploadRules(ctx context.Context, document string) ([]entity.OwnershipRule, error) {
+    ruleset, err := codeowners.Parse(strings.NewReader(document))
+    if err != nil {
+        return nil, fmt.Errorf("%w: %s", domain.ErrInvalidOwnershipRules, err)
+    }
+
+    rules := make([]entity.OwnershipRule, 0, len(ruleset))
+    for _, rule := range ruleset {
+        rules = append(rules, entity.OwnershipRule{
+            Pattern:   rule.Pattern,
+            UserIDs:   rule.Users,
+            TeamNames: rule.Teams,
+        })
+    }
+
+    err = s.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+        for i, rule := range rules {
+            missing, err := s.missingOwner(txCtx, rule)
+            if err != nil {
+                return err
+            }
+            if missing != "" {
+                return fmt.Errorf("%w: line %d: %s not found", domain.ErrInvalidOwnershipRules, ruleset[i].Line, missing)
+            }
+        }
+        if err := s.ownershipRepository.ReplaceAll(txCtx, rules); err != nil {
+            return fmt.Errorf("replace ownership rules: %w", err)
+        }
+        return nil
+    })
+
+    if err != nil {
+        return nil, err
+    }
+    return rules, nil
+}
+
+func (s *Ownership) GetRules(ctx context.Context) ([]entity.OwnershipRule, error) {
+    rules, err := s.ownershipRepository.GetAll(ctx)
+    if err != nil {
+        return nil, fmt.Errorf("get ownership rules: %w", err)
+    }
+    return rules, nil
+}
+
+// missingOwner returns the first owner of the rule that does not exist
+func (s *Ownership) missingOwner(ctx context.Context, rule entity.OwnershipRule) (string, error) {
+    for _, userID := range rule.UserIDs {
+        exists, err := s.userRepository.Exists(ctx, userID)
+        if err != nil {
+            return "", fmt.Errorf("check user exists: %w", err)
+        }
+        if !exists {
+            return "user " + userID, nil
+        }
+    }
+    for _, teamName := range rule.TeamNames {
+        exists, err := s.teamRepository.Exists(ctx, teamName)
+        if err != nil {
+            return "", fmt.Errorf("check team exists: %w", err)
+        }
+        if !exists {
+            return "team " + teamName, nil
+        }
+    }
+    return "", nil
+}
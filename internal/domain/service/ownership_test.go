@@ -0,0 +1,82 @@
+package service
+
+import (
+    "context"
+    "testing"
+
+    "github.com/kimvlry/avito-internship-assignment/internal/domain"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/service/mocks"
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/mock"
+    "github.com/stretchr/testify/require"
+)
+
+func TestOwnershipService_UploadRules(t *testing.T) {
+    tests := []struct {
+        name            string
+        document        string
+        mockUserExists  bool
+        expectRules     []entity.OwnershipRule
+        expectError     bool
+        expectedErrType error
+    }{
+        {
+            name:           "успешная загрузка",
+            document:       "# owners\n*.sql @u3 @org/dba\n",
+            mockUserExists: true,
+            expectRules: []entity.OwnershipRule{
+                {Pattern: "*.sql", UserIDs: []string{"u3"}, TeamNames: []string{"dba"}},
+            },
+        },
+        {
+            name:            "ошибка: документ не разбирается",
+            document:        "*.sql u3",
+            expectError:     true,
+            expectedErrType: domain.ErrInvalidOwnershipRules,
+        },
+        {
+            name:            "ошибка: владелец не существует",
+            document:        "*.sql @u3 @org/dba",
+            mockUserExists:  false,
+            expectError:     true,
+            expectedErrType: domain.ErrInvalidOwnershipRules,
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            ctx := context.Background()
+
+            mockOwnershipRepo := mocks.NewOwnershipRepository(t)
+            mockUserRepo := mocks.NewUserRepository(t)
+            mockTeamRepo := mocks.NewTeamRepository(t)
+            mockTx := mocks.NewTransactor(t)
+
+            mockTx.On(
+                "WithinTransaction",
+                mock.Anything,
+                mock.AnythingOfType("func(context.Context) error"),
+            ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+                return fn(ctx)
+            }).Maybe()
+            mockUserRepo.On("Exists", mock.Anything, "u3").Return(tt.mockUserExists, nil).Maybe()
+            mockTeamRepo.On("Exists", mock.Anything, "dba").Return(true, nil).Maybe()
+            if !tt.expectError {
+                mockOwnershipRepo.On("ReplaceAll", mock.Anything, tt.expectRules).Return(nil)
+            }
+
+            svc := NewOwnership(mockOwnershipRepo, mockUserRepo, mockTeamRepo, mockTx)
+            rules, err := svc.UploadRules(ctx, tt.document)
+
+            if tt.expectError {
+                require.Error(t, err)
+                assert.ErrorIs(t, err, tt.expectedErrType)
+                return
+            }
+
+            require.NoError(t, err)
+            assert.Equal(t, tt.expectRules, rules)
+        })
+    }
+}
@@ -2,36 +2,62 @@ package service
 
 import (
     "context"
+    "errors"
     "fmt"
     "github.com/kimvlry/avito-internship-assignment/internal/domain"
     "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
     "github.com/kimvlry/avito-internship-assignment/internal/domain/repository"
+    "github.com/kimvlry/avito-internship-assignment/pkg/codeowners"
+    "slices"
+    "strings"
     "time"
 )
 
 type PullRequest struct {
-    prRepository   repository.PullRequestRepository
-    userRepository repository.UserRepository
-    tx             repository.Transactor
+    prRepository        repository.PullRequestRepository
+    userRepository      repository.UserRepository
+    teamRepository      repository.TeamRepository
+    ownershipRepository repository.OwnershipRepository
+    selectors           ReviewerSelectors
+    tx                  repository.Transactor
 }
 
 func NewPullRequest(
     prRepo repository.PullRequestRepository,
     userRepo repository.UserRepository,
+    teamRepo repository.TeamRepository,
+    ownershipRepo repository.OwnershipRepository,
+    selectors ReviewerSelectors,
     tx repository.Transactor,
 ) *PullRequest {
     return &PullRequest{
-        prRepository:   prRepo,
-        userRepository: userRepo,
-        tx:             tx,
+        prRepository:        prRepo,
+        userRepository:      userRepo,
+        teamRepository:      teamRepo,
+        ownershipRepository: ownershipRepo,
+        selectors:           selectors,
+        tx:                  tx,
     }
 }
 
+// CreateOptions are optional hints for picking reviewers of a new PR
+type CreateOptions struct {
+    // ChangedPaths get their owners assigned before the author's team is asked
+    ChangedPaths []string
+    // RequiredTags make teammates with matching expertise preferred over the team strategy
+    RequiredTags []string
+    // Reviewers picked by hand replace automatic selection, each has to be a valid candidate
+    Reviewers []string
+    // Draft PRs get no reviewers until they are marked ready
+    Draft bool
+}
+
 func (s *PullRequest) CreatePullRequestWithReviewers(
     ctx context.Context,
     prId,
     prName,
     authorId string,
+    opts CreateOptions,
 ) (*entity.PullRequest, error) {
 
     ok, err := s.prRepository.Exists(ctx, prId)
@@ -49,28 +75,40 @@ func (s *PullRequest) CreatePullRequestWithReviewers(
 
     var createdPr *entity.PullRequest
     err = s.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
-        reviewers, err := s.userRepository.GetRandomActiveTeamUsers(txCtx, author.TeamName, []string{authorId}, 2)
+        team, err := s.authorTeam(txCtx, author)
         if err != nil {
-            return fmt.Errorf("get reviewers: %w", err)
+            return err
         }
-        reviewersIds := make([]string, len(reviewers))
-        for i, reviewer := range reviewers {
-            reviewersIds[i] = reviewer.ID
+
+        status := entity.PROpen
+        reviewersIds, fallbackIds := []string{}, []string{}
+        if opts.Draft {
+            status = entity.PRDraft
+        } else {
+            reviewersIds, fallbackIds, err = s.chooseReviewers(txCtx, team, author, opts)
+            if err != nil {
+                return err
+            }
         }
 
         pr := &entity.PullRequest{
             ID:                prId,
             Name:              prName,
             AuthorID:          authorId,
-            Status:            entity.PROpen,
+            Status:            status,
             AssignedReviewers: reviewersIds,
+            FallbackReviewers: fallbackIds,
             CreatedAt:         time.Now(),
             MergedAt:          nil,
         }
 
-        if err := s.prRepository.CreateWithReviewers(txCtx, pr); err != nil {
+        if err := s.prRepository.CreateWithReviewers(txCtx, pr, team.ReviewSLA.DueAt(pr.CreatedAt)); err != nil {
             return fmt.Errorf("create pr: %w", err)
         }
+        reason := domain.ReasonFrom(txCtx, "pull request created")
+        if err := recordEvents(txCtx, s.prRepository, assignedEvents(prId, reviewersIds, reason)...); err != nil {
+            return err
+        }
         createdPr = pr
         return nil
     })
@@ -81,14 +119,16 @@ func (s *PullRequest) CreatePullRequestWithReviewers(
     return createdPr, nil
 }
 
+// ReassignReviewer replaces oldUserId on the PR. An empty newUserId lets the team strategy
+// pick the replacement, otherwise newUserId has to be a valid candidate
 func (s *PullRequest) ReassignReviewer(
     ctx context.Context,
     prId,
-    oldUserId string,
+    oldUserId,
+    newUserId string,
 ) (*entity.PullRequest, string, error) {
 
     var updatedPr *entity.PullRequest
-    var newUserId string
 
     err := s.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
         pr, err := s.prRepository.GetByID(txCtx, prId)
@@ -98,6 +138,9 @@ func (s *PullRequest) ReassignReviewer(
         if pr.IsMerged() {
             return domain.ErrPullRequestIsMerged
         }
+        if !pr.IsOpen() {
+            return fmt.Errorf("%w: pull request is %s", domain.ErrPullRequestNotOpen, pr.Status)
+        }
         if !pr.HasReviewer(oldUserId) {
             return domain.ErrReviewerNotAssigned
         }
@@ -106,23 +149,64 @@ func (s *PullRequest) ReassignReviewer(
         if err != nil {
             return fmt.Errorf("get old user: %w", err)
         }
-
-        excludedIds := append(pr.AssignedReviewers, pr.AuthorID)
-
-        replacements, err := s.userRepository.GetRandomActiveTeamUsers(txCtx, oldUser.TeamName, excludedIds, 1)
+        author, err := s.userRepository.GetByID(txCtx, pr.AuthorID)
         if err != nil {
-            return fmt.Errorf("get replacements: %w", err)
+            return fmt.Errorf("get pr author: %w", err)
         }
-        if len(replacements) == 0 {
-            return domain.ErrNoReviewerCandidate
+        authorTeam, err := s.authorTeam(txCtx, author)
+        if err != nil {
+            return err
         }
 
-        newReviewer := replacements[0]
-        newUserId = newReviewer.ID
+        var isFallback bool
+        if newUserId != "" {
+            teams := allowedReviewerTeams(authorTeam)
+            if _, ok := teams[oldUser.TeamName]; !ok {
+                teams[oldUser.TeamName] = false
+            }
+            isFallback, err = s.checkManualReviewer(txCtx, newUserId, pr.AuthorID, pr.AssignedReviewers, teams)
+            if err != nil {
+                return err
+            }
+        } else {
+            // a fallback reviewer is replaced starting from the author's team again
+            team := authorTeam
+            if !pr.IsFallbackReviewer(oldUserId) && !oldUser.InTeam(authorTeam.Name) && oldUser.TeamName != "" {
+                team, err = s.teamRepository.GetByName(txCtx, oldUser.TeamName)
+                if err != nil {
+                    return fmt.Errorf("get reviewers team: %w", err)
+                }
+            }
+
+            excludedIds := append(slices.Clone(pr.AssignedReviewers), pr.AuthorID)
 
-        if err := s.prRepository.ReplaceReviewer(txCtx, prId, oldUserId, newUserId); err != nil {
+            replacements, fallbackIds, err := s.pickReviewers(txCtx, team, excludedIds, 1)
+            if err != nil {
+                return fmt.Errorf("get replacements: %w", err)
+            }
+            if len(replacements) == 0 {
+                return domain.ErrNoReviewerCandidate
+            }
+
+            newUserId = replacements[0]
+            isFallback = len(fallbackIds) > 0
+        }
+
+        if err := s.prRepository.ReplaceReviewer(
+            txCtx, prId, oldUserId, newUserId, isFallback, authorTeam.ReviewSLA.DueAt(time.Now()),
+        ); err != nil {
             return fmt.Errorf("replace reviewer: %w", err)
         }
+        err = recordEvents(txCtx, s.prRepository, entity.ReviewerEvent{
+            PullRequestID:      prId,
+            Type:               entity.EventReassigned,
+            ReviewerID:         newUserId,
+            PreviousReviewerID: oldUserId,
+            Reason:             domain.ReasonFrom(txCtx, "reviewer reassigned"),
+        })
+        if err != nil {
+            return err
+        }
         updatedPr, err = s.prRepository.GetByID(txCtx, prId)
         if err != nil {
             return fmt.Errorf("get updated pr: %w", err)
@@ -136,19 +220,932 @@ func (s *PullRequest) ReassignReviewer(
     return updatedPr, newUserId, nil
 }
 
-func (s *PullRequest) Merge(ctx context.Context, prId string) (*entity.PullRequest, error) {
-    pr, err := s.prRepository.GetByID(ctx, prId)
+// AddReviewer assigns one more reviewer to an OPEN PR within the max of the author's team.
+// An empty userId picks the reviewer the way automatic assignment does
+func (s *PullRequest) AddReviewer(ctx context.Context, prId, userId string) (*entity.PullRequest, string, error) {
+    var updatedPr *entity.PullRequest
+
+    err := s.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+        pr, err := s.prRepository.GetByID(txCtx, prId)
+        if err != nil {
+            return fmt.Errorf("get pr: %w", err)
+        }
+        if pr.IsMerged() {
+            return domain.ErrPullRequestIsMerged
+        }
+        if !pr.IsOpen() {
+            return fmt.Errorf("%w: pull request is %s", domain.ErrPullRequestNotOpen, pr.Status)
+        }
+
+        author, err := s.userRepository.GetByID(txCtx, pr.AuthorID)
+        if err != nil {
+            return fmt.Errorf("get pr author: %w", err)
+        }
+        team, err := s.authorTeam(txCtx, author)
+        if err != nil {
+            return err
+        }
+        if len(pr.AssignedReviewers) >= team.MaxReviewers {
+            return fmt.Errorf("%w: team %s allows at most %d reviewers",
+                domain.ErrReviewerLimitReached, team.Name, team.MaxReviewers)
+        }
+
+        var isFallback bool
+        if userId != "" {
+            isFallback, err = s.checkManualReviewer(txCtx, userId, pr.AuthorID, pr.AssignedReviewers, allowedReviewerTeams(team))
+            if err != nil {
+                return err
+            }
+        } else {
+            excludedIds := append(slices.Clone(pr.AssignedReviewers), pr.AuthorID)
+            reviewerIds, fallbackIds, err := s.pickReviewers(txCtx, team, excludedIds, 1)
+            if err != nil {
+                return fmt.Errorf("get reviewers: %w", err)
+            }
+            if len(reviewerIds) == 0 {
+                return domain.ErrNoReviewerCandidate
+            }
+            userId = reviewerIds[0]
+            isFallback = len(fallbackIds) > 0
+        }
+
+        fallbackIds := []string{}
+        if isFallback {
+            fallbackIds = append(fallbackIds, userId)
+        }
+        dueAt := team.ReviewSLA.DueAt(time.Now())
+        if err := s.prRepository.AssignReviewers(txCtx, prId, []string{userId}, fallbackIds, dueAt); err != nil {
+            return fmt.Errorf("assign reviewer: %w", err)
+        }
+        reason := domain.ReasonFrom(txCtx, "reviewer added")
+        if err := recordEvents(txCtx, s.prRepository, assignedEvents(prId, []string{userId}, reason)...); err != nil {
+            return err
+        }
+        updatedPr, err = s.prRepository.GetByID(txCtx, prId)
+        if err != nil {
+            return fmt.Errorf("get updated pr: %w", err)
+        }
+        return nil
+    })
+
     if err != nil {
-        return nil, fmt.Errorf("get pr: %w", err)
+        return nil, "", err
     }
-    if pr.IsMerged() {
-        return pr, nil
+    return updatedPr, userId, nil
+}
+
+// RemoveReviewer drops a reviewer from an OPEN PR without a replacement, team.MinReviewers
+// only applies when reviewers are picked
+func (s *PullRequest) RemoveReviewer(ctx context.Context, prId, userId string) (*entity.PullRequest, error) {
+    var updatedPr *entity.PullRequest
+
+    err := s.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+        pr, err := s.prRepository.GetByID(txCtx, prId)
+        if err != nil {
+            return fmt.Errorf("get pr: %w", err)
+        }
+        if pr.IsMerged() {
+            return domain.ErrPullRequestIsMerged
+        }
+        if !pr.IsOpen() {
+            return fmt.Errorf("%w: pull request is %s", domain.ErrPullRequestNotOpen, pr.Status)
+        }
+        if !pr.HasReviewer(userId) {
+            return domain.ErrReviewerNotAssigned
+        }
+
+        if err := s.prRepository.RemoveReviewer(txCtx, prId, userId); err != nil {
+            return fmt.Errorf("remove reviewer: %w", err)
+        }
+        err = recordEvents(txCtx, s.prRepository, entity.ReviewerEvent{
+            PullRequestID: prId,
+            Type:          entity.EventUnassigned,
+            ReviewerID:    userId,
+            Reason:        domain.ReasonFrom(txCtx, "reviewer removed"),
+        })
+        if err != nil {
+            return err
+        }
+        updatedPr, err = s.prRepository.GetByID(txCtx, prId)
+        if err != nil {
+            return fmt.Errorf("get updated pr: %w", err)
+        }
+        return nil
+    })
+
+    if err != nil {
+        return nil, err
     }
-    if err := pr.SetMerged(); err != nil {
-        return nil, fmt.Errorf("merge pr: %w", err)
+    return updatedPr, nil
+}
+
+// PullRequestUpdate holds PR fields to change, nil fields are left as is.
+// An empty string clears an optional field
+type PullRequestUpdate struct {
+    Name         *string
+    Description  *string
+    URL          *string
+    SourceBranch *string
+    TargetBranch *string
+    ExternalID   *string
+}
+
+// UpdatePullRequest changes the name and the metadata of an OPEN PR
+func (s *PullRequest) UpdatePullRequest(
+    ctx context.Context,
+    prId string,
+    update PullRequestUpdate,
+) (*entity.PullRequest, error) {
+    var updatedPr *entity.PullRequest
+
+    err := s.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+        pr, err := s.prRepository.GetByID(txCtx, prId)
+        if err != nil {
+            return fmt.Errorf("get pr: %w", err)
+        }
+        if pr.IsMerged() {
+            return domain.ErrPullRequestIsMerged
+        }
+        if !pr.IsOpen() {
+            return fmt.Errorf("%w: pull request is %s", domain.ErrPullRequestNotOpen, pr.Status)
+        }
+
+        if update.Name != nil {
+            pr.Name = *update.Name
+        }
+        if update.Description != nil {
+            pr.Description = *update.Description
+        }
+        if update.URL != nil {
+            pr.URL = *update.URL
+        }
+        if update.SourceBranch != nil {
+            pr.SourceBranch = *update.SourceBranch
+        }
+        if update.TargetBranch != nil {
+            pr.TargetBranch = *update.TargetBranch
+        }
+        if update.ExternalID != nil {
+            pr.ExternalID = *update.ExternalID
+        }
+
+        if err := s.prRepository.UpdateDetails(txCtx, pr); err != nil {
+            return fmt.Errorf("update pr details: %w", err)
+        }
+        updatedPr, err = s.prRepository.GetByID(txCtx, prId)
+        if err != nil {
+            return fmt.Errorf("get updated pr: %w", err)
+        }
+        return nil
+    })
+
+    if err != nil {
+        return nil, err
+    }
+    return updatedPr, nil
+}
+
+// ListPullRequests returns a page of PRs newest first and the cursor of the next page, nil on the last one
+func (s *PullRequest) ListPullRequests(
+    ctx context.Context,
+    filter repository.PullRequestFilter,
+    after *repository.PullRequestCursor,
+    limit int,
+) ([]*entity.PullRequest, *repository.PullRequestCursor, error) {
+    prs, err := s.prRepository.List(ctx, filter, after, limit+1)
+    if err != nil {
+        return nil, nil, fmt.Errorf("list prs: %w", err)
+    }
+    if len(prs) <= limit {
+        return prs, nil, nil
+    }
+
+    prs = prs[:limit]
+    last := prs[len(prs)-1]
+    return prs, &repository.PullRequestCursor{CreatedAt: last.CreatedAt, ID: last.ID}, nil
+}
+
+// SearchPullRequests returns up to limit PRs whose name or description match the text, best matches first
+func (s *PullRequest) SearchPullRequests(
+    ctx context.Context,
+    text string,
+    filter repository.PullRequestFilter,
+    limit int,
+) ([]repository.PullRequestSearchHit, error) {
+    hits, err := s.prRepository.Search(ctx, text, filter, limit)
+    if err != nil {
+        return nil, fmt.Errorf("search prs: %w", err)
+    }
+    return hits, nil
+}
+
+// MergeOptions let an admin merge a PR that does not satisfy the merge policy of the author's team
+type MergeOptions struct {
+    Override bool
+    // ActorID is recorded as the one who overrode the policy
+    ActorID string
+}
+
+func (s *PullRequest) Merge(ctx context.Context, prId string, opts MergeOptions) (*entity.PullRequest, error) {
+    var mergedPr *entity.PullRequest
+    err := s.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+        pr, err := s.prRepository.GetByID(txCtx, prId)
+        if err != nil {
+            return fmt.Errorf("get pr: %w", err)
+        }
+        if pr.IsMerged() {
+            mergedPr = pr
+            return nil
+        }
+        if err := pr.SetMerged(); err != nil {
+            return fmt.Errorf("merge pr: %w", err)
+        }
+
+        unmet, err := s.unmetMergePolicy(txCtx, pr)
+        if err != nil {
+            return err
+        }
+        if len(unmet) > 0 {
+            if !opts.Override {
+                return fmt.Errorf("%w: %s", domain.ErrMergePolicyNotSatisfied, strings.Join(unmet, "; "))
+            }
+            if err := s.prRepository.RecordMergeOverride(txCtx, prId, opts.ActorID, unmet); err != nil {
+                return fmt.Errorf("record merge override: %w", err)
+            }
+            pr.MergeOverride = &entity.MergeOverride{By: opts.ActorID, Unmet: unmet}
+        }
+
+        if err = s.prRepository.UpdateStatus(txCtx, prId, entity.PRMerged); err != nil {
+            return fmt.Errorf("update pr status: %w", err)
+        }
+        reason := "merged"
+        if pr.MergeOverride != nil {
+            reason = "merged overriding merge policy: " + strings.Join(unmet, "; ")
+        }
+        err = recordEvents(txCtx, s.prRepository, entity.ReviewerEvent{
+            PullRequestID: prId,
+            Type:          entity.EventMerged,
+            Reason:        domain.ReasonFrom(txCtx, reason),
+        })
+        if err != nil {
+            return err
+        }
+        mergedPr = pr
+        return nil
+    })
+
+    if err != nil {
+        return nil, err
+    }
+    return mergedPr, nil
+}
+
+// unmetMergePolicy checks the PR against the merge policy of the author's team
+func (s *PullRequest) unmetMergePolicy(ctx context.Context, pr *entity.PullRequest) ([]string, error) {
+    author, err := s.userRepository.GetByID(ctx, pr.AuthorID)
+    if err != nil {
+        return nil, fmt.Errorf("get author: %w", err)
+    }
+    team, err := s.authorTeam(ctx, author)
+    if err != nil {
+        return nil, err
+    }
+    return team.MergePolicy.Unmet(pr), nil
+}
+
+// authorTeam returns the primary team of the PR author, an author without a team gets entity.NoTeam
+func (s *PullRequest) authorTeam(ctx context.Context, author *entity.User) (*entity.Team, error) {
+    if author.TeamName == "" {
+        return entity.NoTeam(), nil
     }
-    if err = s.prRepository.UpdateStatus(ctx, prId, entity.PRMerged); err != nil {
-        return nil, fmt.Errorf("update pr status: %w", err)
+    team, err := s.teamRepository.GetByName(ctx, author.TeamName)
+    if err != nil {
+        return nil, fmt.Errorf("get author team: %w", err)
+    }
+    return team, nil
+}
+
+// SubmitReview records the verdict of an assigned reviewer on an OPEN PR, a later verdict replaces the earlier one
+func (s *PullRequest) SubmitReview(
+    ctx context.Context,
+    prId,
+    reviewerId string,
+    verdict entity.ReviewVerdict,
+    comment string,
+) (*entity.PullRequest, error) {
+    if !verdict.IsValid() {
+        return nil, fmt.Errorf("%w: %q", domain.ErrInvalidReviewVerdict, verdict)
+    }
+
+    var updatedPr *entity.PullRequest
+    err := s.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+        pr, err := s.prRepository.GetByID(txCtx, prId)
+        if err != nil {
+            return fmt.Errorf("get pr: %w", err)
+        }
+        if pr.IsMerged() {
+            return domain.ErrPullRequestIsMerged
+        }
+        if !pr.IsOpen() {
+            return fmt.Errorf("%w: pull request is %s", domain.ErrPullRequestNotOpen, pr.Status)
+        }
+        if !pr.HasReviewer(reviewerId) {
+            return domain.ErrReviewerNotAssigned
+        }
+
+        if err := s.prRepository.SubmitVerdict(txCtx, prId, reviewerId, verdict, comment); err != nil {
+            return fmt.Errorf("submit verdict: %w", err)
+        }
+        err = recordEvents(txCtx, s.prRepository, entity.ReviewerEvent{
+            PullRequestID: prId,
+            Type:          entity.EventVerdict,
+            ReviewerID:    reviewerId,
+            Reason:        domain.ReasonFrom(txCtx, comment),
+            Verdict:       verdict,
+        })
+        if err != nil {
+            return err
+        }
+        updatedPr, err = s.prRepository.GetByID(txCtx, prId)
+        if err != nil {
+            return fmt.Errorf("get updated pr: %w", err)
+        }
+        return nil
+    })
+
+    if err != nil {
+        return nil, err
+    }
+    return updatedPr, nil
+}
+
+// MarkReady moves a draft to review and assigns its reviewers the same way a new PR gets them
+func (s *PullRequest) MarkReady(ctx context.Context, prId string, opts CreateOptions) (*entity.PullRequest, error) {
+    var updatedPr *entity.PullRequest
+    err := s.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+        pr, err := s.prRepository.GetByID(txCtx, prId)
+        if err != nil {
+            return fmt.Errorf("get pr: %w", err)
+        }
+        if err := pr.MarkReady(); err != nil {
+            return err
+        }
+        if err := s.assignInitialReviewers(txCtx, pr, opts, "pull request ready for review"); err != nil {
+            return err
+        }
+        if err := s.prRepository.UpdateStatus(txCtx, prId, pr.Status); err != nil {
+            return fmt.Errorf("update pr status: %w", err)
+        }
+        updatedPr, err = s.prRepository.GetByID(txCtx, prId)
+        if err != nil {
+            return fmt.Errorf("get updated pr: %w", err)
+        }
+        return nil
+    })
+
+    if err != nil {
+        return nil, err
+    }
+    return updatedPr, nil
+}
+
+// Close abandons an OPEN or DRAFT PR, its reviewers stop counting it as an open review
+func (s *PullRequest) Close(ctx context.Context, prId string) (*entity.PullRequest, error) {
+    var closedPr *entity.PullRequest
+    err := s.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+        pr, err := s.prRepository.GetByID(txCtx, prId)
+        if err != nil {
+            return fmt.Errorf("get pr: %w", err)
+        }
+        if err := pr.Close(); err != nil {
+            return err
+        }
+        if err := s.prRepository.UpdateStatus(txCtx, prId, pr.Status); err != nil {
+            return fmt.Errorf("update pr status: %w", err)
+        }
+        err = recordEvents(txCtx, s.prRepository, entity.ReviewerEvent{
+            PullRequestID: prId,
+            Type:          entity.EventClosed,
+            Reason:        domain.ReasonFrom(txCtx, "closed"),
+        })
+        if err != nil {
+            return err
+        }
+        closedPr = pr
+        return nil
+    })
+
+    if err != nil {
+        return nil, err
+    }
+    return closedPr, nil
+}
+
+// Reopen brings a CLOSED PR back to review. Its reviewers are kept unless they were deactivated
+// or became unavailable meanwhile, such reviewers are replaced or unassigned.
+// A PR closed as a draft gets reviewers picked automatically
+func (s *PullRequest) Reopen(ctx context.Context, prId string) (*entity.PullRequest, error) {
+    var reopenedPr *entity.PullRequest
+    err := s.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+        pr, err := s.prRepository.GetByID(txCtx, prId)
+        if err != nil {
+            return fmt.Errorf("get pr: %w", err)
+        }
+        if err := pr.Reopen(); err != nil {
+            return err
+        }
+        if err := s.prRepository.UpdateStatus(txCtx, prId, pr.Status); err != nil {
+            return fmt.Errorf("update pr status: %w", err)
+        }
+        err = recordEvents(txCtx, s.prRepository, entity.ReviewerEvent{
+            PullRequestID: prId,
+            Type:          entity.EventReopened,
+            Reason:        domain.ReasonFrom(txCtx, "reopened"),
+        })
+        if err != nil {
+            return err
+        }
+
+        if len(pr.AssignedReviewers) == 0 {
+            err = s.assignInitialReviewers(txCtx, pr, CreateOptions{}, "pull request reopened")
+        } else {
+            err = s.replaceUnavailableReviewers(txCtx, pr)
+        }
+        if err != nil {
+            return err
+        }
+        reopenedPr, err = s.prRepository.GetByID(txCtx, prId)
+        if err != nil {
+            return fmt.Errorf("get updated pr: %w", err)
+        }
+        return nil
+    })
+
+    if err != nil {
+        return nil, err
+    }
+    return reopenedPr, nil
+}
+
+// replaceUnavailableReviewers reassigns the reviews a reopened PR kept for users who cannot review anymore,
+// a reviewer without a replacement candidate is unassigned. It has to run after the PR is OPEN again
+func (s *PullRequest) replaceUnavailableReviewers(ctx context.Context, pr *entity.PullRequest) error {
+    for _, reviewerId := range slices.Clone(pr.AssignedReviewers) {
+        reviewer, err := s.userRepository.GetByID(ctx, reviewerId)
+        if err != nil {
+            return fmt.Errorf("get reviewer: %w", err)
+        }
+        if reviewer.IsActive && reviewer.UnavailableUntil == nil {
+            continue
+        }
+
+        reassignCtx := domain.WithReason(ctx, domain.ReasonFrom(ctx, "pull request reopened, reviewer unavailable"))
+        _, _, err = s.ReassignReviewer(reassignCtx, pr.ID, reviewerId, "")
+        if err == nil {
+            continue
+        }
+        if !errors.Is(err, domain.ErrNoReviewerCandidate) {
+            return fmt.Errorf("reassign reviewer %s: %w", reviewerId, err)
+        }
+
+        if err := s.prRepository.RemoveReviewer(ctx, pr.ID, reviewerId); err != nil {
+            return fmt.Errorf("remove reviewer: %w", err)
+        }
+        err = recordEvents(ctx, s.prRepository, entity.ReviewerEvent{
+            PullRequestID: pr.ID,
+            Type:          entity.EventUnassigned,
+            ReviewerID:    reviewerId,
+            Reason:        domain.ReasonFrom(ctx, "pull request reopened, reviewer unavailable, no replacement candidate"),
+        })
+        if err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+// assignInitialReviewers picks and stores reviewers of a PR that has none yet
+func (s *PullRequest) assignInitialReviewers(
+    ctx context.Context,
+    pr *entity.PullRequest,
+    opts CreateOptions,
+    reason string,
+) error {
+    author, err := s.userRepository.GetByID(ctx, pr.AuthorID)
+    if err != nil {
+        return fmt.Errorf("get pr author: %w", err)
+    }
+    team, err := s.authorTeam(ctx, author)
+    if err != nil {
+        return err
+    }
+
+    reviewersIds, fallbackIds, err := s.chooseReviewers(ctx, team, author, opts)
+    if err != nil {
+        return err
+    }
+    if err := s.prRepository.AssignReviewers(ctx, pr.ID, reviewersIds, fallbackIds, team.ReviewSLA.DueAt(time.Now())); err != nil {
+        return fmt.Errorf("assign reviewers: %w", err)
+    }
+    if err := recordEvents(ctx, s.prRepository, assignedEvents(pr.ID, reviewersIds, domain.ReasonFrom(ctx, reason))...); err != nil {
+        return err
+    }
+    pr.AssignedReviewers = reviewersIds
+    pr.FallbackReviewers = fallbackIds
+    return nil
+}
+
+// chooseReviewers returns reviewers picked by hand or automatically, at least team.MinReviewers of them
+func (s *PullRequest) chooseReviewers(
+    ctx context.Context,
+    team *entity.Team,
+    author *entity.User,
+    opts CreateOptions,
+) ([]string, []string, error) {
+    var reviewersIds, fallbackIds []string
+    var err error
+    if len(opts.Reviewers) > 0 {
+        reviewersIds, fallbackIds, err = s.checkManualReviewers(ctx, team, author.ID, opts.Reviewers)
+    } else {
+        reviewersIds, fallbackIds, err = s.pickAutomatically(ctx, team, author, opts)
+    }
+    if err != nil {
+        return nil, nil, err
+    }
+
+    if len(reviewersIds) < team.MinReviewers {
+        return nil, nil, fmt.Errorf("%w: team %s requires %d reviewers, found %d",
+            domain.ErrNoReviewerCandidate, team.Name, team.MinReviewers, len(reviewersIds))
+    }
+    return reviewersIds, fallbackIds, nil
+}
+
+// pickAutomatically fills the team's reviewer slots with code owners first,
+// then with experts and then by the team strategy falling back to fallback teams
+func (s *PullRequest) pickAutomatically(
+    ctx context.Context,
+    team *entity.Team,
+    author *entity.User,
+    opts CreateOptions,
+) ([]string, []string, error) {
+    ownerIds, err := s.pickOwners(ctx, author, opts.ChangedPaths, team.MaxReviewers)
+    if err != nil {
+        return nil, nil, fmt.Errorf("get code owners: %w", err)
+    }
+
+    reviewersIds := append([]string{}, ownerIds...)
+    excludedIds := append([]string{author.ID}, ownerIds...)
+
+    expertIds, err := s.pickExperts(ctx, team, excludedIds, opts.RequiredTags, max(team.MaxReviewers-len(reviewersIds), 0))
+    if err != nil {
+        return nil, nil, fmt.Errorf("get experts: %w", err)
+    }
+    reviewersIds = append(reviewersIds, expertIds...)
+    excludedIds = append(excludedIds, expertIds...)
+
+    teamReviewerIds, fallbackIds, err := s.pickReviewers(ctx, team, excludedIds, max(team.MaxReviewers-len(reviewersIds), 0))
+    if err != nil {
+        return nil, nil, fmt.Errorf("get reviewers: %w", err)
+    }
+    return append(reviewersIds, teamReviewerIds...), fallbackIds, nil
+}
+
+// checkManualReviewers validates reviewers picked by hand for a new PR of the team
+func (s *PullRequest) checkManualReviewers(
+    ctx context.Context,
+    team *entity.Team,
+    authorId string,
+    reviewerIds []string,
+) ([]string, []string, error) {
+    if len(reviewerIds) > team.MaxReviewers {
+        return nil, nil, fmt.Errorf("%w: team %s allows at most %d reviewers, got %d",
+            domain.ErrInvalidReviewerLimits, team.Name, team.MaxReviewers, len(reviewerIds))
+    }
+
+    teams := allowedReviewerTeams(team)
+    assigned := make([]string, 0, len(reviewerIds))
+    fallbackIds := make([]string, 0)
+    for _, reviewerId := range reviewerIds {
+        isFallback, err := s.checkManualReviewer(ctx, reviewerId, authorId, assigned, teams)
+        if err != nil {
+            return nil, nil, err
+        }
+        assigned = append(assigned, reviewerId)
+        if isFallback {
+            fallbackIds = append(fallbackIds, reviewerId)
+        }
+    }
+    return assigned, fallbackIds, nil
+}
+
+// checkManualReviewer applies the rules automatic selection follows to a user picked by hand:
+// not the author, not yet assigned, able to review right now and a member of one of the allowed teams.
+// teams maps team names to whether the team is a fallback one
+func (s *PullRequest) checkManualReviewer(
+    ctx context.Context,
+    userId,
+    authorId string,
+    assignedIds []string,
+    teams map[string]bool,
+) (bool, error) {
+    if userId == authorId {
+        return false, fmt.Errorf("%w: author %s cannot review own pull request", domain.ErrNoReviewerCandidate, userId)
+    }
+    if slices.Contains(assignedIds, userId) {
+        return false, fmt.Errorf("%w: user %s is already assigned", domain.ErrNoReviewerCandidate, userId)
+    }
+
+    user, err := s.userRepository.GetByID(ctx, userId)
+    if errors.Is(err, domain.ErrUserNotFound) {
+        return false, fmt.Errorf("%w: user %s not found", domain.ErrNoReviewerCandidate, userId)
+    }
+    if err != nil {
+        return false, fmt.Errorf("get reviewer: %w", err)
+    }
+    if !user.IsActive {
+        return false, fmt.Errorf("%w: user %s is inactive", domain.ErrNoReviewerCandidate, userId)
+    }
+    if user.UnavailableUntil != nil {
+        return false, fmt.Errorf("%w: user %s is unavailable until %s",
+            domain.ErrNoReviewerCandidate, userId, user.UnavailableUntil.Format(time.RFC3339))
+    }
+    if !user.HasReviewCapacity() {
+        return false, fmt.Errorf("%w: user %s has no review capacity left", domain.ErrNoReviewerCandidate, userId)
+    }
+
+    // a member of several allowed teams counts as a fallback reviewer only if all of them are fallbacks
+    allowed, isFallback := false, true
+    for _, teamName := range user.Teams {
+        if fallback, ok := teams[teamName]; ok {
+            allowed = true
+            isFallback = isFallback && fallback
+        }
+    }
+    if !allowed {
+        return false, fmt.Errorf("%w: user %s is not in the team or its fallback teams", domain.ErrNoReviewerCandidate, userId)
+    }
+    return isFallback, nil
+}
+
+// allowedReviewerTeams lists the team and its fallback teams, the latter marked true
+func allowedReviewerTeams(team *entity.Team) map[string]bool {
+    teams := map[string]bool{team.Name: false}
+    for _, name := range team.FallbackTeams {
+        if _, ok := teams[name]; !ok {
+            teams[name] = true
+        }
+    }
+    return teams
+}
+
+// pickReviewers fills up to count slots from the team, then from its fallback teams in order,
+// then up the org tree: sibling teams by name and the parent, level by level.
+// It returns ids of all picked reviewers and, separately, of those taken from other teams
+func (s *PullRequest) pickReviewers(
+    ctx context.Context,
+    team *entity.Team,
+    excludeUserIds []string,
+    count int,
+) ([]string, []string, error) {
+    reviewerIds := make([]string, 0)
+    fallbackIds := make([]string, 0)
+    if count <= 0 {
+        return reviewerIds, fallbackIds, nil
+    }
+    excluded := slices.Clone(excludeUserIds)
+
+    candidates, err := s.selectReviewers(ctx, team, excluded, count)
+    if err != nil {
+        return nil, nil, err
+    }
+    for _, candidate := range candidates {
+        reviewerIds = append(reviewerIds, candidate.ID)
+        excluded = append(excluded, candidate.ID)
+    }
+
+    asked := map[string]struct{}{team.Name: {}}
+    askTeam := func(other *entity.Team) error {
+        asked[other.Name] = struct{}{}
+        candidates, err := s.selectReviewers(ctx, other, excluded, count-len(reviewerIds))
+        if err != nil {
+            return err
+        }
+        for _, candidate := range candidates {
+            reviewerIds = append(reviewerIds, candidate.ID)
+            fallbackIds = append(fallbackIds, candidate.ID)
+            excluded = append(excluded, candidate.ID)
+        }
+        return nil
+    }
+
+    for _, fallbackName := range team.FallbackTeams {
+        if len(reviewerIds) >= count {
+            break
+        }
+        fallbackTeam, err := s.teamRepository.GetByName(ctx, fallbackName)
+        if err != nil {
+            return nil, nil, fmt.Errorf("get fallback team %s: %w", fallbackName, err)
+        }
+        if err := askTeam(fallbackTeam); err != nil {
+            return nil, nil, err
+        }
+    }
+
+    climbed := map[string]struct{}{team.Name: {}}
+    for current := team; len(reviewerIds) < count && current.ParentName != ""; {
+        if _, ok := climbed[current.ParentName]; ok {
+            break
+        }
+        climbed[current.ParentName] = struct{}{}
+
+        parent, err := s.teamRepository.GetByName(ctx, current.ParentName)
+        if err != nil {
+            return nil, nil, fmt.Errorf("get parent team %s: %w", current.ParentName, err)
+        }
+        siblings, err := s.teamRepository.GetChildren(ctx, parent.Name)
+        if err != nil {
+            return nil, nil, fmt.Errorf("get sibling teams of %s: %w", current.Name, err)
+        }
+        for _, siblingName := range siblings {
+            if len(reviewerIds) >= count {
+                break
+            }
+            if _, ok := asked[siblingName]; ok {
+                continue
+            }
+            sibling, err := s.teamRepository.GetByName(ctx, siblingName)
+            if err != nil {
+                return nil, nil, fmt.Errorf("get sibling team %s: %w", siblingName, err)
+            }
+            if err := askTeam(sibling); err != nil {
+                return nil, nil, err
+            }
+        }
+        if _, ok := asked[parent.Name]; !ok && len(reviewerIds) < count {
+            if err := askTeam(parent); err != nil {
+                return nil, nil, err
+            }
+        }
+        current = parent
+    }
+    return reviewerIds, fallbackIds, nil
+}
+
+// pickExperts picks active teammates whose expertise covers the most of the required tags
+func (s *PullRequest) pickExperts(
+    ctx context.Context,
+    team *entity.Team,
+    excludeUserIds []string,
+    requiredTags []string,
+    count int,
+) ([]string, error) {
+    requiredTags = entity.NormalizeExpertise(requiredTags)
+    if len(requiredTags) == 0 || count <= 0 || team.Name == "" {
+        return []string{}, nil
+    }
+
+    experts, err := s.userRepository.GetExpertActiveTeamUsers(ctx, team.Name, excludeUserIds, requiredTags, count)
+    if err != nil {
+        return nil, fmt.Errorf("get expert users: %w", err)
+    }
+    expertIds := make([]string, len(experts))
+    for i, expert := range experts {
+        expertIds[i] = expert.ID
+    }
+    return expertIds, nil
+}
+
+// pickOwners picks one reviewer per ownership rule matching the changed paths, at most maxCount.
+// A rule is skipped when the author owns it or an already picked reviewer does. Owners take precedence
+// over experts and the team, when more rules match than maxCount the earlier rules win
+func (s *PullRequest) pickOwners(
+    ctx context.Context,
+    author *entity.User,
+    changedPaths []string,
+    maxCount int,
+) ([]string, error) {
+    if len(changedPaths) == 0 || maxCount <= 0 {
+        return []string{}, nil
+    }
+
+    stored, err := s.ownershipRepository.GetAll(ctx)
+    if err != nil {
+        return nil, fmt.Errorf("get ownership rules: %w", err)
+    }
+    ruleset := make(codeowners.Ruleset, 0, len(stored))
+    for _, rule := range stored {
+        compiled, err := codeowners.NewRule(rule.Pattern, rule.UserIDs, rule.TeamNames)
+        if err != nil {
+            return nil, fmt.Errorf("compile ownership rule %q: %w", rule.Pattern, err)
+        }
+        ruleset = append(ruleset, compiled)
+    }
+
+    matched := make(map[int]struct{})
+    for _, path := range changedPaths {
+        for i := len(ruleset) - 1; i >= 0; i-- {
+            if ruleset[i].Match(path) {
+                matched[i] = struct{}{}
+                break
+            }
+        }
+    }
+
+    var picked []entity.User
+    excluded := []string{author.ID}
+    for i, rule := range ruleset {
+        if len(picked) == maxCount {
+            break
+        }
+        if _, ok := matched[i]; !ok {
+            continue
+        }
+        if slices.Contains(rule.Users, author.ID) || slices.ContainsFunc(picked, func(u entity.User) bool {
+            return ownsRule(rule, u)
+        }) {
+            continue
+        }
+
+        candidate, err := s.ownerCandidate(ctx, rule, excluded)
+        if err != nil {
+            return nil, err
+        }
+        if candidate != nil {
+            picked = append(picked, *candidate)
+            excluded = append(excluded, candidate.ID)
+        }
+    }
+
+    ownerIds := make([]string, len(picked))
+    for i, owner := range picked {
+        ownerIds[i] = owner.ID
+    }
+    return ownerIds, nil
+}
+
+// ownerCandidate returns a reviewable owner of the rule, owner users go before owner teams
+func (s *PullRequest) ownerCandidate(
+    ctx context.Context,
+    rule codeowners.Rule,
+    excludeUserIds []string,
+) (*entity.User, error) {
+    for _, userId := range rule.Users {
+        if slices.Contains(excludeUserIds, userId) {
+            continue
+        }
+        user, err := s.userRepository.GetByID(ctx, userId)
+        if errors.Is(err, domain.ErrUserNotFound) {
+            continue
+        }
+        if err != nil {
+            return nil, fmt.Errorf("get owner: %w", err)
+        }
+        if user.CanReview() {
+            return user, nil
+        }
+    }
+
+    for _, teamName := range rule.Teams {
+        team, err := s.teamRepository.GetByName(ctx, teamName)
+        if errors.Is(err, domain.ErrTeamNotFound) {
+            continue
+        }
+        if err != nil {
+            return nil, fmt.Errorf("get owner team: %w", err)
+        }
+        candidates, err := s.selectReviewers(ctx, team, excludeUserIds, 1)
+        if err != nil {
+            return nil, err
+        }
+        if len(candidates) > 0 {
+            return &candidates[0], nil
+        }
+    }
+    return nil, nil
+}
+
+func ownsRule(rule codeowners.Rule, user entity.User) bool {
+    if slices.Contains(rule.Users, user.ID) {
+        return true
+    }
+    for _, teamName := range user.Teams {
+        if slices.Contains(rule.Teams, teamName) {
+            return true
+        }
+    }
+    return false
+}
+
+// selectReviewers picks reviewers from the team using the strategy the team has chosen,
+// entity.NoTeam has nobody to pick
+func (s *PullRequest) selectReviewers(
+    ctx context.Context,
+    team *entity.Team,
+    excludeUserIds []string,
+    maxCount int,
+) ([]entity.User, error) {
+    if team.Name == "" {
+        return []entity.User{}, nil
     }
-    return pr, nil
+    return s.selectors.For(team.ReviewerStrategy).Select(ctx, team.Name, excludeUserIds, maxCount)
 }
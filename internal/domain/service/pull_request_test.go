@@ -8,6 +8,7 @@ import (
 
     "github.com/kimvlry/avito-internship-assignment/internal/domain"
     "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/repository"
     "github.com/kimvlry/avito-internship-assignment/internal/domain/service/mocks"
     "github.com/stretchr/testify/assert"
     "github.com/stretchr/testify/require"
@@ -22,6 +23,8 @@ func TestPullRequestService_CreatePullRequestWithReviewers(t *testing.T) {
         mockPRExists      bool
         mockAuthor        *entity.User
         mockReviewers     []entity.User
+        minReviewers      int
+        maxReviewers      int
         mockError         error
         expectedReviewers int
         expectError       bool
@@ -37,15 +40,58 @@ func TestPullRequestService_CreatePullRequestWithReviewers(t *testing.T) {
                 ID:       "u1",
                 Username: "Alice",
                 TeamName: "backend",
+                Teams:    []string{"backend"},
                 IsActive: true,
             },
             mockReviewers: []entity.User{
                 {ID: "u2", Username: "Bob", IsActive: true},
                 {ID: "u3", Username: "Charlie", IsActive: true},
             },
+            maxReviewers:      2,
             expectedReviewers: 2,
             expectError:       false,
         },
+        {
+            name:     "успешное создание с 3 ревьюверами по настройке команды",
+            prID:     "pr-3",
+            prName:   "Security fix",
+            authorID: "u1",
+            mockAuthor: &entity.User{
+                ID:       "u1",
+                Username: "Alice",
+                TeamName: "security",
+                Teams:    []string{"security"},
+                IsActive: true,
+            },
+            mockReviewers: []entity.User{
+                {ID: "u2", Username: "Bob", IsActive: true},
+                {ID: "u3", Username: "Charlie", IsActive: true},
+                {ID: "u4", Username: "Dave", IsActive: true},
+            },
+            minReviewers:      3,
+            maxReviewers:      3,
+            expectedReviewers: 3,
+        },
+        {
+            name:     "ошибка: кандидатов меньше минимума команды",
+            prID:     "pr-4",
+            prName:   "Security fix",
+            authorID: "u1",
+            mockAuthor: &entity.User{
+                ID:       "u1",
+                Username: "Alice",
+                TeamName: "security",
+                Teams:    []string{"security"},
+                IsActive: true,
+            },
+            mockReviewers: []entity.User{
+                {ID: "u2", Username: "Bob", IsActive: true},
+            },
+            minReviewers:    3,
+            maxReviewers:    3,
+            expectError:     true,
+            expectedErrType: domain.ErrNoReviewerCandidate,
+        },
         {
             name:            "ошибка: PR уже существует",
             prID:            "pr-2",
@@ -63,14 +109,25 @@ func TestPullRequestService_CreatePullRequestWithReviewers(t *testing.T) {
 
             mockPRRepo := mocks.NewPullRequestRepository(t)
             mockUserRepo := mocks.NewUserRepository(t)
+            mockTeamRepo := mocks.NewTeamRepository(t)
+            mockOwnershipRepo := mocks.NewOwnershipRepository(t)
             mockTx := mocks.NewTransactor(t)
 
             mockPRRepo.On("Exists", ctx, tt.prID).Return(tt.mockPRExists, nil)
             if !tt.mockPRExists && tt.mockAuthor != nil {
                 mockUserRepo.On("GetByID", ctx, tt.authorID).Return(tt.mockAuthor, nil)
-                mockUserRepo.On("GetRandomActiveTeamUsers", ctx, tt.mockAuthor.TeamName, mock.Anything, 2).
+                mockTeamRepo.On("GetByName", ctx, tt.mockAuthor.TeamName).Return(&entity.Team{
+                    Name:             tt.mockAuthor.TeamName,
+                    ReviewerStrategy: entity.ReviewerStrategyRandom,
+                    MinReviewers:     tt.minReviewers,
+                    MaxReviewers:     tt.maxReviewers,
+                }, nil)
+                mockUserRepo.On("GetRandomActiveTeamUsers", ctx, tt.mockAuthor.TeamName, mock.Anything, tt.maxReviewers).
                     Return(tt.mockReviewers, nil)
-                mockPRRepo.On("CreateWithReviewers", ctx, mock.AnythingOfType("*entity.PullRequest")).Return(nil)
+                if !tt.expectError {
+                    mockPRRepo.On("CreateWithReviewers", ctx, mock.AnythingOfType("*entity.PullRequest"), (*time.Time)(nil)).Return(nil)
+                    mockPRRepo.On("AppendEvents", ctx, mock.AnythingOfType("[]entity.ReviewerEvent")).Return(nil)
+                }
 
                 mockTx.On(
                     "WithinTransaction",
@@ -80,9 +137,9 @@ func TestPullRequestService_CreatePullRequestWithReviewers(t *testing.T) {
                     return fn(ctx)
                 })
             }
-            svc := NewPullRequest(mockPRRepo, mockUserRepo, mockTx)
+            svc := NewPullRequest(mockPRRepo, mockUserRepo, mockTeamRepo, mockOwnershipRepo, NewReviewerSelectors(mockUserRepo, mockTeamRepo), mockTx)
 
-            pr, err := svc.CreatePullRequestWithReviewers(ctx, tt.prID, tt.prName, tt.authorID)
+            pr, err := svc.CreatePullRequestWithReviewers(ctx, tt.prID, tt.prName, tt.authorID, CreateOptions{})
 
             if tt.expectError {
                 require.Error(t, err)
@@ -101,30 +158,949 @@ func TestPullRequestService_CreatePullRequestWithReviewers(t *testing.T) {
     }
 }
 
-func TestPullRequestService_Merge(t *testing.T) {
-    t.Run("успешный merge", func(t *testing.T) {
-        ctx := context.Background()
-        pr := &entity.PullRequest{
-            ID:        "pr-1",
-            Name:      "Feature X",
-            AuthorID:  "u1",
-            Status:    entity.PROpen,
-            CreatedAt: time.Now(),
+func TestPullRequestService_CreatePullRequestWithFallbackReviewers(t *testing.T) {
+    ctx := context.Background()
+    author := &entity.User{ID: "u1", Username: "Alice", TeamName: "mobile", Teams: []string{"mobile"}, IsActive: true}
+
+    mockPRRepo := mocks.NewPullRequestRepository(t)
+    mockUserRepo := mocks.NewUserRepository(t)
+    mockTeamRepo := mocks.NewTeamRepository(t)
+    mockOwnershipRepo := mocks.NewOwnershipRepository(t)
+    mockTx := mocks.NewTransactor(t)
+
+    mockPRRepo.On("Exists", ctx, "pr-1").Return(false, nil)
+    mockUserRepo.On("GetByID", ctx, author.ID).Return(author, nil)
+    mockTeamRepo.On("GetByName", ctx, "mobile").Return(&entity.Team{
+        Name:             "mobile",
+        ReviewerStrategy: entity.ReviewerStrategyRandom,
+        MaxReviewers:     2,
+        FallbackTeams:    []string{"platform"},
+    }, nil)
+    mockTeamRepo.On("GetByName", ctx, "platform").Return(&entity.Team{
+        Name:             "platform",
+        ReviewerStrategy: entity.ReviewerStrategyRandom,
+        MaxReviewers:     2,
+    }, nil)
+    mockUserRepo.On("GetRandomActiveTeamUsers", ctx, "mobile", []string{"u1"}, 2).
+        Return([]entity.User{{ID: "u2", IsActive: true}}, nil)
+    mockUserRepo.On("GetRandomActiveTeamUsers", ctx, "platform", []string{"u1", "u2"}, 1).
+        Return([]entity.User{{ID: "u7", IsActive: true}}, nil)
+    mockPRRepo.On("CreateWithReviewers", ctx, mock.AnythingOfType("*entity.PullRequest"), (*time.Time)(nil)).Return(nil)
+    mockPRRepo.On("AppendEvents", ctx, mock.AnythingOfType("[]entity.ReviewerEvent")).Return(nil)
+    mockTx.On(
+        "WithinTransaction",
+        mock.Anything,
+        mock.AnythingOfType("func(context.Context) error"),
+    ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+        return fn(ctx)
+    })
+
+    svc := NewPullRequest(mockPRRepo, mockUserRepo, mockTeamRepo, mockOwnershipRepo, NewReviewerSelectors(mockUserRepo, mockTeamRepo), mockTx)
+    pr, err := svc.CreatePullRequestWithReviewers(ctx, "pr-1", "Feature X", author.ID, CreateOptions{})
+
+    require.NoError(t, err)
+    assert.Equal(t, []string{"u2", "u7"}, pr.AssignedReviewers)
+    assert.Equal(t, []string{"u7"}, pr.FallbackReviewers)
+}
+
+func TestPullRequestService_CreatePullRequestWithTreeReviewers(t *testing.T) {
+    ctx := context.Background()
+    author := &entity.User{ID: "u1", Username: "Alice", TeamName: "ios", Teams: []string{"ios"}, IsActive: true}
+    team := func(name, parent string) *entity.Team {
+        return &entity.Team{
+            Name:             name,
+            ReviewerStrategy: entity.ReviewerStrategyRandom,
+            MaxReviewers:     3,
+            ParentName:       parent,
         }
+    }
+
+    mockPRRepo := mocks.NewPullRequestRepository(t)
+    mockUserRepo := mocks.NewUserRepository(t)
+    mockTeamRepo := mocks.NewTeamRepository(t)
+    mockOwnershipRepo := mocks.NewOwnershipRepository(t)
+    mockTx := mocks.NewTransactor(t)
+
+    mockPRRepo.On("Exists", ctx, "pr-1").Return(false, nil)
+    mockUserRepo.On("GetByID", ctx, author.ID).Return(author, nil)
+    mockTeamRepo.On("GetByName", ctx, "ios").Return(team("ios", "mobile"), nil)
+    mockTeamRepo.On("GetByName", ctx, "mobile").Return(team("mobile", "product"), nil)
+    mockTeamRepo.On("GetByName", ctx, "android").Return(team("android", "mobile"), nil)
+    mockTeamRepo.On("GetByName", ctx, "product").Return(team("product", ""), nil)
+    mockTeamRepo.On("GetByName", ctx, "web").Return(team("web", "product"), nil)
+    mockTeamRepo.On("GetChildren", ctx, "mobile").Return([]string{"android", "ios"}, nil)
+    mockTeamRepo.On("GetChildren", ctx, "product").Return([]string{"mobile", "web"}, nil)
+    mockUserRepo.On("GetRandomActiveTeamUsers", ctx, "ios", []string{"u1"}, 3).
+        Return([]entity.User{{ID: "u2", IsActive: true}}, nil)
+    mockUserRepo.On("GetRandomActiveTeamUsers", ctx, "android", []string{"u1", "u2"}, 2).
+        Return([]entity.User{{ID: "u5", IsActive: true}}, nil)
+    mockUserRepo.On("GetRandomActiveTeamUsers", ctx, "mobile", []string{"u1", "u2", "u5"}, 1).
+        Return([]entity.User{}, nil)
+    mockUserRepo.On("GetRandomActiveTeamUsers", ctx, "web", []string{"u1", "u2", "u5"}, 1).
+        Return([]entity.User{{ID: "u8", IsActive: true}}, nil)
+    mockPRRepo.On("CreateWithReviewers", ctx, mock.AnythingOfType("*entity.PullRequest"), (*time.Time)(nil)).Return(nil)
+    mockPRRepo.On("AppendEvents", ctx, mock.AnythingOfType("[]entity.ReviewerEvent")).Return(nil)
+    mockTx.On(
+        "WithinTransaction",
+        mock.Anything,
+        mock.AnythingOfType("func(context.Context) error"),
+    ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+        return fn(ctx)
+    })
+
+    svc := NewPullRequest(mockPRRepo, mockUserRepo, mockTeamRepo, mockOwnershipRepo, NewReviewerSelectors(mockUserRepo, mockTeamRepo), mockTx)
+    pr, err := svc.CreatePullRequestWithReviewers(ctx, "pr-1", "Feature X", author.ID, CreateOptions{})
+
+    require.NoError(t, err)
+    assert.Equal(t, []string{"u2", "u5", "u8"}, pr.AssignedReviewers, "сначала соседняя команда, затем родитель и выше")
+    assert.Equal(t, []string{"u5", "u8"}, pr.FallbackReviewers)
+}
+
+func TestPullRequestService_CreatePullRequestWithCodeOwners(t *testing.T) {
+    ctx := context.Background()
+    author := &entity.User{ID: "u1", Username: "Alice", TeamName: "backend", Teams: []string{"backend"}, IsActive: true}
+    dba := &entity.User{ID: "u9", Username: "Ivan", TeamName: "dba", Teams: []string{"dba"}, IsActive: true}
+
+    mockPRRepo := mocks.NewPullRequestRepository(t)
+    mockUserRepo := mocks.NewUserRepository(t)
+    mockTeamRepo := mocks.NewTeamRepository(t)
+    mockOwnershipRepo := mocks.NewOwnershipRepository(t)
+    mockTx := mocks.NewTransactor(t)
+
+    mockPRRepo.On("Exists", ctx, "pr-1").Return(false, nil)
+    mockUserRepo.On("GetByID", ctx, author.ID).Return(author, nil)
+    mockUserRepo.On("GetByID", ctx, dba.ID).Return(dba, nil)
+    mockOwnershipRepo.On("GetAll", ctx).Return([]entity.OwnershipRule{
+        {Pattern: "*", TeamNames: []string{"backend"}},
+        {Pattern: "*.sql", UserIDs: []string{"u9"}},
+        {Pattern: "/web/", TeamNames: []string{"frontend"}},
+    }, nil)
+    mockTeamRepo.On("GetByName", ctx, "backend").Return(&entity.Team{
+        Name:             "backend",
+        ReviewerStrategy: entity.ReviewerStrategyRandom,
+        MaxReviewers:     2,
+    }, nil)
+    mockUserRepo.On("GetRandomActiveTeamUsers", ctx, "backend", []string{"u1", "u9"}, 1).
+        Return([]entity.User{{ID: "u2", TeamName: "backend", Teams: []string{"backend"}, IsActive: true}}, nil).Once()
+    mockPRRepo.On("CreateWithReviewers", ctx, mock.AnythingOfType("*entity.PullRequest"), (*time.Time)(nil)).Return(nil)
+    mockPRRepo.On("AppendEvents", ctx, mock.AnythingOfType("[]entity.ReviewerEvent")).Return(nil)
+    mockTx.On(
+        "WithinTransaction",
+        mock.Anything,
+        mock.AnythingOfType("func(context.Context) error"),
+    ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+        return fn(ctx)
+    })
+
+    svc := NewPullRequest(mockPRRepo, mockUserRepo, mockTeamRepo, mockOwnershipRepo, NewReviewerSelectors(mockUserRepo, mockTeamRepo), mockTx)
+    pr, err := svc.CreatePullRequestWithReviewers(ctx, "pr-1", "Add index", author.ID, CreateOptions{
+        ChangedPaths: []string{"migrations/0011_add_index.up.sql"},
+    })
+
+    require.NoError(t, err)
+    assert.Equal(t, []string{"u9", "u2"}, pr.AssignedReviewers, "сначала владелец пути, затем команда автора")
+}
+
+func TestPullRequestService_CreatePullRequestWithMoreOwnersThanLimit(t *testing.T) {
+    ctx := context.Background()
+    author := &entity.User{ID: "u1", Username: "Alice", TeamName: "backend", Teams: []string{"backend"}, IsActive: true}
+
+    mockPRRepo := mocks.NewPullRequestRepository(t)
+    mockUserRepo := mocks.NewUserRepository(t)
+    mockTeamRepo := mocks.NewTeamRepository(t)
+    mockOwnershipRepo := mocks.NewOwnershipRepository(t)
+    mockTx := mocks.NewTransactor(t)
+
+    mockPRRepo.On("Exists", ctx, "pr-1").Return(false, nil)
+    mockUserRepo.On("GetByID", ctx, author.ID).Return(author, nil)
+    for _, id := range []string{"u7", "u8"} {
+        mockUserRepo.On("GetByID", ctx, id).Return(&entity.User{ID: id, TeamName: "platform", Teams: []string{"platform"}, IsActive: true}, nil)
+    }
+    mockOwnershipRepo.On("GetAll", ctx).Return([]entity.OwnershipRule{
+        {Pattern: "/api/", UserIDs: []string{"u7"}},
+        {Pattern: "*.sql", UserIDs: []string{"u8"}},
+        {Pattern: "/web/", UserIDs: []string{"u9"}},
+    }, nil)
+    mockTeamRepo.On("GetByName", ctx, "backend").Return(&entity.Team{
+        Name:             "backend",
+        ReviewerStrategy: entity.ReviewerStrategyRandom,
+        MaxReviewers:     2,
+    }, nil)
+    mockPRRepo.On("CreateWithReviewers", ctx, mock.AnythingOfType("*entity.PullRequest"), (*time.Time)(nil)).Return(nil)
+    mockPRRepo.On("AppendEvents", ctx, mock.AnythingOfType("[]entity.ReviewerEvent")).Return(nil)
+    mockTx.On(
+        "WithinTransaction",
+        mock.Anything,
+        mock.AnythingOfType("func(context.Context) error"),
+    ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+        return fn(ctx)
+    })
+
+    svc := NewPullRequest(mockPRRepo, mockUserRepo, mockTeamRepo, mockOwnershipRepo, NewReviewerSelectors(mockUserRepo, mockTeamRepo), mockTx)
+    pr, err := svc.CreatePullRequestWithReviewers(ctx, "pr-1", "Touch everything", author.ID, CreateOptions{
+        ChangedPaths: []string{"api/openapi.yaml", "migrations/0011_add_index.up.sql", "web/index.html"},
+        RequiredTags: []string{"sql"},
+    })
+
+    require.NoError(t, err)
+    assert.Equal(t, []string{"u7", "u8"}, pr.AssignedReviewers, "владельцев не больше max_reviewers, побеждают первые правила")
+}
+
+func TestPullRequestService_CreatePullRequestWithRequiredTags(t *testing.T) {
+    ctx := context.Background()
+    author := &entity.User{ID: "u1", Username: "Alice", TeamName: "backend", Teams: []string{"backend"}, IsActive: true}
+
+    mockPRRepo := mocks.NewPullRequestRepository(t)
+    mockUserRepo := mocks.NewUserRepository(t)
+    mockTeamRepo := mocks.NewTeamRepository(t)
+    mockOwnershipRepo := mocks.NewOwnershipRepository(t)
+    mockTx := mocks.NewTransactor(t)
+
+    mockPRRepo.On("Exists", ctx, "pr-1").Return(false, nil)
+    mockUserRepo.On("GetByID", ctx, author.ID).Return(author, nil)
+    mockTeamRepo.On("GetByName", ctx, "backend").Return(&entity.Team{
+        Name:             "backend",
+        ReviewerStrategy: entity.ReviewerStrategyRandom,
+        MaxReviewers:     2,
+    }, nil)
+    mockUserRepo.On("GetExpertActiveTeamUsers", ctx, "backend", []string{"u1"}, []string{"sql"}, 2).
+        Return([]entity.User{{ID: "u4", IsActive: true, Expertise: []string{"sql"}}}, nil)
+    mockUserRepo.On("GetRandomActiveTeamUsers", ctx, "backend", []string{"u1", "u4"}, 1).
+        Return([]entity.User{{ID: "u2", IsActive: true}}, nil)
+    mockPRRepo.On("CreateWithReviewers", ctx, mock.AnythingOfType("*entity.PullRequest"), (*time.Time)(nil)).Return(nil)
+    mockPRRepo.On("AppendEvents", ctx, mock.AnythingOfType("[]entity.ReviewerEvent")).Return(nil)
+    mockTx.On(
+        "WithinTransaction",
+        mock.Anything,
+        mock.AnythingOfType("func(context.Context) error"),
+    ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+        return fn(ctx)
+    })
+
+    svc := NewPullRequest(mockPRRepo, mockUserRepo, mockTeamRepo, mockOwnershipRepo, NewReviewerSelectors(mockUserRepo, mockTeamRepo), mockTx)
+    pr, err := svc.CreatePullRequestWithReviewers(ctx, "pr-1", "Add migration", author.ID, CreateOptions{
+        RequiredTags: []string{"SQL"},
+    })
+
+    require.NoError(t, err)
+    assert.Equal(t, []string{"u4", "u2"}, pr.AssignedReviewers, "сначала эксперт, затем случайный выбор")
+}
+
+func TestPullRequestService_CreatePullRequestWithManualReviewers(t *testing.T) {
+    ctx := context.Background()
+    author := &entity.User{ID: "u1", Username: "Alice", TeamName: "backend", Teams: []string{"backend"}, IsActive: true}
+
+    mockPRRepo := mocks.NewPullRequestRepository(t)
+    mockUserRepo := mocks.NewUserRepository(t)
+    mockTeamRepo := mocks.NewTeamRepository(t)
+    mockOwnershipRepo := mocks.NewOwnershipRepository(t)
+    mockTx := mocks.NewTransactor(t)
+
+    mockPRRepo.On("Exists", ctx, "pr-1").Return(false, nil)
+    mockUserRepo.On("GetByID", ctx, author.ID).Return(author, nil)
+    mockUserRepo.On("GetByID", ctx, "u9").Return(&entity.User{ID: "u9", TeamName: "platform", Teams: []string{"platform"}, IsActive: true}, nil)
+    mockTeamRepo.On("GetByName", ctx, "backend").Return(&entity.Team{
+        Name:          "backend",
+        MaxReviewers:  2,
+        FallbackTeams: []string{"platform"},
+    }, nil)
+    mockPRRepo.On("CreateWithReviewers", ctx, mock.AnythingOfType("*entity.PullRequest"), (*time.Time)(nil)).Return(nil)
+    mockPRRepo.On("AppendEvents", ctx, mock.AnythingOfType("[]entity.ReviewerEvent")).Return(nil)
+    mockTx.On(
+        "WithinTransaction",
+        mock.Anything,
+        mock.AnythingOfType("func(context.Context) error"),
+    ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+        return fn(ctx)
+    })
+
+    svc := NewPullRequest(mockPRRepo, mockUserRepo, mockTeamRepo, mockOwnershipRepo, NewReviewerSelectors(mockUserRepo, mockTeamRepo), mockTx)
+    pr, err := svc.CreatePullRequestWithReviewers(ctx, "pr-1", "Add index", author.ID, CreateOptions{
+        Reviewers: []string{"u9"},
+    })
+
+    require.NoError(t, err)
+    assert.Equal(t, []string{"u9"}, pr.AssignedReviewers, "автоматический выбор не выполняется")
+    assert.Equal(t, []string{"u9"}, pr.FallbackReviewers)
+
+    _, err = svc.CreatePullRequestWithReviewers(ctx, "pr-1", "Add index", author.ID, CreateOptions{
+        Reviewers: []string{"u1"},
+    })
+    assert.ErrorIs(t, err, domain.ErrNoReviewerCandidate, "автор не может быть ревьювером")
+}
+
+func TestPullRequestService_UpdatePullRequest(t *testing.T) {
+    newName, empty := "Add full-text search", ""
+
+    tests := []struct {
+        name            string
+        status          entity.PullRequestStatus
+        update          PullRequestUpdate
+        expectedErrType error
+    }{
+        {
+            name:   "смена названия и очистка описания",
+            status: entity.PROpen,
+            update: PullRequestUpdate{Name: &newName, Description: &empty},
+        },
+        {
+            name:            "ошибка: PR смержен",
+            status:          entity.PRMerged,
+            update:          PullRequestUpdate{Name: &newName},
+            expectedErrType: domain.ErrPullRequestIsMerged,
+        },
+        {
+            name:            "ошибка: PR в статусе DRAFT",
+            status:          entity.PRDraft,
+            update:          PullRequestUpdate{Name: &newName},
+            expectedErrType: domain.ErrPullRequestNotOpen,
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            ctx := context.Background()
+            pr := &entity.PullRequest{
+                ID:           "pr-1",
+                Name:         "Add search",
+                Description:  "first draft",
+                SourceBranch: "feature/search",
+                AuthorID:     "u1",
+                Status:       tt.status,
+            }
+
+            mockPRRepo := mocks.NewPullRequestRepository(t)
+            mockUserRepo := mocks.NewUserRepository(t)
+            mockTeamRepo := mocks.NewTeamRepository(t)
+            mockOwnershipRepo := mocks.NewOwnershipRepository(t)
+            mockTx := mocks.NewTransactor(t)
+
+            mockPRRepo.On("GetByID", ctx, "pr-1").Return(pr, nil)
+            if tt.expectedErrType == nil {
+                mockPRRepo.On("UpdateDetails", ctx, mock.MatchedBy(func(updated *entity.PullRequest) bool {
+                    return updated.Name == newName && updated.Description == "" && updated.SourceBranch == "feature/search"
+                })).Return(nil)
+            }
+            mockTx.On(
+                "WithinTransaction",
+                mock.Anything,
+                mock.AnythingOfType("func(context.Context) error"),
+            ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+                return fn(ctx)
+            })
+
+            svc := NewPullRequest(mockPRRepo, mockUserRepo, mockTeamRepo, mockOwnershipRepo, NewReviewerSelectors(mockUserRepo, mockTeamRepo), mockTx)
+            gotPr, err := svc.UpdatePullRequest(ctx, "pr-1", tt.update)
+
+            if tt.expectedErrType != nil {
+                require.Error(t, err)
+                assert.ErrorIs(t, err, tt.expectedErrType)
+                return
+            }
+            require.NoError(t, err)
+            assert.Equal(t, newName, gotPr.Name)
+        })
+    }
+}
+
+func TestPullRequestService_ListPullRequests(t *testing.T) {
+    ctx := context.Background()
+    now := time.Now()
+    filter := repository.PullRequestFilter{Statuses: []entity.PullRequestStatus{entity.PROpen}}
+    page := []*entity.PullRequest{
+        {ID: "pr-3", CreatedAt: now},
+        {ID: "pr-2", CreatedAt: now.Add(-time.Minute)},
+        {ID: "pr-1", CreatedAt: now.Add(-2 * time.Minute)},
+    }
 
+    t.Run("есть следующая страница", func(t *testing.T) {
         mockPRRepo := mocks.NewPullRequestRepository(t)
-        mockUserRepo := mocks.NewUserRepository(t)
-        mockTx := mocks.NewTransactor(t)
+        mockPRRepo.On("List", ctx, filter, (*repository.PullRequestCursor)(nil), 3).Return(page, nil)
 
-        mockPRRepo.On("GetByID", ctx, pr.ID).Return(pr, nil)
-        mockPRRepo.On("UpdateStatus", ctx, pr.ID, entity.PRMerged).Return(nil)
+        svc := NewPullRequest(mockPRRepo, nil, nil, nil, nil, nil)
+        prs, next, err := svc.ListPullRequests(ctx, filter, nil, 2)
 
-        svc := NewPullRequest(mockPRRepo, mockUserRepo, mockTx)
-        gotPr, err := svc.Merge(ctx, pr.ID)
         require.NoError(t, err)
-        assert.Equal(t, entity.PRMerged, gotPr.Status)
-        assert.NotNil(t, gotPr.MergedAt)
+        assert.Len(t, prs, 2)
+        require.NotNil(t, next)
+        assert.Equal(t, repository.PullRequestCursor{CreatedAt: page[1].CreatedAt, ID: "pr-2"}, *next)
+    })
+
+    t.Run("последняя страница", func(t *testing.T) {
+        after := &repository.PullRequestCursor{CreatedAt: now, ID: "pr-3"}
+        mockPRRepo := mocks.NewPullRequestRepository(t)
+        mockPRRepo.On("List", ctx, filter, after, 3).Return(page[1:], nil)
+
+        svc := NewPullRequest(mockPRRepo, nil, nil, nil, nil, nil)
+        prs, next, err := svc.ListPullRequests(ctx, filter, after, 2)
+
+        require.NoError(t, err)
+        assert.Len(t, prs, 2)
+        assert.Nil(t, next)
+    })
+}
+
+func TestPullRequestService_SearchPullRequests(t *testing.T) {
+    ctx := context.Background()
+    filter := repository.PullRequestFilter{TeamName: "backend"}
+
+    t.Run("найдены PR", func(t *testing.T) {
+        hits := []repository.PullRequestSearchHit{
+            {PullRequest: &entity.PullRequest{ID: "pr-1"}, Rank: 0.6, Highlight: "<b>Add</b> search"},
+        }
+        mockPRRepo := mocks.NewPullRequestRepository(t)
+        mockPRRepo.On("Search", ctx, "add", filter, 10).Return(hits, nil)
+
+        svc := NewPullRequest(mockPRRepo, nil, nil, nil, nil, nil)
+        got, err := svc.SearchPullRequests(ctx, "add", filter, 10)
+
+        require.NoError(t, err)
+        assert.Equal(t, hits, got)
+    })
+
+    t.Run("запрос без слов", func(t *testing.T) {
+        mockPRRepo := mocks.NewPullRequestRepository(t)
+        mockPRRepo.On("Search", ctx, "!!!", filter, 10).Return(nil, domain.ErrInvalidSearchQuery)
+
+        svc := NewPullRequest(mockPRRepo, nil, nil, nil, nil, nil)
+        _, err := svc.SearchPullRequests(ctx, "!!!", filter, 10)
+
+        assert.ErrorIs(t, err, domain.ErrInvalidSearchQuery)
+    })
+}
+
+func TestPullRequestService_Merge(t *testing.T) {
+    approved := entity.Review{ReviewerID: "u2", Verdict: entity.VerdictApproved}
+    changesRequested := entity.Review{ReviewerID: "u3", Verdict: entity.VerdictChangesRequested}
+    strictPolicy := entity.MergePolicy{RequiredApprovals: 2, BlockOnChangesRequested: true}
+
+    tests := []struct {
+        name            string
+        reviews         []entity.Review
+        policy          entity.MergePolicy
+        opts            MergeOptions
+        expectOverride  []string
+        expectedErrType error
+    }{
+        {
+            name: "успешный merge",
+        },
+        {
+            name:    "политика выполнена",
+            reviews: []entity.Review{approved, {ReviewerID: "u3", Verdict: entity.VerdictApproved}},
+            policy:  strictPolicy,
+        },
+        {
+            name:            "ошибка: не хватает approve и есть changes requested",
+            reviews:         []entity.Review{approved, changesRequested},
+            policy:          strictPolicy,
+            expectedErrType: domain.ErrMergePolicyNotSatisfied,
+        },
+        {
+            name:           "override записывает невыполненные условия",
+            reviews:        []entity.Review{changesRequested},
+            policy:         entity.MergePolicy{BlockOnChangesRequested: true},
+            opts:           MergeOptions{Override: true, ActorID: "admin"},
+            expectOverride: []string{"changes requested by u3"},
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            ctx := context.Background()
+            pr := &entity.PullRequest{
+                ID:                "pr-1",
+                Name:              "Feature X",
+                AuthorID:          "u1",
+                Status:            entity.PROpen,
+                AssignedReviewers: []string{"u2", "u3"},
+                CreatedAt:         time.Now(),
+                Reviews:           tt.reviews,
+            }
+
+            mockPRRepo := mocks.NewPullRequestRepository(t)
+            mockUserRepo := mocks.NewUserRepository(t)
+            mockTeamRepo := mocks.NewTeamRepository(t)
+            mockOwnershipRepo := mocks.NewOwnershipRepository(t)
+            mockTx := mocks.NewTransactor(t)
+
+            mockPRRepo.On("GetByID", ctx, pr.ID).Return(pr, nil)
+            mockUserRepo.On("GetByID", ctx, "u1").Return(&entity.User{ID: "u1", TeamName: "backend", Teams: []string{"backend"}}, nil)
+            mockTeamRepo.On("GetByName", ctx, "backend").Return(&entity.Team{Name: "backend", MergePolicy: tt.policy}, nil)
+            if tt.expectedErrType == nil {
+                mockPRRepo.On("UpdateStatus", ctx, pr.ID, entity.PRMerged).Return(nil)
+                mockPRRepo.On("AppendEvents", ctx, mock.AnythingOfType("[]entity.ReviewerEvent")).Return(nil)
+            }
+            if tt.expectOverride != nil {
+                mockPRRepo.On("RecordMergeOverride", ctx, pr.ID, tt.opts.ActorID, tt.expectOverride).Return(nil)
+            }
+            mockTx.On(
+                "WithinTransaction",
+                mock.Anything,
+                mock.AnythingOfType("func(context.Context) error"),
+            ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+                return fn(ctx)
+            })
+
+            svc := NewPullRequest(mockPRRepo, mockUserRepo, mockTeamRepo, mockOwnershipRepo, NewReviewerSelectors(mockUserRepo, mockTeamRepo), mockTx)
+            gotPr, err := svc.Merge(ctx, pr.ID, tt.opts)
+
+            if tt.expectedErrType != nil {
+                require.Error(t, err)
+                assert.ErrorIs(t, err, tt.expectedErrType)
+                assert.Contains(t, err.Error(), "1 of 2 required approvals; changes requested by u3")
+                return
+            }
+            require.NoError(t, err)
+            assert.Equal(t, entity.PRMerged, gotPr.Status)
+            assert.NotNil(t, gotPr.MergedAt)
+            if tt.expectOverride != nil {
+                require.NotNil(t, gotPr.MergeOverride)
+                assert.Equal(t, "admin", gotPr.MergeOverride.By)
+            } else {
+                assert.Nil(t, gotPr.MergeOverride)
+            }
+        })
+    }
+}
+
+func TestPullRequestService_Lifecycle(t *testing.T) {
+    tests := []struct {
+        name            string
+        status          entity.PullRequestStatus
+        action          func(svc *PullRequest, ctx context.Context) (*entity.PullRequest, error)
+        expectStatus    entity.PullRequestStatus
+        expectEvent     entity.ReviewerEvent
+        expectedErrType error
+    }{
+        {
+            name:   "закрытие OPEN PR",
+            status: entity.PROpen,
+            action: func(svc *PullRequest, ctx context.Context) (*entity.PullRequest, error) {
+                return svc.Close(ctx, "pr-1")
+            },
+            expectStatus: entity.PRClosed,
+            expectEvent:  entity.ReviewerEvent{PullRequestID: "pr-1", Type: entity.EventClosed, Reason: "closed"},
+        },
+        {
+            name:   "ошибка: merge закрытого PR",
+            status: entity.PRClosed,
+            action: func(svc *PullRequest, ctx context.Context) (*entity.PullRequest, error) {
+                return svc.Merge(ctx, "pr-1", MergeOptions{})
+            },
+            expectedErrType: domain.ErrInvalidStatusTransition,
+        },
+        {
+            name:   "ошибка: закрытие смерженного PR",
+            status: entity.PRMerged,
+            action: func(svc *PullRequest, ctx context.Context) (*entity.PullRequest, error) {
+                return svc.Close(ctx, "pr-1")
+            },
+            expectedErrType: domain.ErrInvalidStatusTransition,
+        },
+        {
+            name:   "ошибка: ready для OPEN PR",
+            status: entity.PROpen,
+            action: func(svc *PullRequest, ctx context.Context) (*entity.PullRequest, error) {
+                return svc.MarkReady(ctx, "pr-1", CreateOptions{})
+            },
+            expectedErrType: domain.ErrInvalidStatusTransition,
+        },
+        {
+            name:   "ошибка: reopen OPEN PR",
+            status: entity.PROpen,
+            action: func(svc *PullRequest, ctx context.Context) (*entity.PullRequest, error) {
+                return svc.Reopen(ctx, "pr-1")
+            },
+            expectedErrType: domain.ErrInvalidStatusTransition,
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            ctx := context.Background()
+            pr := &entity.PullRequest{
+                ID:                "pr-1",
+                AuthorID:          "u1",
+                Status:            tt.status,
+                AssignedReviewers: []string{"u2"},
+            }
+
+            mockPRRepo := mocks.NewPullRequestRepository(t)
+            mockUserRepo := mocks.NewUserRepository(t)
+            mockTeamRepo := mocks.NewTeamRepository(t)
+            mockOwnershipRepo := mocks.NewOwnershipRepository(t)
+            mockTx := mocks.NewTransactor(t)
+
+            mockPRRepo.On("GetByID", ctx, pr.ID).Return(pr, nil)
+            if tt.expectedErrType == nil {
+                mockPRRepo.On("UpdateStatus", ctx, pr.ID, tt.expectStatus).Return(nil)
+                mockPRRepo.On("AppendEvents", ctx, []entity.ReviewerEvent{tt.expectEvent}).Return(nil)
+            }
+            mockTx.On(
+                "WithinTransaction",
+                mock.Anything,
+                mock.AnythingOfType("func(context.Context) error"),
+            ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+                return fn(ctx)
+            }).Maybe()
+
+            svc := NewPullRequest(mockPRRepo, mockUserRepo, mockTeamRepo, mockOwnershipRepo, NewReviewerSelectors(mockUserRepo, mockTeamRepo), mockTx)
+            gotPr, err := tt.action(svc, ctx)
+
+            if tt.expectedErrType != nil {
+                require.Error(t, err)
+                assert.ErrorIs(t, err, tt.expectedErrType)
+                return
+            }
+            require.NoError(t, err)
+            assert.Equal(t, tt.expectStatus, gotPr.Status)
+        })
+    }
+}
+
+func TestPullRequestService_MarkReady(t *testing.T) {
+    ctx := context.Background()
+    draft := &entity.PullRequest{ID: "pr-1", AuthorID: "u1", Status: entity.PRDraft}
+    ready := &entity.PullRequest{ID: "pr-1", AuthorID: "u1", Status: entity.PROpen, AssignedReviewers: []string{"u2"}}
+
+    mockPRRepo := mocks.NewPullRequestRepository(t)
+    mockUserRepo := mocks.NewUserRepository(t)
+    mockTeamRepo := mocks.NewTeamRepository(t)
+    mockOwnershipRepo := mocks.NewOwnershipRepository(t)
+    mockTx := mocks.NewTransactor(t)
+
+    mockPRRepo.On("GetByID", ctx, "pr-1").Return(draft, nil).Once()
+    mockPRRepo.On("GetByID", ctx, "pr-1").Return(ready, nil).Once()
+    mockUserRepo.On("GetByID", ctx, "u1").Return(&entity.User{ID: "u1", TeamName: "backend", Teams: []string{"backend"}, IsActive: true}, nil)
+    mockTeamRepo.On("GetByName", ctx, "backend").Return(&entity.Team{
+        Name:             "backend",
+        ReviewerStrategy: entity.ReviewerStrategyRandom,
+        MaxReviewers:     1,
+    }, nil)
+    mockUserRepo.On("GetRandomActiveTeamUsers", ctx, "backend", []string{"u1"}, 1).
+        Return([]entity.User{{ID: "u2", IsActive: true}}, nil)
+    mockPRRepo.On("AssignReviewers", ctx, "pr-1", []string{"u2"}, []string{}, (*time.Time)(nil)).Return(nil)
+    mockPRRepo.On("AppendEvents", ctx, []entity.ReviewerEvent{{
+        PullRequestID: "pr-1",
+        Type:          entity.EventAssigned,
+        ReviewerID:    "u2",
+        Reason:        "pull request ready for review",
+    }}).Return(nil)
+    mockPRRepo.On("UpdateStatus", ctx, "pr-1", entity.PROpen).Return(nil)
+    mockTx.On(
+        "WithinTransaction",
+        mock.Anything,
+        mock.AnythingOfType("func(context.Context) error"),
+    ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+        return fn(ctx)
     })
+
+    svc := NewPullRequest(mockPRRepo, mockUserRepo, mockTeamRepo, mockOwnershipRepo, NewReviewerSelectors(mockUserRepo, mockTeamRepo), mockTx)
+    pr, err := svc.MarkReady(ctx, "pr-1", CreateOptions{})
+
+    require.NoError(t, err)
+    assert.Equal(t, entity.PROpen, pr.Status)
+    assert.Equal(t, []string{"u2"}, pr.AssignedReviewers)
+}
+
+func TestPullRequestService_Reopen(t *testing.T) {
+    ctx := context.Background()
+    pr := &entity.PullRequest{ID: "pr-1", AuthorID: "u1", Status: entity.PRClosed, AssignedReviewers: []string{"u2", "u3", "u5"}}
+    backend := []string{"backend"}
+
+    mockPRRepo := mocks.NewPullRequestRepository(t)
+    mockUserRepo := mocks.NewUserRepository(t)
+    mockTeamRepo := mocks.NewTeamRepository(t)
+    mockOwnershipRepo := mocks.NewOwnershipRepository(t)
+    mockTx := mocks.NewTransactor(t)
+
+    // the reassignment runs with the reason in its context
+    mockPRRepo.On("GetByID", mock.Anything, "pr-1").Return(pr, nil)
+    mockPRRepo.On("UpdateStatus", ctx, "pr-1", entity.PROpen).Return(nil)
+    mockUserRepo.On("GetByID", mock.Anything, "u1").Return(&entity.User{ID: "u1", TeamName: "backend", Teams: backend, IsActive: true}, nil)
+    mockUserRepo.On("GetByID", mock.Anything, "u2").Return(&entity.User{ID: "u2", TeamName: "backend", Teams: backend, IsActive: true}, nil)
+    mockUserRepo.On("GetByID", mock.Anything, "u3").Return(&entity.User{ID: "u3", TeamName: "backend", Teams: backend}, nil)
+    mockUserRepo.On("GetByID", mock.Anything, "u5").Return(&entity.User{ID: "u5", TeamName: "backend", Teams: backend}, nil)
+    mockTeamRepo.On("GetByName", mock.Anything, "backend").Return(&entity.Team{
+        Name:             "backend",
+        ReviewerStrategy: entity.ReviewerStrategyRandom,
+        MaxReviewers:     3,
+    }, nil)
+    mockUserRepo.On("GetRandomActiveTeamUsers", mock.Anything, "backend", mock.Anything, 1).
+        Return([]entity.User{{ID: "u4", IsActive: true}}, nil).Once()
+    mockUserRepo.On("GetRandomActiveTeamUsers", mock.Anything, "backend", mock.Anything, 1).
+        Return([]entity.User{}, nil).Once()
+    mockPRRepo.On("ReplaceReviewer", mock.Anything, "pr-1", "u3", "u4", false, (*time.Time)(nil)).Return(nil)
+    mockPRRepo.On("RemoveReviewer", mock.Anything, "pr-1", "u5").Return(nil)
+    mockPRRepo.On("AppendEvents", mock.Anything, []entity.ReviewerEvent{{
+        PullRequestID: "pr-1",
+        Type:          entity.EventReopened,
+        Reason:        "reopened",
+    }}).Return(nil).Once()
+    mockPRRepo.On("AppendEvents", mock.Anything, []entity.ReviewerEvent{{
+        PullRequestID:      "pr-1",
+        Type:               entity.EventReassigned,
+        ReviewerID:         "u4",
+        PreviousReviewerID: "u3",
+        Reason:             "pull request reopened, reviewer unavailable",
+    }}).Return(nil).Once()
+    mockPRRepo.On("AppendEvents", mock.Anything, []entity.ReviewerEvent{{
+        PullRequestID: "pr-1",
+        Type:          entity.EventUnassigned,
+        ReviewerID:    "u5",
+        Reason:        "pull request reopened, reviewer unavailable, no replacement candidate",
+    }}).Return(nil).Once()
+    mockTx.On(
+        "WithinTransaction",
+        mock.Anything,
+        mock.AnythingOfType("func(context.Context) error"),
+    ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+        return fn(ctx)
+    })
+
+    svc := NewPullRequest(mockPRRepo, mockUserRepo, mockTeamRepo, mockOwnershipRepo, NewReviewerSelectors(mockUserRepo, mockTeamRepo), mockTx)
+    reopened, err := svc.Reopen(ctx, "pr-1")
+
+    require.NoError(t, err)
+    assert.Equal(t, entity.PROpen, reopened.Status)
+    mockPRRepo.AssertNumberOfCalls(t, "AppendEvents", 3)
+}
+
+func TestPullRequestService_AddReviewer(t *testing.T) {
+    tests := []struct {
+        name            string
+        status          entity.PullRequestStatus
+        reviewers       []string
+        userID          string
+        expectAdded     string
+        expectedErrType error
+    }{
+        {
+            name:        "автоматический выбор",
+            status:      entity.PROpen,
+            reviewers:   []string{"u2"},
+            expectAdded: "u4",
+        },
+        {
+            name:        "ревьювер выбран вручную",
+            status:      entity.PROpen,
+            reviewers:   []string{"u2"},
+            userID:      "u3",
+            expectAdded: "u3",
+        },
+        {
+            name:            "ошибка: достигнут max_reviewers",
+            status:          entity.PROpen,
+            reviewers:       []string{"u2", "u3"},
+            expectedErrType: domain.ErrReviewerLimitReached,
+        },
+        {
+            name:            "ошибка: PR смержен",
+            status:          entity.PRMerged,
+            reviewers:       []string{"u2"},
+            expectedErrType: domain.ErrPullRequestIsMerged,
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            ctx := context.Background()
+            pr := &entity.PullRequest{ID: "pr-1", AuthorID: "u1", Status: tt.status, AssignedReviewers: tt.reviewers}
+
+            mockPRRepo := mocks.NewPullRequestRepository(t)
+            mockUserRepo := mocks.NewUserRepository(t)
+            mockTeamRepo := mocks.NewTeamRepository(t)
+            mockOwnershipRepo := mocks.NewOwnershipRepository(t)
+            mockTx := mocks.NewTransactor(t)
+
+            mockPRRepo.On("GetByID", ctx, "pr-1").Return(pr, nil)
+            mockUserRepo.On("GetByID", ctx, "u1").Return(&entity.User{ID: "u1", TeamName: "backend", Teams: []string{"backend"}, IsActive: true}, nil).Maybe()
+            mockTeamRepo.On("GetByName", ctx, "backend").Return(&entity.Team{
+                Name:             "backend",
+                ReviewerStrategy: entity.ReviewerStrategyRandom,
+                MaxReviewers:     2,
+            }, nil).Maybe()
+            mockUserRepo.On("GetByID", ctx, "u3").Return(&entity.User{ID: "u3", TeamName: "backend", Teams: []string{"backend"}, IsActive: true}, nil).Maybe()
+            mockUserRepo.On("GetRandomActiveTeamUsers", ctx, "backend", []string{"u2", "u1"}, 1).
+                Return([]entity.User{{ID: "u4", IsActive: true}}, nil).Maybe()
+            if tt.expectedErrType == nil {
+                mockPRRepo.On("AssignReviewers", ctx, "pr-1", []string{tt.expectAdded}, []string{}, (*time.Time)(nil)).Return(nil)
+                mockPRRepo.On("AppendEvents", ctx, []entity.ReviewerEvent{{
+                    PullRequestID: "pr-1",
+                    Type:          entity.EventAssigned,
+                    ReviewerID:    tt.expectAdded,
+                    Reason:        "reviewer added",
+                }}).Return(nil)
+            }
+            mockTx.On(
+                "WithinTransaction",
+                mock.Anything,
+                mock.AnythingOfType("func(context.Context) error"),
+            ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+                return fn(ctx)
+            })
+
+            svc := NewPullRequest(mockPRRepo, mockUserRepo, mockTeamRepo, mockOwnershipRepo, NewReviewerSelectors(mockUserRepo, mockTeamRepo), mockTx)
+            _, added, err := svc.AddReviewer(ctx, "pr-1", tt.userID)
+
+            if tt.expectedErrType != nil {
+                require.Error(t, err)
+                assert.ErrorIs(t, err, tt.expectedErrType)
+                return
+            }
+            require.NoError(t, err)
+            assert.Equal(t, tt.expectAdded, added)
+        })
+    }
+}
+
+func TestPullRequestService_RemoveReviewer(t *testing.T) {
+    tests := []struct {
+        name            string
+        userID          string
+        expectedErrType error
+    }{
+        {
+            name:   "ревьювер снят без замены",
+            userID: "u2",
+        },
+        {
+            name:            "ошибка: пользователь не назначен",
+            userID:          "u5",
+            expectedErrType: domain.ErrReviewerNotAssigned,
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            ctx := context.Background()
+            pr := &entity.PullRequest{ID: "pr-1", AuthorID: "u1", Status: entity.PROpen, AssignedReviewers: []string{"u2"}}
+
+            mockPRRepo := mocks.NewPullRequestRepository(t)
+            mockUserRepo := mocks.NewUserRepository(t)
+            mockTeamRepo := mocks.NewTeamRepository(t)
+            mockOwnershipRepo := mocks.NewOwnershipRepository(t)
+            mockTx := mocks.NewTransactor(t)
+
+            mockPRRepo.On("GetByID", ctx, "pr-1").Return(pr, nil)
+            if tt.expectedErrType == nil {
+                mockPRRepo.On("RemoveReviewer", ctx, "pr-1", tt.userID).Return(nil)
+                mockPRRepo.On("AppendEvents", ctx, []entity.ReviewerEvent{{
+                    PullRequestID: "pr-1",
+                    Type:          entity.EventUnassigned,
+                    ReviewerID:    tt.userID,
+                    Reason:        "reviewer removed",
+                }}).Return(nil)
+            }
+            mockTx.On(
+                "WithinTransaction",
+                mock.Anything,
+                mock.AnythingOfType("func(context.Context) error"),
+            ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+                return fn(ctx)
+            })
+
+            svc := NewPullRequest(mockPRRepo, mockUserRepo, mockTeamRepo, mockOwnershipRepo, NewReviewerSelectors(mockUserRepo, mockTeamRepo), mockTx)
+            _, err := svc.RemoveReviewer(ctx, "pr-1", tt.userID)
+
+            if tt.expectedErrType != nil {
+                require.Error(t, err)
+                assert.ErrorIs(t, err, tt.expectedErrType)
+                return
+            }
+            require.NoError(t, err)
+        })
+    }
+}
+
+func TestPullRequestService_SubmitReview(t *testing.T) {
+    ctx := context.Background()
+    openPr := &entity.PullRequest{ID: "pr-1", AuthorID: "u1", Status: entity.PROpen, AssignedReviewers: []string{"u2"}}
+
+    tests := []struct {
+        name       string
+        pr         *entity.PullRequest
+        reviewerID string
+        verdict    entity.ReviewVerdict
+        wantErr    error
+    }{
+        {
+            name:       "вердикт назначенного ревьювера",
+            pr:         openPr,
+            reviewerID: "u2",
+            verdict:    entity.VerdictChangesRequested,
+        },
+        {
+            name:       "неизвестный вердикт",
+            reviewerID: "u2",
+            verdict:    "LGTM",
+            wantErr:    domain.ErrInvalidReviewVerdict,
+        },
+        {
+            name:       "пользователь не назначен ревьювером",
+            pr:         openPr,
+            reviewerID: "u3",
+            verdict:    entity.VerdictApproved,
+            wantErr:    domain.ErrReviewerNotAssigned,
+        },
+        {
+            name:       "PR смержен",
+            pr:         &entity.PullRequest{ID: "pr-1", Status: entity.PRMerged, AssignedReviewers: []string{"u2"}},
+            reviewerID: "u2",
+            verdict:    entity.VerdictApproved,
+            wantErr:    domain.ErrPullRequestIsMerged,
+        },
+        {
+            name:       "PR закрыт",
+            pr:         &entity.PullRequest{ID: "pr-1", Status: entity.PRClosed, AssignedReviewers: []string{"u2"}},
+            reviewerID: "u2",
+            verdict:    entity.VerdictApproved,
+            wantErr:    domain.ErrPullRequestNotOpen,
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            mockPRRepo := mocks.NewPullRequestRepository(t)
+            mockUserRepo := mocks.NewUserRepository(t)
+            mockTeamRepo := mocks.NewTeamRepository(t)
+            mockOwnershipRepo := mocks.NewOwnershipRepository(t)
+            mockTx := mocks.NewTransactor(t)
+
+            if tt.pr != nil {
+                mockPRRepo.On("GetByID", ctx, "pr-1").Return(tt.pr, nil)
+                mockTx.On(
+                    "WithinTransaction",
+                    mock.Anything,
+                    mock.AnythingOfType("func(context.Context) error"),
+                ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+                    return fn(ctx)
+                })
+            }
+            if tt.wantErr == nil {
+                mockPRRepo.On("SubmitVerdict", ctx, "pr-1", tt.reviewerID, tt.verdict, "needs an index").Return(nil)
+                mockPRRepo.On("AppendEvents", ctx, []entity.ReviewerEvent{{
+                    PullRequestID: "pr-1",
+                    Type:          entity.EventVerdict,
+                    ReviewerID:    tt.reviewerID,
+                    Reason:        "needs an index",
+                    Verdict:       tt.verdict,
+                }}).Return(nil)
+            }
+
+            svc := NewPullRequest(mockPRRepo, mockUserRepo, mockTeamRepo, mockOwnershipRepo, NewReviewerSelectors(mockUserRepo, mockTeamRepo), mockTx)
+            pr, err := svc.SubmitReview(ctx, "pr-1", tt.reviewerID, tt.verdict, "needs an index")
+
+            if tt.wantErr != nil {
+                assert.ErrorIs(t, err, tt.wantErr)
+                assert.Nil(t, pr)
+                return
+            }
+            require.NoError(t, err)
+            assert.Equal(t, "pr-1", pr.ID)
+        })
+    }
 }
 
 func TestPullRequestService_ReassignReviewer(t *testing.T) {
@@ -139,17 +1115,37 @@ func TestPullRequestService_ReassignReviewer(t *testing.T) {
         oldUser := &entity.User{
             ID:       "u2",
             TeamName: "backend",
+            Teams:    []string{"backend"},
             IsActive: true,
         }
         newReviewer := entity.User{ID: "u4"}
 
         mockPRRepo := mocks.NewPullRequestRepository(t)
         mockUserRepo := mocks.NewUserRepository(t)
+        mockTeamRepo := mocks.NewTeamRepository(t)
+        mockOwnershipRepo := mocks.NewOwnershipRepository(t)
         mockTx := mocks.NewTransactor(t)
 
         mockPRRepo.On("GetByID", ctx, pr.ID).Return(pr, nil)
-        mockPRRepo.On("ReplaceReviewer", ctx, pr.ID, oldUser.ID, newReviewer.ID).Return(nil)
+        mockPRRepo.On("ReplaceReviewer", ctx, pr.ID, oldUser.ID, newReviewer.ID, false,
+            mock.MatchedBy(func(dueAt *time.Time) bool {
+                return dueAt != nil && dueAt.After(time.Now().Add(23*time.Hour))
+            }),
+        ).Return(nil)
+        mockPRRepo.On("AppendEvents", ctx, []entity.ReviewerEvent{{
+            PullRequestID:      pr.ID,
+            Type:               entity.EventReassigned,
+            ReviewerID:         newReviewer.ID,
+            PreviousReviewerID: oldUser.ID,
+            Reason:             "reviewer reassigned",
+        }}).Return(nil)
         mockUserRepo.On("GetByID", ctx, oldUser.ID).Return(oldUser, nil)
+        mockUserRepo.On("GetByID", ctx, pr.AuthorID).Return(&entity.User{ID: pr.AuthorID, TeamName: "backend", Teams: []string{"backend"}}, nil)
+        mockTeamRepo.On("GetByName", ctx, oldUser.TeamName).Return(&entity.Team{
+            Name:             oldUser.TeamName,
+            ReviewerStrategy: entity.ReviewerStrategyRandom,
+            ReviewSLA:        entity.ReviewSLA{Hours: 24},
+        }, nil)
         mockUserRepo.On("GetRandomActiveTeamUsers", ctx, oldUser.TeamName, mock.Anything, 1).
             Return([]entity.User{newReviewer}, nil)
 
@@ -161,10 +1157,182 @@ func TestPullRequestService_ReassignReviewer(t *testing.T) {
             return fn(ctx)
         })
 
-        svc := NewPullRequest(mockPRRepo, mockUserRepo, mockTx)
-        gotPr, gotNewID, err := svc.ReassignReviewer(ctx, pr.ID, oldUser.ID)
+        svc := NewPullRequest(mockPRRepo, mockUserRepo, mockTeamRepo, mockOwnershipRepo, NewReviewerSelectors(mockUserRepo, mockTeamRepo), mockTx)
+        gotPr, gotNewID, err := svc.ReassignReviewer(ctx, pr.ID, oldUser.ID, "")
         require.NoError(t, err)
         assert.Equal(t, newReviewer.ID, gotNewID)
         assert.Equal(t, pr, gotPr)
     })
+
+    vacationEnd := time.Now().Add(7 * 24 * time.Hour)
+    manualTests := []struct {
+        name            string
+        newUser         *entity.User
+        expectFallback  bool
+        expectedErrType error
+    }{
+        {
+            name:           "ручная замена на участника резервной команды",
+            newUser:        &entity.User{ID: "u7", TeamName: "platform", Teams: []string{"platform"}, IsActive: true},
+            expectFallback: true,
+        },
+        {
+            name:    "ручная замена на участника нескольких команд",
+            newUser: &entity.User{ID: "u7", TeamName: "platform", Teams: []string{"platform", "backend"}, IsActive: true},
+        },
+        {
+            name:            "ошибка: выбранный пользователь неактивен",
+            newUser:         &entity.User{ID: "u7", TeamName: "backend", Teams: []string{"backend"}, IsActive: false},
+            expectedErrType: domain.ErrNoReviewerCandidate,
+        },
+        {
+            name: "ошибка: выбранный пользователь в отпуске",
+            newUser: &entity.User{
+                ID:               "u7",
+                TeamName:         "backend",
+                Teams:            []string{"backend"},
+                IsActive:         true,
+                UnavailableUntil: &vacationEnd,
+            },
+            expectedErrType: domain.ErrNoReviewerCandidate,
+        },
+        {
+            name:            "ошибка: выбранный пользователь из чужой команды",
+            newUser:         &entity.User{ID: "u7", TeamName: "frontend", Teams: []string{"frontend"}, IsActive: true},
+            expectedErrType: domain.ErrNoReviewerCandidate,
+        },
+    }
+
+    for _, tt := range manualTests {
+        t.Run(tt.name, func(t *testing.T) {
+            ctx := context.Background()
+            pr := &entity.PullRequest{
+                ID:                "pr-1",
+                AuthorID:          "u1",
+                Status:            entity.PROpen,
+                AssignedReviewers: []string{"u2", "u3"},
+            }
+
+            mockPRRepo := mocks.NewPullRequestRepository(t)
+            mockUserRepo := mocks.NewUserRepository(t)
+            mockTeamRepo := mocks.NewTeamRepository(t)
+            mockOwnershipRepo := mocks.NewOwnershipRepository(t)
+            mockTx := mocks.NewTransactor(t)
+
+            mockPRRepo.On("GetByID", ctx, pr.ID).Return(pr, nil)
+            mockUserRepo.On("GetByID", ctx, "u2").Return(&entity.User{ID: "u2", TeamName: "backend", Teams: []string{"backend"}, IsActive: true}, nil)
+            mockUserRepo.On("GetByID", ctx, "u1").Return(&entity.User{ID: "u1", TeamName: "backend", Teams: []string{"backend"}, IsActive: true}, nil)
+            mockUserRepo.On("GetByID", ctx, tt.newUser.ID).Return(tt.newUser, nil)
+            mockTeamRepo.On("GetByName", ctx, "backend").Return(&entity.Team{
+                Name:          "backend",
+                FallbackTeams: []string{"platform"},
+            }, nil)
+            if tt.expectedErrType == nil {
+                mockPRRepo.On("ReplaceReviewer", ctx, pr.ID, "u2", tt.newUser.ID, tt.expectFallback, (*time.Time)(nil)).Return(nil)
+                mockPRRepo.On("AppendEvents", ctx, []entity.ReviewerEvent{{
+                    PullRequestID:      pr.ID,
+                    Type:               entity.EventReassigned,
+                    ReviewerID:         tt.newUser.ID,
+                    PreviousReviewerID: "u2",
+                    Reason:             "reviewer reassigned",
+                }}).Return(nil)
+            }
+            mockTx.On(
+                "WithinTransaction",
+                mock.Anything,
+                mock.AnythingOfType("func(context.Context) error"),
+            ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+                return fn(ctx)
+            })
+
+            svc := NewPullRequest(mockPRRepo, mockUserRepo, mockTeamRepo, mockOwnershipRepo, NewReviewerSelectors(mockUserRepo, mockTeamRepo), mockTx)
+            _, gotNewID, err := svc.ReassignReviewer(ctx, pr.ID, "u2", tt.newUser.ID)
+
+            if tt.expectedErrType != nil {
+                require.Error(t, err)
+                assert.ErrorIs(t, err, tt.expectedErrType)
+                return
+            }
+            require.NoError(t, err)
+            assert.Equal(t, tt.newUser.ID, gotNewID)
+        })
+    }
+}
+
+func TestPullRequestService_TeamlessAuthor(t *testing.T) {
+    teamless := &entity.User{ID: "u1", IsActive: true}
+    reviewer := &entity.User{ID: "u2", TeamName: "backend", Teams: []string{"backend"}, IsActive: true}
+
+    newService := func(t *testing.T, ctx context.Context, pr *entity.PullRequest) (
+        *PullRequest, *mocks.PullRequestRepository, *mocks.UserRepository, *mocks.TeamRepository,
+    ) {
+        mockPRRepo := mocks.NewPullRequestRepository(t)
+        mockUserRepo := mocks.NewUserRepository(t)
+        mockTeamRepo := mocks.NewTeamRepository(t)
+        mockTx := mocks.NewTransactor(t)
+
+        mockPRRepo.On("GetByID", ctx, pr.ID).Return(pr, nil)
+        mockUserRepo.On("GetByID", ctx, teamless.ID).Return(teamless, nil)
+        mockTx.On(
+            "WithinTransaction",
+            mock.Anything,
+            mock.AnythingOfType("func(context.Context) error"),
+        ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+            return fn(ctx)
+        })
+
+        svc := NewPullRequest(
+            mockPRRepo, mockUserRepo, mockTeamRepo, mocks.NewOwnershipRepository(t),
+            NewReviewerSelectors(mockUserRepo, mockTeamRepo), mockTx,
+        )
+        return svc, mockPRRepo, mockUserRepo, mockTeamRepo
+    }
+
+    t.Run("merge по политике по умолчанию", func(t *testing.T) {
+        ctx := context.Background()
+        pr := &entity.PullRequest{ID: "pr-1", AuthorID: teamless.ID, Status: entity.PROpen, AssignedReviewers: []string{"u2"}}
+
+        svc, mockPRRepo, _, _ := newService(t, ctx, pr)
+        mockPRRepo.On("UpdateStatus", ctx, pr.ID, entity.PRMerged).Return(nil)
+        mockPRRepo.On("AppendEvents", ctx, mock.AnythingOfType("[]entity.ReviewerEvent")).Return(nil)
+
+        gotPr, err := svc.Merge(ctx, pr.ID, MergeOptions{})
+        require.NoError(t, err)
+        assert.Equal(t, entity.PRMerged, gotPr.Status)
+        assert.Nil(t, gotPr.MergeOverride)
+    })
+
+    t.Run("reassign из команды ревьювера", func(t *testing.T) {
+        ctx := context.Background()
+        pr := &entity.PullRequest{ID: "pr-1", AuthorID: teamless.ID, Status: entity.PROpen, AssignedReviewers: []string{"u2"}}
+
+        svc, mockPRRepo, mockUserRepo, mockTeamRepo := newService(t, ctx, pr)
+        mockUserRepo.On("GetByID", ctx, reviewer.ID).Return(reviewer, nil)
+        mockTeamRepo.On("GetByName", ctx, "backend").Return(&entity.Team{
+            Name:             "backend",
+            ReviewerStrategy: entity.ReviewerStrategyRandom,
+            ReviewSLA:        entity.ReviewSLA{Hours: 24},
+        }, nil)
+        mockUserRepo.On("GetRandomActiveTeamUsers", ctx, "backend", []string{"u2", "u1"}, 1).
+            Return([]entity.User{{ID: "u3"}}, nil)
+        // the SLA of the author's settings applies, entity.NoTeam sets no deadline
+        mockPRRepo.On("ReplaceReviewer", ctx, pr.ID, "u2", "u3", false, (*time.Time)(nil)).Return(nil)
+        mockPRRepo.On("AppendEvents", ctx, mock.AnythingOfType("[]entity.ReviewerEvent")).Return(nil)
+
+        _, gotNewID, err := svc.ReassignReviewer(ctx, pr.ID, "u2", "")
+        require.NoError(t, err)
+        assert.Equal(t, "u3", gotNewID)
+    })
+
+    t.Run("ошибка: reassign, когда и ревьювер без команды", func(t *testing.T) {
+        ctx := context.Background()
+        pr := &entity.PullRequest{ID: "pr-1", AuthorID: teamless.ID, Status: entity.PROpen, AssignedReviewers: []string{"u2"}}
+
+        svc, _, mockUserRepo, _ := newService(t, ctx, pr)
+        mockUserRepo.On("GetByID", ctx, "u2").Return(&entity.User{ID: "u2", IsActive: true}, nil)
+
+        _, _, err := svc.ReassignReviewer(ctx, pr.ID, "u2", "")
+        require.Error(t, err)
+        assert.ErrorIs(t, err, domain.ErrNoReviewerCandidate)
+    })
 }
@@ -0,0 +1,130 @@
+package service
+
+import (
+    "context"
+    "fmt"
+    "slices"
+    "strings"
+
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/repository"
+)
+
+// ReviewerSelector picks up to maxCount active members of a team as reviewers
+type ReviewerSelector interface {
+    Select(ctx context.Context, teamName string, excludeUserIDs []string, maxCount int) ([]entity.User, error)
+}
+
+// ReviewerSelectors maps team strategies to their selectors
+type ReviewerSelectors map[entity.ReviewerStrategy]ReviewerSelector
+
+func NewReviewerSelectors(userRepo repository.UserRepository, teamRepo repository.TeamRepository) ReviewerSelectors {
+    return ReviewerSelectors{
+        entity.ReviewerStrategyRandom:      &randomSelector{userRepository: userRepo},
+        entity.ReviewerStrategyLeastLoaded: &leastLoadedSelector{userRepository: userRepo},
+        entity.ReviewerStrategyRoundRobin: &roundRobinSelector{
+            userRepository: userRepo,
+            teamRepository: teamRepo,
+        },
+    }
+}
+
+// For returns the selector of the strategy, teams without a known strategy get random selection
+func (s ReviewerSelectors) For(strategy entity.ReviewerStrategy) ReviewerSelector {
+    if selector, ok := s[strategy]; ok {
+        return selector
+    }
+    return s[entity.ReviewerStrategyRandom]
+}
+
+type randomSelector struct {
+    userRepository repository.UserRepository
+}
+
+func (s *randomSelector) Select(
+    ctx context.Context,
+    teamName string,
+    excludeUserIDs []string,
+    maxCount int,
+) ([]entity.User, error) {
+    return s.userRepository.GetRandomActiveTeamUsers(ctx, teamName, excludeUserIDs, maxCount)
+}
+
+type leastLoadedSelector struct {
+    userRepository repository.UserRepository
+}
+
+func (s *leastLoadedSelector) Select(
+    ctx context.Context,
+    teamName string,
+    excludeUserIDs []string,
+    maxCount int,
+) ([]entity.User, error) {
+    return s.userRepository.GetLeastLoadedActiveTeamUsers(ctx, teamName, excludeUserIDs, maxCount)
+}
+
+// roundRobinSelector walks through team members ordered by id. The cursor is stored
+// per team and locked by the repository, so Select has to run inside a transaction
+// together with the assignment it feeds
+type roundRobinSelector struct {
+    userRepository repository.UserRepository
+    teamRepository repository.TeamRepository
+}
+
+func (s *roundRobinSelector) Select(
+    ctx context.Context,
+    teamName string,
+    excludeUserIDs []string,
+    maxCount int,
+) ([]entity.User, error) {
+    cursor, err := s.teamRepository.LockRotationCursor(ctx, teamName)
+    if err != nil {
+        return nil, fmt.Errorf("lock rotation cursor: %w", err)
+    }
+
+    members, err := s.userRepository.GetByTeam(ctx, teamName)
+    if err != nil {
+        return nil, fmt.Errorf("get team members: %w", err)
+    }
+
+    selected, last := rotate(members, cursor, excludeUserIDs, maxCount)
+    if last == "" {
+        return selected, nil
+    }
+    if err := s.teamRepository.SetRotationCursor(ctx, teamName, last); err != nil {
+        return nil, fmt.Errorf("set rotation cursor: %w", err)
+    }
+    return selected, nil
+}
+
+// rotate returns up to maxCount reviewable members coming after cursor in id order,
+// wrapping around the list, and the id of the last picked member.
+// The cursor does not have to be a current member
+func rotate(members []entity.User, cursor string, excludeUserIDs []string, maxCount int) ([]entity.User, string) {
+    excluded := make(map[string]struct{}, len(excludeUserIDs))
+    for _, id := range excludeUserIDs {
+        excluded[id] = struct{}{}
+    }
+
+    ordered := slices.Clone(members)
+    slices.SortFunc(ordered, func(a, b entity.User) int {
+        return strings.Compare(a.ID, b.ID)
+    })
+
+    start := 0
+    for start < len(ordered) && ordered[start].ID <= cursor {
+        start++
+    }
+
+    var selected []entity.User
+    last := ""
+    for i := 0; i < len(ordered) && len(selected) < maxCount; i++ {
+        member := ordered[(start+i)%len(ordered)]
+        if _, ok := excluded[member.ID]; ok || !member.CanReview() {
+            continue
+        }
+        selected = append(selected, member)
+        last = member.ID
+    }
+    return selected, last
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+    "context"
+    "testing"
+
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/service/mocks"
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/mock"
+    "github.com/stretchr/testify/require"
+)
+
+func TestReviewerSelectors_For(t *testing.T) {
+    mockUserRepo := mocks.NewUserRepository(t)
+    mockTeamRepo := mocks.NewTeamRepository(t)
+    selectors := NewReviewerSelectors(mockUserRepo, mockTeamRepo)
+
+    assert.IsType(t, &leastLoadedSelector{}, selectors.For(entity.ReviewerStrategyLeastLoaded))
+    assert.IsType(t, &roundRobinSelector{}, selectors.For(entity.ReviewerStrategyRoundRobin))
+    assert.IsType(t, &randomSelector{}, selectors.For(""))
+}
+
+func TestLeastLoadedSelector_Select(t *testing.T) {
+    ctx := context.Background()
+    expected := []entity.User{{ID: "u3", IsActive: true}}
+
+    mockUserRepo := mocks.NewUserRepository(t)
+    mockUserRepo.On("GetLeastLoadedActiveTeamUsers", ctx, "backend", []string{"u1"}, 2).Return(expected, nil)
+
+    selected, err := NewReviewerSelectors(mockUserRepo, mocks.NewTeamRepository(t)).For(entity.ReviewerStrategyLeastLoaded).
+        Select(ctx, "backend", []string{"u1"}, 2)
+    require.NoError(t, err)
+    assert.Equal(t, expected, selected)
+}
+
+func TestRoundRobinSelector_Select(t *testing.T) {
+    ctx := context.Background()
+    members := []entity.User{
+        {ID: "u4", IsActive: true},
+        {ID: "u1", IsActive: true},
+        {ID: "u3", IsActive: false},
+        {ID: "u2", IsActive: true},
+    }
+
+    mockUserRepo := mocks.NewUserRepository(t)
+    mockTeamRepo := mocks.NewTeamRepository(t)
+    mockUserRepo.On("GetByTeam", ctx, "backend").Return(members, nil)
+
+    cursor := ""
+    mockTeamRepo.On("LockRotationCursor", ctx, "backend").Return(func(context.Context, string) (string, error) {
+        return cursor, nil
+    })
+    mockTeamRepo.On("SetRotationCursor", ctx, "backend", mock.AnythingOfType("string")).
+        Return(func(_ context.Context, _ string, last string) error {
+            cursor = last
+            return nil
+        })
+
+    selector := NewReviewerSelectors(mockUserRepo, mockTeamRepo).For(entity.ReviewerStrategyRoundRobin)
+
+    ids := func(users []entity.User) []string {
+        res := make([]string, len(users))
+        for i, u := range users {
+            res[i] = u.ID
+        }
+        return res
+    }
+
+    first, err := selector.Select(ctx, "backend", []string{"u1"}, 1)
+    require.NoError(t, err)
+    assert.Equal(t, []string{"u2"}, ids(first), "автор пропускается")
+    assert.Equal(t, "u2", cursor)
+
+    second, err := selector.Select(ctx, "backend", []string{"u1"}, 1)
+    require.NoError(t, err)
+    assert.Equal(t, []string{"u4"}, ids(second), "неактивный пропускается")
+
+    third, err := selector.Select(ctx, "backend", []string{"u1"}, 2)
+    require.NoError(t, err)
+    assert.Equal(t, []string{"u2", "u4"}, ids(third), "обход по кругу")
+}
+
+func TestRotate(t *testing.T) {
+    members := []entity.User{
+        {ID: "u1", IsActive: true},
+        {ID: "u3", IsActive: true},
+        {ID: "u5", IsActive: true},
+    }
+
+    t.Run("курсор на пользователе не из команды", func(t *testing.T) {
+        selected, last := rotate(members, "u4", nil, 1)
+        require.Len(t, selected, 1)
+        assert.Equal(t, "u5", selected[0].ID)
+        assert.Equal(t, "u5", last)
+    })
+
+    t.Run("пропускает пользователя с исчерпанным лимитом", func(t *testing.T) {
+        loaded := []entity.User{
+            {ID: "u1", IsActive: true},
+            {ID: "u3", IsActive: true, OpenReviews: 2, ReviewCapacity: 2},
+            {ID: "u5", IsActive: true, OpenReviews: 1, ReviewCapacity: 2},
+        }
+        selected, last := rotate(loaded, "u1", nil, 1)
+        require.Len(t, selected, 1)
+        assert.Equal(t, "u5", selected[0].ID)
+        assert.Equal(t, "u5", last)
+    })
+
+    t.Run("нет кандидатов", func(t *testing.T) {
+        selected, last := rotate(members, "", []string{"u1", "u3", "u5"}, 2)
+        assert.Empty(t, selected)
+        assert.Empty(t, last)
+    })
+}
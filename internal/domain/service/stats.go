@@ -3,21 +3,45 @@ package service
 import (
     "context"
     "fmt"
+    "slices"
+    "strings"
 
     "github.com/kimvlry/avito-internship-assignment/internal/domain/repository"
 )
 
 type StatsService struct {
-    prRepo repository.PullRequestRepository
+    prRepo   repository.PullRequestRepository
+    userRepo repository.UserRepository
+    teamRepo repository.TeamRepository
 }
 
-func NewStatsService(prRepo repository.PullRequestRepository) *StatsService {
-    return &StatsService{prRepo: prRepo}
+func NewStatsService(
+    prRepo repository.PullRequestRepository,
+    userRepo repository.UserRepository,
+    teamRepo repository.TeamRepository,
+) *StatsService {
+    return &StatsService{prRepo: prRepo, userRepo: userRepo, teamRepo: teamRepo}
 }
 
 type UserAssignmentStat struct {
-    UserID      string `json:"user_id"`
-    AssignedPRs int    `json:"assigned_prs"`
+    UserID      string   `json:"user_id"`
+    Teams       []string `json:"teams"`
+    AssignedPRs int      `json:"assigned_prs"`
+    OpenPRs     int      `json:"open_prs"`
+    // Capacity is the effective limit of OPEN reviews, 0 means unlimited
+    Capacity   int  `json:"capacity"`
+    AtCapacity bool `json:"at_capacity"`
+}
+
+// TeamAssignmentStat sums assignments of the team members. The Subtree counters also take
+// the members of all teams below, a user in several of them is counted once
+type TeamAssignmentStat struct {
+    TeamName           string `json:"team_name"`
+    ParentName         string `json:"parent_name"`
+    AssignedPRs        int    `json:"assigned_prs"`
+    OpenPRs            int    `json:"open_prs"`
+    SubtreeAssignedPRs int    `json:"subtree_assigned_prs"`
+    SubtreeOpenPRs     int    `json:"subtree_open_prs"`
 }
 
 type PRReviewerStat struct {
@@ -26,6 +50,7 @@ type PRReviewerStat struct {
     Reviewers     []string `json:"reviewers"`
 }
 
+// GetUserAssignmentStats reports every user's assignments overall and the current OPEN load against capacity
 func (s *StatsService) GetUserAssignmentStats(ctx context.Context) ([]UserAssignmentStat, error) {
     prs, err := s.prRepo.GetAll(ctx)
     if err != nil {
@@ -39,12 +64,79 @@ func (s *StatsService) GetUserAssignmentStats(ctx context.Context) ([]UserAssign
         }
     }
 
-    stats := make([]UserAssignmentStat, 0, len(counts))
-    for userID, c := range counts {
+    users, err := s.userRepo.GetAllReviewLoads(ctx)
+    if err != nil {
+        return nil, fmt.Errorf("get review loads: %w", err)
+    }
+
+    stats := make([]UserAssignmentStat, 0, len(users))
+    for _, u := range users {
         stats = append(stats, UserAssignmentStat{
-            UserID:      userID,
-            AssignedPRs: c,
+            UserID:      u.ID,
+            Teams:       u.Teams,
+            AssignedPRs: counts[u.ID],
+            OpenPRs:     u.OpenReviews,
+            Capacity:    u.ReviewCapacity,
+            AtCapacity:  !u.HasReviewCapacity(),
         })
     }
     return stats, nil
 }
+
+// GetTeamAssignmentStats rolls the user stats up the team tree, teams are ordered by name
+func (s *StatsService) GetTeamAssignmentStats(ctx context.Context, users []UserAssignmentStat) ([]TeamAssignmentStat, error) {
+    parents, err := s.teamRepo.GetParents(ctx)
+    if err != nil {
+        return nil, fmt.Errorf("get team parents: %w", err)
+    }
+
+    children := make(map[string][]string, len(parents))
+    for team, parent := range parents {
+        if parent != "" {
+            children[parent] = append(children[parent], team)
+        }
+    }
+    members := make(map[string][]int, len(parents))
+    for i, u := range users {
+        for _, team := range u.Teams {
+            members[team] = append(members[team], i)
+        }
+    }
+
+    stats := make([]TeamAssignmentStat, 0, len(parents))
+    for team, parent := range parents {
+        stat := TeamAssignmentStat{TeamName: team, ParentName: parent}
+        for _, i := range members[team] {
+            stat.AssignedPRs += users[i].AssignedPRs
+            stat.OpenPRs += users[i].OpenPRs
+        }
+
+        counted := make(map[int]struct{})
+        visited := make(map[string]struct{})
+        queue := []string{team}
+        for len(queue) > 0 {
+            current := queue[0]
+            queue = queue[1:]
+            if _, ok := visited[current]; ok {
+                continue
+            }
+            visited[current] = struct{}{}
+            queue = append(queue, children[current]...)
+
+            for _, i := range members[current] {
+                if _, ok := counted[i]; ok {
+                    continue
+                }
+                counted[i] = struct{}{}
+                stat.SubtreeAssignedPRs += users[i].AssignedPRs
+                stat.SubtreeOpenPRs += users[i].OpenPRs
+            }
+        }
+        stats = append(stats, stat)
+    }
+
+    slices.SortFunc(stats, func(a, b TeamAssignmentStat) int {
+        return strings.Compare(a.TeamName, b.TeamName)
+    })
+    return stats, nil
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+    "context"
+    "testing"
+
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/service/mocks"
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestStatsService_GetTeamAssignmentStats(t *testing.T) {
+    ctx := context.Background()
+    users := []UserAssignmentStat{
+        {UserID: "u1", Teams: []string{"ios"}, AssignedPRs: 3, OpenPRs: 1},
+        {UserID: "u2", Teams: []string{"android", "ios"}, AssignedPRs: 2, OpenPRs: 2},
+        {UserID: "u3", Teams: []string{"web"}, AssignedPRs: 4},
+        {UserID: "u4", AssignedPRs: 1},
+    }
+
+    mockTeamRepo := mocks.NewTeamRepository(t)
+    mockTeamRepo.On("GetParents", ctx).Return(map[string]string{
+        "product": "",
+        "mobile":  "product",
+        "ios":     "mobile",
+        "android": "mobile",
+        "web":     "product",
+    }, nil)
+
+    svc := NewStatsService(nil, nil, mockTeamRepo)
+    stats, err := svc.GetTeamAssignmentStats(ctx, users)
+
+    require.NoError(t, err)
+    assert.Equal(t, []TeamAssignmentStat{
+        {TeamName: "android", ParentName: "mobile", AssignedPRs: 2, OpenPRs: 2, SubtreeAssignedPRs: 2, SubtreeOpenPRs: 2},
+        {TeamName: "ios", ParentName: "mobile", AssignedPRs: 5, OpenPRs: 3, SubtreeAssignedPRs: 5, SubtreeOpenPRs: 3},
+        {TeamName: "mobile", ParentName: "product", SubtreeAssignedPRs: 5, SubtreeOpenPRs: 3},
+        {TeamName: "product", SubtreeAssignedPRs: 9, SubtreeOpenPRs: 3},
+        {TeamName: "web", ParentName: "product", AssignedPRs: 4, SubtreeAssignedPRs: 4},
+    }, stats)
+}
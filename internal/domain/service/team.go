@@ -6,6 +6,7 @@ import (
     "github.com/kimvlry/avito-internship-assignment/internal/domain"
     "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
     "github.com/kimvlry/avito-internship-assignment/internal/domain/repository"
+    "slices"
 )
 
 type Team struct {
@@ -24,9 +25,36 @@ func NewTeam(teamRepo repository.TeamRepository, userRepo repository.UserReposit
 }
 
 func (s *Team) CreateTeam(ctx context.Context, team *entity.Team, members []entity.User) (*entity.Team, error) {
-    userIDs := make([]string, len(members))
-    for i, member := range members {
-        userIDs[i] = member.ID
+    if team.ReviewerStrategy == "" {
+        team.ReviewerStrategy = entity.ReviewerStrategyRandom
+    }
+    if !team.ReviewerStrategy.IsValid() {
+        return nil, domain.ErrInvalidReviewerStrategy
+    }
+    if team.MaxReviewers == 0 {
+        team.MaxReviewers = entity.DefaultMaxReviewers
+    }
+    if !team.HasValidReviewerLimits() {
+        return nil, domain.ErrInvalidReviewerLimits
+    }
+    if team.DefaultMaxOpenReviews < 0 {
+        return nil, domain.ErrInvalidReviewCapacity
+    }
+    if team.MergePolicy.RequiredApprovals < 0 {
+        return nil, domain.ErrInvalidMergePolicy
+    }
+    if team.ReviewSLA.Escalation == "" {
+        team.ReviewSLA.Escalation = entity.EscalationReassign
+    }
+    if team.ReviewSLA.WorkdayEnd == 0 {
+        team.ReviewSLA.WorkdayStart = entity.DefaultWorkdayStart
+        team.ReviewSLA.WorkdayEnd = entity.DefaultWorkdayEnd
+    }
+    if team.ReviewSLA.TimeZone == "" {
+        team.ReviewSLA.TimeZone = entity.DefaultTimeZone
+    }
+    if team.ReviewSLA.Hours < 0 || !team.ReviewSLA.Escalation.IsValid() || !team.ReviewSLA.HasValidWorkday() {
+        return nil, domain.ErrInvalidReviewSLA
     }
 
     err := s.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
@@ -38,32 +66,26 @@ func (s *Team) CreateTeam(ctx context.Context, team *entity.Team, members []enti
             return domain.ErrTeamAlreadyExists
         }
 
+        if err := s.checkFallbackTeams(txCtx, team); err != nil {
+            return err
+        }
+        if err := s.checkParentTeam(txCtx, team); err != nil {
+            return err
+        }
+
         if err := s.teamRepository.Create(txCtx, team); err != nil {
             return fmt.Errorf("create team: %w", err)
         }
-
-        if err := s.userRepository.CheckUsersAvailableForTeam(txCtx, userIDs, team.Name); err != nil {
+        if err := s.putMembers(txCtx, team.Name, members); err != nil {
             return err
         }
 
-        for i := range members {
-            member := &members[i]
-            member.TeamName = team.Name
-
-            exists, err := s.userRepository.Exists(txCtx, member.ID)
-            if err != nil {
-                return fmt.Errorf("check user exists: %w", err)
-            }
-
-            if exists {
-                if err := s.userRepository.Update(txCtx, member); err != nil {
-                    return fmt.Errorf("update user: %w", err)
-                }
-            } else {
-                if err := s.userRepository.Create(txCtx, member); err != nil {
-                    return fmt.Errorf("create user: %w", err)
-                }
-            }
+        // the lead references users, so it is set once the members exist
+        if team.LeadID == "" {
+            return nil
+        }
+        if err := s.teamRepository.SetLead(txCtx, team.Name, team.LeadID); err != nil {
+            return fmt.Errorf("set team lead: %w", err)
         }
         return nil
     })
@@ -80,15 +102,398 @@ func (s *Team) CreateTeam(ctx context.Context, team *entity.Team, members []enti
     return team, nil
 }
 
+// GetTeamWithMembers returns the team with its ancestors and children filled
 func (s *Team) GetTeamWithMembers(ctx context.Context, teamName string) (*entity.Team, []entity.User, error) {
     team, err := s.teamRepository.GetByName(ctx, teamName)
     if err != nil {
         return nil, nil, fmt.Errorf("get team: %w", err)
     }
 
+    team.Ancestors, err = s.teamRepository.GetAncestors(ctx, teamName)
+    if err != nil {
+        return nil, nil, fmt.Errorf("get ancestors: %w", err)
+    }
+    team.Children, err = s.teamRepository.GetChildren(ctx, teamName)
+    if err != nil {
+        return nil, nil, fmt.Errorf("get children: %w", err)
+    }
+
     members, err := s.userRepository.GetByTeam(ctx, teamName)
     if err != nil {
         return nil, nil, fmt.Errorf("get users: %w", err)
     }
     return team, members, nil
 }
+
+// AddMembers adds existing users to the team keeping their other teams and creates the new ones.
+// Nobody leaves a team here, so OPEN reviews do not block it the way they block RemoveMember
+func (s *Team) AddMembers(ctx context.Context, teamName string, members []entity.User) (*entity.Team, error) {
+    var updatedTeam *entity.Team
+    err := s.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+        team, err := s.teamRepository.GetByName(txCtx, teamName)
+        if err != nil {
+            return fmt.Errorf("get team: %w", err)
+        }
+
+        if err := s.putMembers(txCtx, teamName, members); err != nil {
+            return err
+        }
+
+        team.Members, err = s.userRepository.GetByTeam(txCtx, teamName)
+        if err != nil {
+            return fmt.Errorf("get users: %w", err)
+        }
+        updatedTeam = team
+        return nil
+    })
+
+    if err != nil {
+        return nil, err
+    }
+    return updatedTeam, nil
+}
+
+// RemoveMember takes the user out of the team, they keep reviewing for their other teams
+func (s *Team) RemoveMember(ctx context.Context, teamName, userID string) (*entity.Team, error) {
+    var updatedTeam *entity.Team
+    err := s.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+        team, err := s.teamRepository.GetByName(txCtx, teamName)
+        if err != nil {
+            return fmt.Errorf("get team: %w", err)
+        }
+
+        user, err := s.userRepository.GetByID(txCtx, userID)
+        if err != nil {
+            return fmt.Errorf("get user: %w", err)
+        }
+        if !user.InTeam(teamName) {
+            return domain.ErrUserNotInTeam
+        }
+
+        if err := s.userRepository.CheckUsersAvailableForTeam(txCtx, []string{userID}, teamName); err != nil {
+            return err
+        }
+
+        if err := s.userRepository.RemoveFromTeam(txCtx, userID, teamName); err != nil {
+            return fmt.Errorf("remove user from team: %w", err)
+        }
+
+        team.Members, err = s.userRepository.GetByTeam(txCtx, teamName)
+        if err != nil {
+            return fmt.Errorf("get users: %w", err)
+        }
+        updatedTeam = team
+        return nil
+    })
+
+    if err != nil {
+        return nil, err
+    }
+    return updatedTeam, nil
+}
+
+// RenameTeam renames the team keeping its members and settings
+func (s *Team) RenameTeam(ctx context.Context, teamName, newName string) (*entity.Team, error) {
+    var renamedTeam *entity.Team
+    err := s.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+        if err := s.teamRepository.Rename(txCtx, teamName, newName); err != nil {
+            return fmt.Errorf("rename team: %w", err)
+        }
+
+        team, err := s.teamRepository.GetByName(txCtx, newName)
+        if err != nil {
+            return fmt.Errorf("get team: %w", err)
+        }
+        team.Members, err = s.userRepository.GetByTeam(txCtx, newName)
+        if err != nil {
+            return fmt.Errorf("get users: %w", err)
+        }
+        renamedTeam = team
+        return nil
+    })
+
+    if err != nil {
+        return nil, err
+    }
+    return renamedTeam, nil
+}
+
+// DeleteTeam removes the team. Members are moved to moveMembersTo first, keeping their
+// other teams, an empty moveMembersTo is only accepted for a team without members.
+// Members leave the team, so the move is blocked by OPEN reviews the same way RemoveMember is.
+// Returns the moved members
+func (s *Team) DeleteTeam(ctx context.Context, teamName, moveMembersTo string) ([]entity.User, error) {
+    if teamName == moveMembersTo {
+        return nil, fmt.Errorf("%w: members cannot be moved to the deleted team", domain.ErrTeamNotEmpty)
+    }
+
+    var moved []entity.User
+    err := s.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+        exists, err := s.teamRepository.Exists(txCtx, teamName)
+        if err != nil {
+            return fmt.Errorf("check team exists: %w", err)
+        }
+        if !exists {
+            return domain.ErrTeamNotFound
+        }
+
+        members, err := s.userRepository.GetByTeam(txCtx, teamName)
+        if err != nil {
+            return fmt.Errorf("get users: %w", err)
+        }
+        if len(members) > 0 {
+            if moveMembersTo == "" {
+                return domain.ErrTeamNotEmpty
+            }
+            exists, err := s.teamRepository.Exists(txCtx, moveMembersTo)
+            if err != nil {
+                return fmt.Errorf("check target team exists: %w", err)
+            }
+            if !exists {
+                return fmt.Errorf("%w: target team %s", domain.ErrTeamNotFound, moveMembersTo)
+            }
+
+            memberIDs := make([]string, 0, len(members))
+            for _, member := range members {
+                memberIDs = append(memberIDs, member.ID)
+            }
+            if err := s.userRepository.CheckUsersAvailableForTeam(txCtx, memberIDs, teamName); err != nil {
+                return err
+            }
+
+            if err := s.userRepository.MoveTeamMembers(txCtx, teamName, moveMembersTo); err != nil {
+                return fmt.Errorf("move members: %w", err)
+            }
+        }
+
+        if err := s.teamRepository.Delete(txCtx, teamName); err != nil {
+            return fmt.Errorf("delete team: %w", err)
+        }
+        moved = members
+        return nil
+    })
+
+    if err != nil {
+        return nil, err
+    }
+    return moved, nil
+}
+
+// putMembers creates the members that don't exist yet and adds the others to the team,
+// their other team memberships stay as they are
+func (s *Team) putMembers(ctx context.Context, teamName string, members []entity.User) error {
+    for i := range members {
+        member := &members[i]
+
+        exists, err := s.userRepository.Exists(ctx, member.ID)
+        if err != nil {
+            return fmt.Errorf("check user exists: %w", err)
+        }
+
+        if exists {
+            if err := s.userRepository.Update(ctx, member); err != nil {
+                return fmt.Errorf("update user: %w", err)
+            }
+            if err := s.userRepository.AddToTeam(ctx, member.ID, teamName); err != nil {
+                return fmt.Errorf("add user to team: %w", err)
+            }
+        } else {
+            member.TeamName = teamName
+            if err := s.userRepository.Create(ctx, member); err != nil {
+                return fmt.Errorf("create user: %w", err)
+            }
+        }
+    }
+    return nil
+}
+
+// ListTeams returns up to limit teams after the given name and the name to continue from,
+// empty on the last page
+func (s *Team) ListTeams(ctx context.Context, afterName string, limit int) ([]repository.TeamSummary, string, error) {
+    teams, err := s.teamRepository.List(ctx, afterName, limit+1)
+    if err != nil {
+        return nil, "", fmt.Errorf("list teams: %w", err)
+    }
+    if len(teams) <= limit {
+        return teams, "", nil
+    }
+
+    teams = teams[:limit]
+    return teams, teams[len(teams)-1].Name, nil
+}
+
+// TeamUpdate holds team settings to change, nil fields are left as is
+type TeamUpdate struct {
+    ReviewerStrategy *entity.ReviewerStrategy
+    MinReviewers     *int
+    MaxReviewers     *int
+    FallbackTeams    *[]string
+    // DefaultMaxOpenReviews of 0 removes the team default limit
+    DefaultMaxOpenReviews *int
+    // RequiredApprovals of 0 lets PRs merge without approvals
+    RequiredApprovals       *int
+    BlockOnChangesRequested *bool
+    // ReviewSLAHours of 0 leaves new assignments without a deadline
+    ReviewSLAHours         *int
+    ReviewSLABusinessHours *bool
+    ReviewSLAWorkdayStart  *int
+    ReviewSLAWorkdayEnd    *int
+    ReviewSLATimeZone      *string
+    EscalationPolicy       *entity.EscalationPolicy
+    // LeadID of "" removes the team lead
+    LeadID *string
+    // ParentName of "" makes the team a root of the tree
+    ParentName *string
+}
+
+func (s *Team) UpdateTeam(ctx context.Context, teamName string, update TeamUpdate) (*entity.Team, error) {
+    var updatedTeam *entity.Team
+    err := s.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+        team, err := s.teamRepository.GetByName(txCtx, teamName)
+        if err != nil {
+            return fmt.Errorf("get team: %w", err)
+        }
+
+        if update.ReviewerStrategy != nil {
+            if !update.ReviewerStrategy.IsValid() {
+                return domain.ErrInvalidReviewerStrategy
+            }
+            team.ReviewerStrategy = *update.ReviewerStrategy
+        }
+        if update.MinReviewers != nil {
+            team.MinReviewers = *update.MinReviewers
+        }
+        if update.MaxReviewers != nil {
+            team.MaxReviewers = *update.MaxReviewers
+        }
+        if !team.HasValidReviewerLimits() {
+            return domain.ErrInvalidReviewerLimits
+        }
+        if update.DefaultMaxOpenReviews != nil {
+            if *update.DefaultMaxOpenReviews < 0 {
+                return domain.ErrInvalidReviewCapacity
+            }
+            team.DefaultMaxOpenReviews = *update.DefaultMaxOpenReviews
+        }
+        if update.RequiredApprovals != nil {
+            if *update.RequiredApprovals < 0 {
+                return domain.ErrInvalidMergePolicy
+            }
+            team.MergePolicy.RequiredApprovals = *update.RequiredApprovals
+        }
+        if update.BlockOnChangesRequested != nil {
+            team.MergePolicy.BlockOnChangesRequested = *update.BlockOnChangesRequested
+        }
+        if update.ReviewSLAHours != nil {
+            if *update.ReviewSLAHours < 0 {
+                return domain.ErrInvalidReviewSLA
+            }
+            team.ReviewSLA.Hours = *update.ReviewSLAHours
+        }
+        if update.ReviewSLABusinessHours != nil {
+            team.ReviewSLA.BusinessHours = *update.ReviewSLABusinessHours
+        }
+        if update.ReviewSLAWorkdayStart != nil || update.ReviewSLAWorkdayEnd != nil || update.ReviewSLATimeZone != nil {
+            if update.ReviewSLAWorkdayStart != nil {
+                team.ReviewSLA.WorkdayStart = *update.ReviewSLAWorkdayStart
+            }
+            if update.ReviewSLAWorkdayEnd != nil {
+                team.ReviewSLA.WorkdayEnd = *update.ReviewSLAWorkdayEnd
+            }
+            if update.ReviewSLATimeZone != nil {
+                team.ReviewSLA.TimeZone = *update.ReviewSLATimeZone
+            }
+            if !team.ReviewSLA.HasValidWorkday() {
+                return fmt.Errorf("%w: working hours %d-%d in %q", domain.ErrInvalidReviewSLA,
+                    team.ReviewSLA.WorkdayStart, team.ReviewSLA.WorkdayEnd, team.ReviewSLA.TimeZone)
+            }
+        }
+        if update.EscalationPolicy != nil {
+            if !update.EscalationPolicy.IsValid() {
+                return fmt.Errorf("%w: unknown escalation policy %s", domain.ErrInvalidReviewSLA, *update.EscalationPolicy)
+            }
+            team.ReviewSLA.Escalation = *update.EscalationPolicy
+        }
+        if update.LeadID != nil {
+            team.LeadID = *update.LeadID
+        }
+        if update.FallbackTeams != nil {
+            team.FallbackTeams = *update.FallbackTeams
+            if err := s.checkFallbackTeams(txCtx, team); err != nil {
+                return err
+            }
+        }
+        if update.ParentName != nil {
+            team.ParentName = *update.ParentName
+            if err := s.checkParentTeam(txCtx, team); err != nil {
+                return err
+            }
+        }
+
+        if err := s.teamRepository.Update(txCtx, team); err != nil {
+            return fmt.Errorf("update team: %w", err)
+        }
+
+        members, err := s.userRepository.GetByTeam(txCtx, teamName)
+        if err != nil {
+            return fmt.Errorf("get users: %w", err)
+        }
+        team.Members = members
+        updatedTeam = team
+        return nil
+    })
+
+    if err != nil {
+        return nil, err
+    }
+    return updatedTeam, nil
+}
+
+// checkParentTeam makes sure the parent exists and the team does not end up below itself
+func (s *Team) checkParentTeam(ctx context.Context, team *entity.Team) error {
+    if team.ParentName == "" {
+        return nil
+    }
+    if team.ParentName == team.Name {
+        return fmt.Errorf("%w: team %s cannot be its own parent", domain.ErrInvalidParentTeam, team.Name)
+    }
+
+    exists, err := s.teamRepository.Exists(ctx, team.ParentName)
+    if err != nil {
+        return fmt.Errorf("check parent team exists: %w", err)
+    }
+    if !exists {
+        return fmt.Errorf("%w: parent team %s", domain.ErrTeamNotFound, team.ParentName)
+    }
+
+    ancestors, err := s.teamRepository.GetAncestors(ctx, team.ParentName)
+    if err != nil {
+        return fmt.Errorf("get parent team ancestors: %w", err)
+    }
+    if slices.Contains(ancestors, team.Name) {
+        return fmt.Errorf("%w: team %s is above %s", domain.ErrInvalidParentTeam, team.Name, team.ParentName)
+    }
+    return nil
+}
+
+func (s *Team) checkFallbackTeams(ctx context.Context, team *entity.Team) error {
+    seen := make(map[string]struct{}, len(team.FallbackTeams))
+    for _, name := range team.FallbackTeams {
+        if name == team.Name {
+            return fmt.Errorf("%w: team %s cannot fall back to itself", domain.ErrInvalidFallbackTeams, name)
+        }
+        if _, ok := seen[name]; ok {
+            return fmt.Errorf("%w: team %s listed twice", domain.ErrInvalidFallbackTeams, name)
+        }
+        seen[name] = struct{}{}
+
+        exists, err := s.teamRepository.Exists(ctx, name)
+        if err != nil {
+            return fmt.Errorf("check fallback team exists: %w", err)
+        }
+        if !exists {
+            return fmt.Errorf("%w: fallback team %s", domain.ErrTeamNotFound, name)
+        }
+    }
+    return nil
+}
@@ -6,6 +6,7 @@ import (
 
     "github.com/kimvlry/avito-internship-assignment/internal/domain"
     "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/repository"
     "github.com/kimvlry/avito-internship-assignment/internal/domain/service/mocks"
     "github.com/stretchr/testify/assert"
     "github.com/stretchr/testify/mock"
@@ -33,6 +34,16 @@ func TestTeamService_CreateTeam(t *testing.T) {
             mockUsersExist: map[string]bool{"u1": false, "u2": false},
             expectError:    false,
         },
+        {
+            name: "создание команды с тимлидом из новых участников",
+            team: &entity.Team{Name: "backend", LeadID: "u1"},
+            members: []entity.User{
+                {ID: "u1", Username: "Alice", IsActive: true},
+            },
+            mockTeamExists: false,
+            mockUsersExist: map[string]bool{"u1": false},
+            expectError:    false,
+        },
         {
             name: "ошибка: команда уже существует",
             team: &entity.Team{Name: "backend"},
@@ -73,6 +84,7 @@ func TestTeamService_CreateTeam(t *testing.T) {
                         mockUserRepo.On("Update", mock.Anything, mock.MatchedBy(func(u *entity.User) bool { return u.ID == user.ID })).Return(nil).Run(func(args mock.Arguments) {
                             updateCalls[user.ID]++
                         })
+                        mockUserRepo.On("AddToTeam", mock.Anything, user.ID, tt.team.Name).Return(nil)
                     } else {
                         mockUserRepo.On("Exists", mock.Anything, user.ID).Return(false, nil)
                         mockUserRepo.On("Create", mock.Anything, mock.MatchedBy(func(u *entity.User) bool { return u.ID == user.ID })).Return(nil).Run(func(args mock.Arguments) {
@@ -81,12 +93,12 @@ func TestTeamService_CreateTeam(t *testing.T) {
                     }
                 }
                 mockUserRepo.On("GetByTeam", mock.Anything, tt.team.Name).Return([]entity.User{}, nil)
-
-                userIDs := make([]string, len(tt.members))
-                for i, u := range tt.members {
-                    userIDs[i] = u.ID
+                if tt.team.LeadID != "" {
+                    mockTeamRepo.On("SetLead", mock.Anything, tt.team.Name, tt.team.LeadID).Return(nil).
+                        Run(func(args mock.Arguments) {
+                            assert.Equal(t, 1, createCalls[tt.team.LeadID], "тимлид назначается после создания участников")
+                        })
                 }
-                mockUserRepo.On("CheckUsersAvailableForTeam", mock.Anything, userIDs, tt.team.Name).Return(nil)
             }
 
             svc := NewTeam(mockTeamRepo, mockUserRepo, mockTx)
@@ -155,6 +167,8 @@ func TestTeamService_GetTeamWithMembers(t *testing.T) {
 
             if tt.mockTeam != nil {
                 mockTeamRepo.On("GetByName", mock.Anything, tt.teamName).Return(tt.mockTeam, nil)
+                mockTeamRepo.On("GetAncestors", mock.Anything, tt.teamName).Return([]string{"tribe", "department"}, nil)
+                mockTeamRepo.On("GetChildren", mock.Anything, tt.teamName).Return([]string{"backend-api"}, nil)
                 mockUserRepo.On("GetByTeam", mock.Anything, tt.teamName).Return(tt.mockMembers, nil)
             } else {
                 mockTeamRepo.On("GetByName", mock.Anything, tt.teamName).Return(nil, tt.mockError)
@@ -172,7 +186,530 @@ func TestTeamService_GetTeamWithMembers(t *testing.T) {
             require.NoError(t, err)
             require.NotNil(t, team)
             assert.Equal(t, tt.teamName, team.Name)
+            assert.Equal(t, []string{"tribe", "department"}, team.Ancestors)
+            assert.Equal(t, []string{"backend-api"}, team.Children)
             assert.Len(t, members, len(tt.mockMembers))
         })
     }
 }
+
+func TestTeamService_UpdateTeam(t *testing.T) {
+    leastLoaded := entity.ReviewerStrategyLeastLoaded
+    unknown := entity.ReviewerStrategy("by_horoscope")
+    one, three, negative := 1, 3, -1
+    moscow, mars := "Europe/Moscow", "Mars/Olympus"
+
+    tests := []struct {
+        name            string
+        update          TeamUpdate
+        expectError     bool
+        expectedErrType error
+    }{
+        {
+            name:   "успешная смена стратегии",
+            update: TeamUpdate{ReviewerStrategy: &leastLoaded},
+        },
+        {
+            name: "ошибка: минимум больше максимума",
+            update: TeamUpdate{
+                ReviewerStrategy: &leastLoaded,
+                MinReviewers:     &three,
+                MaxReviewers:     &one,
+            },
+            expectError:     true,
+            expectedErrType: domain.ErrInvalidReviewerLimits,
+        },
+        {
+            name:            "ошибка: неизвестная стратегия",
+            update:          TeamUpdate{ReviewerStrategy: &unknown},
+            expectError:     true,
+            expectedErrType: domain.ErrInvalidReviewerStrategy,
+        },
+        {
+            name: "ошибка: отрицательное число approve",
+            update: TeamUpdate{
+                ReviewerStrategy:  &leastLoaded,
+                RequiredApprovals: &negative,
+            },
+            expectError:     true,
+            expectedErrType: domain.ErrInvalidMergePolicy,
+        },
+        {
+            name:   "рабочие часы в другом часовом поясе",
+            update: TeamUpdate{ReviewerStrategy: &leastLoaded, ReviewSLATimeZone: &moscow},
+        },
+        {
+            name:            "ошибка: неизвестный часовой пояс",
+            update:          TeamUpdate{ReviewerStrategy: &leastLoaded, ReviewSLATimeZone: &mars},
+            expectError:     true,
+            expectedErrType: domain.ErrInvalidReviewSLA,
+        },
+        {
+            name:            "ошибка: рабочий день заканчивается до начала",
+            update:          TeamUpdate{ReviewerStrategy: &leastLoaded, ReviewSLAWorkdayEnd: &one},
+            expectError:     true,
+            expectedErrType: domain.ErrInvalidReviewSLA,
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            ctx := context.Background()
+
+            mockTeamRepo := mocks.NewTeamRepository(t)
+            mockUserRepo := mocks.NewUserRepository(t)
+            mockTx := mocks.NewTransactor(t)
+
+            mockTx.On(
+                "WithinTransaction",
+                mock.Anything,
+                mock.AnythingOfType("func(context.Context) error"),
+            ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+                return fn(ctx)
+            })
+            mockTeamRepo.On("GetByName", mock.Anything, "backend").Return(&entity.Team{
+                Name:             "backend",
+                ReviewerStrategy: entity.ReviewerStrategyRandom,
+                MinReviewers:     entity.DefaultMinReviewers,
+                MaxReviewers:     entity.DefaultMaxReviewers,
+                ReviewSLA: entity.ReviewSLA{
+                    WorkdayStart: entity.DefaultWorkdayStart,
+                    WorkdayEnd:   entity.DefaultWorkdayEnd,
+                    TimeZone:     entity.DefaultTimeZone,
+                },
+            }, nil)
+            if !tt.expectError {
+                mockTeamRepo.On("Update", mock.Anything, mock.AnythingOfType("*entity.Team")).Return(nil)
+                mockUserRepo.On("GetByTeam", mock.Anything, "backend").Return([]entity.User{}, nil)
+            }
+
+            svc := NewTeam(mockTeamRepo, mockUserRepo, mockTx)
+            team, err := svc.UpdateTeam(ctx, "backend", tt.update)
+
+            if tt.expectError {
+                require.Error(t, err)
+                assert.ErrorIs(t, err, tt.expectedErrType)
+                return
+            }
+
+            require.NoError(t, err)
+            assert.Equal(t, *tt.update.ReviewerStrategy, team.ReviewerStrategy)
+        })
+    }
+}
+
+func TestTeamService_UpdateTeamParent(t *testing.T) {
+    tests := []struct {
+        name            string
+        parent          string
+        parentExists    bool
+        ancestors       []string
+        expectedErrType error
+    }{
+        {
+            name:         "команда входит в трайб",
+            parent:       "tribe",
+            parentExists: true,
+            ancestors:    []string{"department"},
+        },
+        {
+            name: "команда становится корнем",
+        },
+        {
+            name:            "ошибка: команда - родитель самой себя",
+            parent:          "backend",
+            expectedErrType: domain.ErrInvalidParentTeam,
+        },
+        {
+            name:            "ошибка: родитель ниже команды в дереве",
+            parent:          "backend-api",
+            parentExists:    true,
+            ancestors:       []string{"backend", "tribe"},
+            expectedErrType: domain.ErrInvalidParentTeam,
+        },
+        {
+            name:            "ошибка: родитель не найден",
+            parent:          "ghost",
+            expectedErrType: domain.ErrTeamNotFound,
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            ctx := context.Background()
+
+            mockTeamRepo := mocks.NewTeamRepository(t)
+            mockUserRepo := mocks.NewUserRepository(t)
+            mockTx := mocks.NewTransactor(t)
+
+            mockTx.On(
+                "WithinTransaction",
+                mock.Anything,
+                mock.AnythingOfType("func(context.Context) error"),
+            ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+                return fn(ctx)
+            })
+            mockTeamRepo.On("GetByName", ctx, "backend").Return(&entity.Team{
+                Name:         "backend",
+                MaxReviewers: entity.DefaultMaxReviewers,
+                ParentName:   "department",
+            }, nil)
+            if tt.parent != "" && tt.parent != "backend" {
+                mockTeamRepo.On("Exists", ctx, tt.parent).Return(tt.parentExists, nil)
+            }
+            if tt.parentExists {
+                mockTeamRepo.On("GetAncestors", ctx, tt.parent).Return(tt.ancestors, nil)
+            }
+            if tt.expectedErrType == nil {
+                mockTeamRepo.On("Update", ctx, mock.MatchedBy(func(team *entity.Team) bool {
+                    return team.ParentName == tt.parent
+                })).Return(nil)
+                mockUserRepo.On("GetByTeam", ctx, "backend").Return([]entity.User{}, nil)
+            }
+
+            svc := NewTeam(mockTeamRepo, mockUserRepo, mockTx)
+            team, err := svc.UpdateTeam(ctx, "backend", TeamUpdate{ParentName: &tt.parent})
+
+            if tt.expectedErrType != nil {
+                require.Error(t, err)
+                assert.ErrorIs(t, err, tt.expectedErrType)
+                return
+            }
+
+            require.NoError(t, err)
+            assert.Equal(t, tt.parent, team.ParentName)
+        })
+    }
+}
+
+func TestTeamService_AddMembers(t *testing.T) {
+    tests := []struct {
+        name            string
+        addErr          error
+        expectedErrType error
+    }{
+        {
+            name: "новый пользователь и участник другой команды",
+        },
+        {
+            name:            "ошибка: команда удалена в параллельной транзакции",
+            addErr:          domain.ErrTeamNotFound,
+            expectedErrType: domain.ErrTeamNotFound,
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            ctx := context.Background()
+            members := []entity.User{
+                {ID: "u1", Username: "Alice", IsActive: true},
+                {ID: "u2", Username: "Bob", IsActive: true},
+            }
+
+            mockTeamRepo := mocks.NewTeamRepository(t)
+            mockUserRepo := mocks.NewUserRepository(t)
+            mockTx := mocks.NewTransactor(t)
+
+            mockTx.On(
+                "WithinTransaction",
+                mock.Anything,
+                mock.AnythingOfType("func(context.Context) error"),
+            ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+                return fn(ctx)
+            })
+            mockTeamRepo.On("GetByName", ctx, "backend").Return(&entity.Team{Name: "backend"}, nil)
+            mockUserRepo.On("Exists", ctx, "u1").Return(false, nil)
+            mockUserRepo.On("Create", ctx, mock.MatchedBy(func(u *entity.User) bool {
+                return u.ID == "u1" && u.TeamName == "backend"
+            })).Return(nil)
+            mockUserRepo.On("Exists", ctx, "u2").Return(true, nil)
+            // the existing user keeps their primary team, the membership is added separately
+            mockUserRepo.On("Update", ctx, mock.MatchedBy(func(u *entity.User) bool {
+                return u.ID == "u2" && u.TeamName == ""
+            })).Return(nil)
+            mockUserRepo.On("AddToTeam", ctx, "u2", "backend").Return(tt.addErr)
+            if tt.addErr == nil {
+                mockUserRepo.On("GetByTeam", ctx, "backend").Return(members, nil)
+            }
+
+            svc := NewTeam(mockTeamRepo, mockUserRepo, mockTx)
+            team, err := svc.AddMembers(ctx, "backend", members)
+
+            if tt.expectedErrType != nil {
+                require.Error(t, err)
+                assert.ErrorIs(t, err, tt.expectedErrType)
+                return
+            }
+
+            require.NoError(t, err)
+            assert.Len(t, team.Members, 2)
+            // u2 keeps their other team and its reviews, adding a team is never blocked
+            mockUserRepo.AssertNotCalled(t, "CheckUsersAvailableForTeam", mock.Anything, mock.Anything, mock.Anything)
+        })
+    }
+}
+
+func TestTeamService_RemoveMember(t *testing.T) {
+    tests := []struct {
+        name            string
+        user            *entity.User
+        checkErr        error
+        expectedErrType error
+    }{
+        {
+            name: "пользователь исключён",
+            user: &entity.User{ID: "u1", Username: "Alice", TeamName: "backend", Teams: []string{"backend"}, IsActive: true},
+        },
+        {
+            name: "пользователь исключён из неосновной команды",
+            user: &entity.User{ID: "u1", Username: "Alice", TeamName: "frontend", Teams: []string{"frontend", "backend"}, IsActive: true},
+        },
+        {
+            name:            "ошибка: пользователь из другой команды",
+            user:            &entity.User{ID: "u1", Username: "Alice", TeamName: "frontend", Teams: []string{"frontend"}, IsActive: true},
+            expectedErrType: domain.ErrUserNotInTeam,
+        },
+        {
+            name: "ошибка: у пользователя OPEN ревью",
+            user: &entity.User{ID: "u1", Username: "Alice", TeamName: "backend", Teams: []string{"backend"}, IsActive: true},
+            checkErr: &domain.TeamChangeBlockedError{
+                Reviews: []domain.BlockingReview{{UserID: "u1", PullRequestID: "pr-1"}},
+            },
+            expectedErrType: domain.ErrUserHasActiveAssignments,
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            ctx := context.Background()
+
+            mockTeamRepo := mocks.NewTeamRepository(t)
+            mockUserRepo := mocks.NewUserRepository(t)
+            mockTx := mocks.NewTransactor(t)
+
+            mockTx.On(
+                "WithinTransaction",
+                mock.Anything,
+                mock.AnythingOfType("func(context.Context) error"),
+            ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+                return fn(ctx)
+            })
+            mockTeamRepo.On("GetByName", ctx, "backend").Return(&entity.Team{Name: "backend"}, nil)
+            mockUserRepo.On("GetByID", ctx, "u1").Return(tt.user, nil)
+            if tt.user.InTeam("backend") {
+                mockUserRepo.On("CheckUsersAvailableForTeam", ctx, []string{"u1"}, "backend").Return(tt.checkErr)
+            }
+            if tt.expectedErrType == nil {
+                mockUserRepo.On("RemoveFromTeam", ctx, "u1", "backend").Return(nil)
+                mockUserRepo.On("GetByTeam", ctx, "backend").Return([]entity.User{}, nil)
+            }
+
+            svc := NewTeam(mockTeamRepo, mockUserRepo, mockTx)
+            team, err := svc.RemoveMember(ctx, "backend", "u1")
+
+            if tt.expectedErrType != nil {
+                require.Error(t, err)
+                assert.ErrorIs(t, err, tt.expectedErrType)
+                return
+            }
+
+            require.NoError(t, err)
+            assert.Empty(t, team.Members)
+        })
+    }
+}
+
+func TestTeamService_RenameTeam(t *testing.T) {
+    tests := []struct {
+        name            string
+        renameErr       error
+        expectedErrType error
+    }{
+        {
+            name: "успешное переименование",
+        },
+        {
+            name:            "ошибка: имя занято",
+            renameErr:       domain.ErrTeamAlreadyExists,
+            expectedErrType: domain.ErrTeamAlreadyExists,
+        },
+        {
+            name:            "ошибка: команда не найдена",
+            renameErr:       domain.ErrTeamNotFound,
+            expectedErrType: domain.ErrTeamNotFound,
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            ctx := context.Background()
+
+            mockTeamRepo := mocks.NewTeamRepository(t)
+            mockUserRepo := mocks.NewUserRepository(t)
+            mockTx := mocks.NewTransactor(t)
+
+            mockTx.On(
+                "WithinTransaction",
+                mock.Anything,
+                mock.AnythingOfType("func(context.Context) error"),
+            ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+                return fn(ctx)
+            })
+            mockTeamRepo.On("Rename", ctx, "backend", "core").Return(tt.renameErr)
+            if tt.renameErr == nil {
+                mockTeamRepo.On("GetByName", ctx, "core").Return(&entity.Team{Name: "core"}, nil)
+                mockUserRepo.On("GetByTeam", ctx, "core").Return([]entity.User{
+                    {ID: "u1", TeamName: "core"},
+                }, nil)
+            }
+
+            svc := NewTeam(mockTeamRepo, mockUserRepo, mockTx)
+            team, err := svc.RenameTeam(ctx, "backend", "core")
+
+            if tt.expectedErrType != nil {
+                require.Error(t, err)
+                assert.ErrorIs(t, err, tt.expectedErrType)
+                return
+            }
+
+            require.NoError(t, err)
+            assert.Equal(t, "core", team.Name)
+            assert.Len(t, team.Members, 1)
+        })
+    }
+}
+
+func TestTeamService_DeleteTeam(t *testing.T) {
+    members := []entity.User{
+        {ID: "u1", Username: "Alice", TeamName: "legacy", Teams: []string{"legacy"}, IsActive: true},
+    }
+
+    tests := []struct {
+        name            string
+        members         []entity.User
+        moveMembersTo   string
+        targetExists    bool
+        checkErr        error
+        moveErr         error
+        expectDelete    bool
+        expectedErrType error
+    }{
+        {
+            name:         "пустая команда",
+            expectDelete: true,
+        },
+        {
+            name:          "участники переводятся",
+            members:       members,
+            moveMembersTo: "backend",
+            targetExists:  true,
+            expectDelete:  true,
+        },
+        {
+            name:            "ошибка: участники без целевой команды",
+            members:         members,
+            expectedErrType: domain.ErrTeamNotEmpty,
+        },
+        {
+            name:            "ошибка: целевая команда не найдена",
+            members:         members,
+            moveMembersTo:   "ghost",
+            expectedErrType: domain.ErrTeamNotFound,
+        },
+        {
+            name:          "ошибка: у участника OPEN ревью",
+            members:       members,
+            moveMembersTo: "backend",
+            targetExists:  true,
+            checkErr: &domain.TeamChangeBlockedError{
+                Reviews: []domain.BlockingReview{{UserID: "u1", PullRequestID: "pr-1"}},
+            },
+            expectedErrType: domain.ErrUserHasActiveAssignments,
+        },
+        {
+            name:            "ошибка: целевая команда удалена в параллельной транзакции",
+            members:         members,
+            moveMembersTo:   "backend",
+            targetExists:    true,
+            moveErr:         domain.ErrTeamNotFound,
+            expectedErrType: domain.ErrTeamNotFound,
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            ctx := context.Background()
+
+            mockTeamRepo := mocks.NewTeamRepository(t)
+            mockUserRepo := mocks.NewUserRepository(t)
+            mockTx := mocks.NewTransactor(t)
+
+            mockTx.On(
+                "WithinTransaction",
+                mock.Anything,
+                mock.AnythingOfType("func(context.Context) error"),
+            ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+                return fn(ctx)
+            })
+            mockTeamRepo.On("Exists", ctx, "legacy").Return(true, nil)
+            mockUserRepo.On("GetByTeam", ctx, "legacy").Return(tt.members, nil)
+            if tt.moveMembersTo != "" {
+                mockTeamRepo.On("Exists", ctx, tt.moveMembersTo).Return(tt.targetExists, nil)
+            }
+            if tt.targetExists {
+                mockUserRepo.On("CheckUsersAvailableForTeam", ctx, []string{"u1"}, "legacy").Return(tt.checkErr)
+            }
+            if tt.targetExists && tt.checkErr == nil {
+                mockUserRepo.On("MoveTeamMembers", ctx, "legacy", tt.moveMembersTo).Return(tt.moveErr)
+            }
+            if tt.expectDelete {
+                mockTeamRepo.On("Delete", ctx, "legacy").Return(nil)
+            }
+
+            svc := NewTeam(mockTeamRepo, mockUserRepo, mockTx)
+            moved, err := svc.DeleteTeam(ctx, "legacy", tt.moveMembersTo)
+
+            if tt.expectedErrType != nil {
+                require.Error(t, err)
+                assert.ErrorIs(t, err, tt.expectedErrType)
+                return
+            }
+
+            require.NoError(t, err)
+            assert.Len(t, moved, len(tt.members))
+        })
+    }
+}
+
+func TestTeamService_ListTeams(t *testing.T) {
+    ctx := context.Background()
+    page := []repository.TeamSummary{
+        {Name: "backend", MemberCount: 3, ActiveMemberCount: 2, OpenReviews: 4},
+        {Name: "frontend", MemberCount: 2, ActiveMemberCount: 2},
+        {Name: "platform", MemberCount: 1},
+    }
+
+    t.Run("есть следующая страница", func(t *testing.T) {
+        mockTeamRepo := mocks.NewTeamRepository(t)
+        mockTeamRepo.On("List", ctx, "", 3).Return(page, nil)
+
+        svc := NewTeam(mockTeamRepo, nil, nil)
+        teams, next, err := svc.ListTeams(ctx, "", 2)
+
+        require.NoError(t, err)
+        assert.Equal(t, page[:2], teams)
+        assert.Equal(t, "frontend", next)
+    })
+
+    t.Run("последняя страница", func(t *testing.T) {
+        mockTeamRepo := mocks.NewTeamRepository(t)
+        mockTeamRepo.On("List", ctx, "backend", 3).Return(page[1:], nil)
+
+        svc := NewTeam(mockTeamRepo, nil, nil)
+        teams, next, err := svc.ListTeams(ctx, "backend", 2)
+
+        require.NoError(t, err)
+        assert.Len(t, teams, 2)
+        assert.Empty(t, next)
+    })
+}
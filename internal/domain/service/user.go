@@ -2,21 +2,38 @@ package service
 
 import (
     "context"
+    "errors"
     "fmt"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain"
     "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
     "github.com/kimvlry/avito-internship-assignment/internal/domain/repository"
+    "github.com/kimvlry/avito-internship-assignment/pkg/ical"
     "github.com/kimvlry/avito-internship-assignment/pkg/logger"
+    "io"
+    "time"
 )
 
+// ReviewReassigner replaces a reviewer of a PR, an empty newUserId lets the team strategy pick
+type ReviewReassigner interface {
+    ReassignReviewer(ctx context.Context, prId, oldUserId, newUserId string) (*entity.PullRequest, string, error)
+}
+
 type User struct {
-    userRepo repository.UserRepository
-    prRepo   repository.PullRequestRepository
+    userRepo   repository.UserRepository
+    prRepo     repository.PullRequestRepository
+    teamRepo   repository.TeamRepository
+    reassigner ReviewReassigner
+    tx         repository.Transactor
 }
 
-func NewUser(userRepo repository.UserRepository, prRepo repository.PullRequestRepository) *User {
+func NewUser(userRepo repository.UserRepository, prRepo repository.PullRequestRepository,
+    teamRepo repository.TeamRepository, reassigner ReviewReassigner, tx repository.Transactor) *User {
     return &User{
-        userRepo: userRepo,
-        prRepo:   prRepo,
+        userRepo:   userRepo,
+        prRepo:     prRepo,
+        teamRepo:   teamRepo,
+        reassigner: reassigner,
+        tx:         tx,
     }
 }
 
@@ -28,13 +45,93 @@ func (s *User) SetIsActive(ctx context.Context, userId string, isActive bool) (*
     return user, nil
 }
 
+// BulkSetIsActive sets the activity of the listed users or of every member of teamName in one
+// transaction. It returns the updated users ordered by id and the listed ids that do not exist
+func (s *User) BulkSetIsActive(
+    ctx context.Context,
+    userIDs []string,
+    teamName string,
+    isActive bool,
+) ([]entity.User, []string, error) {
+    var (
+        users    []entity.User
+        notFound []string
+    )
+    err := s.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+        ids := uniqueIDs(userIDs)
+        if teamName != "" {
+            exists, err := s.teamRepo.Exists(txCtx, teamName)
+            if err != nil {
+                return fmt.Errorf("check team exists: %w", err)
+            }
+            if !exists {
+                return domain.ErrTeamNotFound
+            }
+
+            members, err := s.userRepo.GetByTeam(txCtx, teamName)
+            if err != nil {
+                return fmt.Errorf("get team members: %w", err)
+            }
+            ids = make([]string, 0, len(members))
+            for _, member := range members {
+                ids = append(ids, member.ID)
+            }
+        }
+
+        var err error
+        users, err = s.userRepo.SetIsActiveBatch(txCtx, ids, isActive)
+        if err != nil {
+            return fmt.Errorf("set users active status: %w", err)
+        }
+
+        updated := make(map[string]struct{}, len(users))
+        for _, user := range users {
+            updated[user.ID] = struct{}{}
+        }
+        notFound = make([]string, 0)
+        for _, id := range ids {
+            if _, ok := updated[id]; !ok {
+                notFound = append(notFound, id)
+            }
+        }
+        return nil
+    })
+
+    if err != nil {
+        return nil, nil, err
+    }
+    return users, notFound, nil
+}
+
+// uniqueIDs drops repeated ids keeping the first occurrence
+func uniqueIDs(ids []string) []string {
+    seen := make(map[string]struct{}, len(ids))
+    res := make([]string, 0, len(ids))
+    for _, id := range ids {
+        if _, ok := seen[id]; ok {
+            continue
+        }
+        seen[id] = struct{}{}
+        res = append(res, id)
+    }
+    return res
+}
+
 func (s *User) GetReviewAssignments(ctx context.Context, userId string) ([]*entity.PullRequest, error) {
     pullRequests, err := s.prRepo.GetByReviewer(ctx, userId)
     if err != nil {
         logger.Error(ctx, fmt.Sprintf("get pull requests: %s", userId), err)
         return nil, err
     }
-    return pullRequests, nil
+
+    // closed PRs are abandoned, nobody has to review them anymore
+    reviews := make([]*entity.PullRequest, 0, len(pullRequests))
+    for _, pr := range pullRequests {
+        if pr.Status != entity.PRClosed {
+            reviews = append(reviews, pr)
+        }
+    }
+    return reviews, nil
 }
 
 func (s *User) GetByID(ctx context.Context, userID string) (*entity.User, error) {
@@ -44,3 +141,238 @@ func (s *User) GetByID(ctx context.Context, userID string) (*entity.User, error)
     }
     return user, nil
 }
+
+// SetExpertise replaces expertise tags of the user
+func (s *User) SetExpertise(ctx context.Context, userID string, tags []string) (*entity.User, error) {
+    tags = entity.NormalizeExpertise(tags)
+    for _, tag := range tags {
+        if tag == "" || len(tag) > 64 {
+            return nil, fmt.Errorf("%w: %q", domain.ErrInvalidExpertiseTag, tag)
+        }
+    }
+
+    var user *entity.User
+    err := s.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+        if err := s.userRepo.SetExpertise(txCtx, userID, tags); err != nil {
+            return fmt.Errorf("set expertise: %w", err)
+        }
+        var err error
+        user, err = s.userRepo.GetByID(txCtx, userID)
+        if err != nil {
+            return fmt.Errorf("get user by id: %w", err)
+        }
+        return nil
+    })
+
+    if err != nil {
+        return nil, err
+    }
+    return user, nil
+}
+
+// SetMaxOpenReviews sets the user's own limit of OPEN reviews, nil falls back to the team default
+func (s *User) SetMaxOpenReviews(ctx context.Context, userID string, maxOpenReviews *int) (*entity.User, error) {
+    if maxOpenReviews != nil && *maxOpenReviews < 1 {
+        return nil, fmt.Errorf("%w: limit must be at least 1", domain.ErrInvalidReviewCapacity)
+    }
+
+    var user *entity.User
+    err := s.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+        if err := s.userRepo.SetMaxOpenReviews(txCtx, userID, maxOpenReviews); err != nil {
+            return fmt.Errorf("set max open reviews: %w", err)
+        }
+        var err error
+        user, err = s.userRepo.GetByID(txCtx, userID)
+        if err != nil {
+            return fmt.Errorf("get user by id: %w", err)
+        }
+        return nil
+    })
+
+    if err != nil {
+        return nil, err
+    }
+    return user, nil
+}
+
+// AddUnavailability takes the user out of review rotation for the period, is_active stays as it is
+func (s *User) AddUnavailability(ctx context.Context, period *entity.Unavailability) error {
+    if !period.EndsAt.After(period.StartsAt) {
+        return fmt.Errorf("%w: period must end after it starts", domain.ErrInvalidUnavailability)
+    }
+    if err := s.userRepo.AddUnavailability(ctx, period); err != nil {
+        return fmt.Errorf("add unavailability: %w", err)
+    }
+    return nil
+}
+
+// ImportUnavailability stores events of an iCalendar document as unavailability periods of the user.
+// Events over by now are skipped, an event imported before is updated by its UID
+func (s *User) ImportUnavailability(
+    ctx context.Context,
+    userID string,
+    calendar io.Reader,
+    now time.Time,
+) ([]entity.Unavailability, error) {
+    events, err := ical.Parse(calendar)
+    if err != nil {
+        return nil, fmt.Errorf("%w: %s", domain.ErrInvalidCalendar, err)
+    }
+
+    periods := make([]entity.Unavailability, 0, len(events))
+    for _, event := range events {
+        if event.End.Before(event.Start) {
+            return nil, fmt.Errorf("%w: line %d: event ends before it starts", domain.ErrInvalidCalendar, event.Line)
+        }
+        if event.End.Equal(event.Start) || !event.End.After(now) {
+            continue
+        }
+
+        // an event without a UID is told apart by its time, re-importing it does not duplicate it
+        uid := event.UID
+        if uid == "" {
+            uid = event.Start.Format(time.RFC3339) + "/" + event.End.Format(time.RFC3339)
+        }
+        periods = append(periods, entity.Unavailability{
+            UserID:      userID,
+            StartsAt:    event.Start,
+            EndsAt:      event.End,
+            Reason:      event.Summary,
+            ExternalUID: uid,
+        })
+    }
+
+    err = s.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+        exists, err := s.userRepo.Exists(txCtx, userID)
+        if err != nil {
+            return fmt.Errorf("check user exists: %w", err)
+        }
+        if !exists {
+            return domain.ErrUserNotFound
+        }
+
+        for i := range periods {
+            if err := s.userRepo.AddUnavailability(txCtx, &periods[i]); err != nil {
+                return fmt.Errorf("add unavailability: %w", err)
+            }
+        }
+        return nil
+    })
+
+    if err != nil {
+        return nil, err
+    }
+    return periods, nil
+}
+
+func (s *User) RemoveUnavailability(ctx context.Context, userID string, id int64) error {
+    if err := s.userRepo.DeleteUnavailability(ctx, userID, id); err != nil {
+        return fmt.Errorf("delete unavailability: %w", err)
+    }
+    return nil
+}
+
+// GetUpcomingUnavailability maps each of the users to their periods not over by now, earliest first
+func (s *User) GetUpcomingUnavailability(
+    ctx context.Context,
+    userIDs []string,
+    now time.Time,
+) (map[string][]entity.Unavailability, error) {
+    absences := make(map[string][]entity.Unavailability)
+    if len(userIDs) == 0 {
+        return absences, nil
+    }
+
+    periods, err := s.userRepo.GetUpcomingUnavailability(ctx, userIDs, now)
+    if err != nil {
+        return nil, fmt.Errorf("get upcoming unavailability: %w", err)
+    }
+    for _, period := range periods {
+        absences[period.UserID] = append(absences[period.UserID], period)
+    }
+    return absences, nil
+}
+
+type ReviewReplacement struct {
+    PullRequestID string
+    ReplacedBy    string
+}
+
+type ReviewReassignFailure struct {
+    PullRequestID string
+    Reason        string
+}
+
+// ReassignReport tells what happened to each OPEN review of a deactivated user
+type ReassignReport struct {
+    Replaced []ReviewReplacement
+    // Unassigned PRs had no replacement candidate, the user was removed from them
+    Unassigned []string
+    // Failed PRs keep the user assigned
+    Failed []ReviewReassignFailure
+}
+
+// DeactivateAndReassign deactivates the user and hands their OPEN reviews over to someone else.
+// Every PR is reassigned in its own nested transaction, so a failure leaves only that PR as it was
+func (s *User) DeactivateAndReassign(ctx context.Context, userID string) (*entity.User, *ReassignReport, error) {
+    var user *entity.User
+    report := &ReassignReport{
+        Replaced:   []ReviewReplacement{},
+        Unassigned: []string{},
+        Failed:     []ReviewReassignFailure{},
+    }
+
+    ctx = domain.WithReason(ctx, "reviewer deactivated")
+    err := s.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+        var err error
+        user, err = s.userRepo.SetIsActive(txCtx, userID, false)
+        if err != nil {
+            return fmt.Errorf("set user active status: %w", err)
+        }
+
+        pullRequests, err := s.prRepo.GetByReviewer(txCtx, userID)
+        if err != nil {
+            return fmt.Errorf("get pull requests: %w", err)
+        }
+
+        for _, pr := range pullRequests {
+            if pr.Status != entity.PROpen {
+                continue
+            }
+
+            _, newUserID, err := s.reassigner.ReassignReviewer(txCtx, pr.ID, userID, "")
+            switch {
+            case err == nil:
+                report.Replaced = append(report.Replaced, ReviewReplacement{PullRequestID: pr.ID, ReplacedBy: newUserID})
+            case errors.Is(err, domain.ErrNoReviewerCandidate):
+                if err := s.unassign(txCtx, pr.ID, userID); err != nil {
+                    report.Failed = append(report.Failed, ReviewReassignFailure{PullRequestID: pr.ID, Reason: err.Error()})
+                    continue
+                }
+                report.Unassigned = append(report.Unassigned, pr.ID)
+            default:
+                report.Failed = append(report.Failed, ReviewReassignFailure{PullRequestID: pr.ID, Reason: err.Error()})
+            }
+        }
+        return nil
+    })
+
+    if err != nil {
+        return nil, nil, err
+    }
+    return user, report, nil
+}
+
+func (s *User) unassign(ctx context.Context, prID, userID string) error {
+    return s.tx.WithinTransaction(ctx, func(txCtx context.Context) error {
+        if err := s.prRepo.RemoveReviewer(txCtx, prID, userID); err != nil {
+            return fmt.Errorf("remove reviewer: %w", err)
+        }
+        return recordEvents(txCtx, s.prRepo, entity.ReviewerEvent{
+            PullRequestID: prID,
+            Type:          entity.EventUnassigned,
+            ReviewerID:    userID,
+            Reason:        "reviewer deactivated, no replacement candidate",
+        })
+    })
+}
@@ -2,12 +2,16 @@ package service
 
 import (
     "context"
+    "errors"
+    "strings"
     "testing"
+    "time"
 
     "github.com/kimvlry/avito-internship-assignment/internal/domain"
     "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
     "github.com/kimvlry/avito-internship-assignment/internal/domain/service/mocks"
     "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/mock"
     "github.com/stretchr/testify/require"
 )
 
@@ -35,7 +39,7 @@ func TestUserService_SetIsActive(t *testing.T) {
             mockUserRepo.On("SetIsActive", ctx, tt.userID, tt.isActive).
                 Return(nil, tt.mockError)
 
-            svc := NewUser(mockUserRepo, mockPRRepo)
+            svc := NewUser(mockUserRepo, mockPRRepo, nil, nil, mocks.NewTransactor(t))
 
             _, err := svc.SetIsActive(ctx, tt.userID, tt.isActive)
 
@@ -51,6 +55,87 @@ func TestUserService_SetIsActive(t *testing.T) {
     }
 }
 
+func TestUserService_BulkSetIsActive(t *testing.T) {
+    tests := []struct {
+        name             string
+        userIDs          []string
+        teamName         string
+        teamExists       bool
+        members          []entity.User
+        updateIDs        []string
+        updated          []entity.User
+        expectedNotFound []string
+        expectedErrType  error
+    }{
+        {
+            name:      "список пользователей с повторами и несуществующими",
+            userIDs:   []string{"u2", "u404", "u1", "u2"},
+            updateIDs: []string{"u2", "u404", "u1"},
+            updated: []entity.User{
+                {ID: "u1", TeamName: "backend", Teams: []string{"backend"}},
+                {ID: "u2", TeamName: "backend", Teams: []string{"backend"}},
+            },
+            expectedNotFound: []string{"u404"},
+        },
+        {
+            name:       "вся команда",
+            teamName:   "backend",
+            teamExists: true,
+            members:    []entity.User{{ID: "u1"}, {ID: "u2"}},
+            updateIDs:  []string{"u1", "u2"},
+            updated: []entity.User{
+                {ID: "u1", TeamName: "backend", Teams: []string{"backend"}},
+                {ID: "u2", TeamName: "backend", Teams: []string{"backend"}},
+            },
+            expectedNotFound: []string{},
+        },
+        {
+            name:            "ошибка: команда не найдена",
+            teamName:        "missing",
+            expectedErrType: domain.ErrTeamNotFound,
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            ctx := context.Background()
+
+            mockUserRepo := mocks.NewUserRepository(t)
+            mockTeamRepo := mocks.NewTeamRepository(t)
+            mockTx := mocks.NewTransactor(t)
+
+            mockTx.On(
+                "WithinTransaction",
+                mock.Anything,
+                mock.AnythingOfType("func(context.Context) error"),
+            ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+                return fn(ctx)
+            })
+            if tt.teamName != "" {
+                mockTeamRepo.On("Exists", ctx, tt.teamName).Return(tt.teamExists, nil)
+            }
+            if tt.teamExists {
+                mockUserRepo.On("GetByTeam", ctx, tt.teamName).Return(tt.members, nil)
+            }
+            if tt.expectedErrType == nil {
+                mockUserRepo.On("SetIsActiveBatch", ctx, tt.updateIDs, false).Return(tt.updated, nil)
+            }
+
+            svc := NewUser(mockUserRepo, nil, mockTeamRepo, nil, mockTx)
+            users, notFound, err := svc.BulkSetIsActive(ctx, tt.userIDs, tt.teamName, false)
+
+            if tt.expectedErrType != nil {
+                assert.ErrorIs(t, err, tt.expectedErrType)
+                return
+            }
+
+            require.NoError(t, err)
+            assert.Equal(t, tt.updated, users)
+            assert.Equal(t, tt.expectedNotFound, notFound)
+        })
+    }
+}
+
 func TestUserService_GetReviewAssignments(t *testing.T) {
     tests := []struct {
         name            string
@@ -93,7 +178,7 @@ func TestUserService_GetReviewAssignments(t *testing.T) {
 
             mockPRRepo.On("GetByReviewer", ctx, tt.userID).Return(tt.mockPRs, tt.mockError)
 
-            svc := NewUser(mockUserRepo, mockPRRepo)
+            svc := NewUser(mockUserRepo, mockPRRepo, nil, nil, mocks.NewTransactor(t))
 
             prs, err := svc.GetReviewAssignments(ctx, tt.userID)
 
@@ -114,3 +199,324 @@ func TestUserService_GetReviewAssignments(t *testing.T) {
         })
     }
 }
+
+func TestUserService_SetExpertise(t *testing.T) {
+    tests := []struct {
+        name            string
+        tags            []string
+        expectTags      []string
+        expectError     bool
+        expectedErrType error
+    }{
+        {
+            name:       "теги нормализуются",
+            tags:       []string{"Go", " sql ", "go"},
+            expectTags: []string{"go", "sql"},
+        },
+        {
+            name:            "ошибка: пустой тег",
+            tags:            []string{"go", " "},
+            expectError:     true,
+            expectedErrType: domain.ErrInvalidExpertiseTag,
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            ctx := context.Background()
+
+            mockUserRepo := mocks.NewUserRepository(t)
+            mockPRRepo := mocks.NewPullRequestRepository(t)
+            mockTx := mocks.NewTransactor(t)
+
+            if !tt.expectError {
+                mockTx.On(
+                    "WithinTransaction",
+                    mock.Anything,
+                    mock.AnythingOfType("func(context.Context) error"),
+                ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+                    return fn(ctx)
+                })
+                mockUserRepo.On("SetExpertise", ctx, "u1", tt.expectTags).Return(nil)
+                mockUserRepo.On("GetByID", ctx, "u1").
+                    Return(&entity.User{ID: "u1", IsActive: true, Expertise: tt.expectTags}, nil)
+            }
+
+            svc := NewUser(mockUserRepo, mockPRRepo, nil, nil, mockTx)
+            user, err := svc.SetExpertise(ctx, "u1", tt.tags)
+
+            if tt.expectError {
+                require.Error(t, err)
+                assert.ErrorIs(t, err, tt.expectedErrType)
+                return
+            }
+
+            require.NoError(t, err)
+            assert.Equal(t, tt.expectTags, user.Expertise)
+        })
+    }
+}
+
+func TestUserService_SetMaxOpenReviews(t *testing.T) {
+    limit := 3
+    zero := 0
+
+    tests := []struct {
+        name            string
+        maxOpenReviews  *int
+        expectError     bool
+        expectedErrType error
+    }{
+        {
+            name:           "собственный лимит",
+            maxOpenReviews: &limit,
+        },
+        {
+            name:           "сброс к лимиту команды",
+            maxOpenReviews: nil,
+        },
+        {
+            name:            "ошибка: нулевой лимит",
+            maxOpenReviews:  &zero,
+            expectError:     true,
+            expectedErrType: domain.ErrInvalidReviewCapacity,
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            ctx := context.Background()
+
+            mockUserRepo := mocks.NewUserRepository(t)
+            mockPRRepo := mocks.NewPullRequestRepository(t)
+            mockTx := mocks.NewTransactor(t)
+
+            if !tt.expectError {
+                mockTx.On(
+                    "WithinTransaction",
+                    mock.Anything,
+                    mock.AnythingOfType("func(context.Context) error"),
+                ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+                    return fn(ctx)
+                })
+                mockUserRepo.On("SetMaxOpenReviews", ctx, "u1", tt.maxOpenReviews).Return(nil)
+                mockUserRepo.On("GetByID", ctx, "u1").
+                    Return(&entity.User{ID: "u1", IsActive: true, MaxOpenReviews: tt.maxOpenReviews}, nil)
+            }
+
+            svc := NewUser(mockUserRepo, mockPRRepo, nil, nil, mockTx)
+            user, err := svc.SetMaxOpenReviews(ctx, "u1", tt.maxOpenReviews)
+
+            if tt.expectError {
+                require.Error(t, err)
+                assert.ErrorIs(t, err, tt.expectedErrType)
+                return
+            }
+
+            require.NoError(t, err)
+            assert.Equal(t, tt.maxOpenReviews, user.MaxOpenReviews)
+        })
+    }
+}
+
+type reassignFunc func(ctx context.Context, prId, oldUserId, newUserId string) (*entity.PullRequest, string, error)
+
+func (f reassignFunc) ReassignReviewer(
+    ctx context.Context,
+    prId,
+    oldUserId,
+    newUserId string,
+) (*entity.PullRequest, string, error) {
+    return f(ctx, prId, oldUserId, newUserId)
+}
+
+func TestUserService_DeactivateAndReassign(t *testing.T) {
+    // the service tags its changes with the deactivation reason
+    ctx := domain.WithReason(context.Background(), "reviewer deactivated")
+
+    mockUserRepo := mocks.NewUserRepository(t)
+    mockPRRepo := mocks.NewPullRequestRepository(t)
+    mockTx := mocks.NewTransactor(t)
+
+    mockTx.On(
+        "WithinTransaction",
+        mock.Anything,
+        mock.AnythingOfType("func(context.Context) error"),
+    ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+        return fn(ctx)
+    })
+    mockUserRepo.On("SetIsActive", ctx, "u2", false).
+        Return(&entity.User{ID: "u2", TeamName: "backend", Teams: []string{"backend"}, IsActive: false}, nil)
+    mockPRRepo.On("GetByReviewer", ctx, "u2").Return([]*entity.PullRequest{
+        {ID: "pr-1", Status: entity.PROpen},
+        {ID: "pr-2", Status: entity.PROpen},
+        {ID: "pr-3", Status: entity.PROpen},
+        {ID: "pr-4", Status: entity.PRMerged},
+    }, nil)
+    mockPRRepo.On("RemoveReviewer", ctx, "pr-2", "u2").Return(nil)
+    mockPRRepo.On("AppendEvents", ctx, []entity.ReviewerEvent{{
+        PullRequestID: "pr-2",
+        Type:          entity.EventUnassigned,
+        ReviewerID:    "u2",
+        Reason:        "reviewer deactivated, no replacement candidate",
+    }}).Return(nil)
+
+    reassigner := reassignFunc(func(ctx context.Context, prId, oldUserId, newUserId string) (*entity.PullRequest, string, error) {
+        assert.Equal(t, "reviewer deactivated", domain.ReasonFrom(ctx, ""))
+        switch prId {
+        case "pr-1":
+            return &entity.PullRequest{ID: prId}, "u5", nil
+        case "pr-2":
+            return nil, "", domain.ErrNoReviewerCandidate
+        default:
+            return nil, "", domain.ErrTeamNotFound
+        }
+    })
+
+    svc := NewUser(mockUserRepo, mockPRRepo, nil, reassigner, mockTx)
+    user, report, err := svc.DeactivateAndReassign(context.Background(), "u2")
+
+    require.NoError(t, err)
+    assert.False(t, user.IsActive)
+    assert.Equal(t, []ReviewReplacement{{PullRequestID: "pr-1", ReplacedBy: "u5"}}, report.Replaced)
+    assert.Equal(t, []string{"pr-2"}, report.Unassigned)
+    require.Len(t, report.Failed, 1)
+    assert.Equal(t, "pr-3", report.Failed[0].PullRequestID, "MERGED PR не трогается")
+}
+
+func TestUserService_AddUnavailability(t *testing.T) {
+    ctx := context.Background()
+    start := time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC)
+
+    mockUserRepo := mocks.NewUserRepository(t)
+    svc := NewUser(mockUserRepo, nil, nil, nil, nil)
+
+    t.Run("ошибка: период заканчивается до начала", func(t *testing.T) {
+        err := svc.AddUnavailability(ctx, &entity.Unavailability{UserID: "u1", StartsAt: start, EndsAt: start})
+        assert.ErrorIs(t, err, domain.ErrInvalidUnavailability)
+    })
+
+    t.Run("период сохраняется", func(t *testing.T) {
+        period := &entity.Unavailability{UserID: "u1", StartsAt: start, EndsAt: start.AddDate(0, 0, 14), Reason: "Отпуск"}
+        mockUserRepo.On("AddUnavailability", ctx, period).Return(nil).Once()
+
+        require.NoError(t, svc.AddUnavailability(ctx, period))
+    })
+}
+
+func TestUserService_ImportUnavailability(t *testing.T) {
+    now := time.Date(2025, 10, 20, 12, 0, 0, 0, time.UTC)
+    calendar := `BEGIN:VCALENDAR
+BEGIN:VEVENT
+UID:vacation
+SUMMARY:Отпуск
+DTSTART;VALUE=DATE:20251103
+DTEND;VALUE=DATE:20251117
+END:VEVENT
+BEGIN:VEVENT
+SUMMARY:Больничный
+DTSTART:20251020T080000Z
+DTEND:20251021T080000Z
+END:VEVENT
+BEGIN:VEVENT
+UID:past
+DTSTART;VALUE=DATE:20251001
+END:VEVENT
+END:VCALENDAR`
+
+    tests := []struct {
+        name            string
+        calendar        string
+        userExists      bool
+        expectedUIDs    []string
+        expectedErrType error
+    }{
+        {
+            name:         "прошедшие события пропускаются",
+            calendar:     calendar,
+            userExists:   true,
+            expectedUIDs: []string{"vacation", "2025-10-20T08:00:00Z/2025-10-21T08:00:00Z"},
+        },
+        {
+            name:            "ошибка: пользователь не найден",
+            calendar:        calendar,
+            expectedErrType: domain.ErrUserNotFound,
+        },
+        {
+            name:            "ошибка: невалидный календарь",
+            calendar:        "BEGIN:VEVENT\nDTSTART:2025-11-03\nEND:VEVENT",
+            expectedErrType: domain.ErrInvalidCalendar,
+        },
+        {
+            name:            "ошибка: событие заканчивается до начала",
+            calendar:        "BEGIN:VEVENT\nDTSTART:20251103T090000Z\nDTEND:20251102T090000Z\nEND:VEVENT",
+            expectedErrType: domain.ErrInvalidCalendar,
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            ctx := context.Background()
+
+            mockUserRepo := mocks.NewUserRepository(t)
+            mockTx := mocks.NewTransactor(t)
+
+            if !errors.Is(tt.expectedErrType, domain.ErrInvalidCalendar) {
+                mockTx.On(
+                    "WithinTransaction",
+                    mock.Anything,
+                    mock.AnythingOfType("func(context.Context) error"),
+                ).Return(func(ctx context.Context, fn func(ctx2 context.Context) error) error {
+                    return fn(ctx)
+                })
+                mockUserRepo.On("Exists", ctx, "u1").Return(tt.userExists, nil)
+            }
+            if tt.userExists {
+                mockUserRepo.On("AddUnavailability", ctx, mock.AnythingOfType("*entity.Unavailability")).
+                    Return(nil).
+                    Times(len(tt.expectedUIDs))
+            }
+
+            svc := NewUser(mockUserRepo, nil, nil, nil, mockTx)
+            periods, err := svc.ImportUnavailability(ctx, "u1", strings.NewReader(tt.calendar), now)
+
+            if tt.expectedErrType != nil {
+                assert.ErrorIs(t, err, tt.expectedErrType)
+                return
+            }
+
+            require.NoError(t, err)
+            uids := make([]string, 0, len(periods))
+            for _, period := range periods {
+                assert.Equal(t, "u1", period.UserID)
+                uids = append(uids, period.ExternalUID)
+            }
+            assert.Equal(t, tt.expectedUIDs, uids)
+            assert.Equal(t, "Отпуск", periods[0].Reason)
+            assert.Equal(t, time.Date(2025, 11, 17, 0, 0, 0, 0, time.UTC), periods[0].EndsAt)
+        })
+    }
+}
+
+func TestUserService_GetUpcomingUnavailability(t *testing.T) {
+    ctx := context.Background()
+    now := time.Date(2025, 10, 20, 12, 0, 0, 0, time.UTC)
+
+    mockUserRepo := mocks.NewUserRepository(t)
+    mockUserRepo.On("GetUpcomingUnavailability", ctx, []string{"u2", "u3"}, now).Return([]entity.Unavailability{
+        {ID: 1, UserID: "u2", StartsAt: now, EndsAt: now.AddDate(0, 0, 1)},
+        {ID: 2, UserID: "u2", StartsAt: now.AddDate(0, 0, 7), EndsAt: now.AddDate(0, 0, 14)},
+        {ID: 3, UserID: "u3", StartsAt: now.AddDate(0, 1, 0), EndsAt: now.AddDate(0, 1, 7)},
+    }, nil)
+
+    svc := NewUser(mockUserRepo, nil, nil, nil, nil)
+    absences, err := svc.GetUpcomingUnavailability(ctx, []string{"u2", "u3"}, now)
+    require.NoError(t, err)
+    require.Len(t, absences["u2"], 2)
+    assert.Equal(t, int64(2), absences["u2"][1].ID)
+    require.Len(t, absences["u3"], 1)
+
+    absences, err = svc.GetUpcomingUnavailability(ctx, nil, now)
+    require.NoError(t, err)
+    assert.Empty(t, absences)
+}
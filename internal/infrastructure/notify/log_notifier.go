@@ -0,0 +1,25 @@
+// Package notify delivers notifications of the domain services to people
+package notify
+
+import (
+    "context"
+
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/repository"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/service"
+    "github.com/kimvlry/avito-internship-assignment/pkg/logger"
+)
+
+// LogNotifier writes notifications to the application log until a real channel is wired in
+type LogNotifier struct{}
+
+var _ service.Notifier = LogNotifier{}
+
+func (LogNotifier) NotifyOverdueReview(ctx context.Context, leadID string, assignment repository.OverdueAssignment) error {
+    logger.Info(ctx, "review is overdue",
+        "lead_id", leadID,
+        "pull_request_id", assignment.PullRequestID,
+        "reviewer_id", assignment.ReviewerID,
+        "due_at", assignment.DueAt,
+    )
+    return nil
+}
@@ -13,13 +13,13 @@ func (e Error) Error() string {
 }
 
 const (
-    ErrUserHasActiveAssignments Error = "user has active PR assignments in other team"
-    ErrUserAlreadyExists        Error = "user already exists"
+    ErrUserAlreadyExists Error = "user already exists"
 )
 
 const (
     pgUniqueViolation     = "23505"
     pgForeignKeyViolation = "23503"
+    pgCheckViolation      = "23514"
 )
 
 func isPgUniqueViolation(err error) bool {
@@ -31,3 +31,17 @@ func isPgForeignKeyViolation(err error) bool {
     var pgErr *pgconn.PgError
     return errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation
 }
+
+func isPgCheckViolation(err error) bool {
+    var pgErr *pgconn.PgError
+    return errors.As(err, &pgErr) && pgErr.Code == pgCheckViolation
+}
+
+// pgConstraintName returns the constraint a Postgres error refers to, "" for other errors
+func pgConstraintName(err error) string {
+    var pgErr *pgconn.PgError
+    if errors.As(err, &pgErr) {
+        return pgErr.ConstraintName
+    }
+    return ""
+}
@@ -10,6 +10,7 @@ type Repositories struct {
     Team        repository.TeamRepository
     User        repository.UserRepository
     PullRequest repository.PullRequestRepository
+    Ownership   repository.OwnershipRepository
     Transactor  repository.Transactor
 }
 
@@ -23,6 +24,7 @@ func NewRepositories(ctx context.Context, connString string) (*Repositories, *DB
         Team:        NewTeamRepository(db),
         User:        NewUserRepository(db),
         PullRequest: NewPullRequestRepository(db),
+        Ownership:   NewOwnershipRepository(db),
         Transactor:  NewTransactor(db.Pool),
     }, db, nil
 }
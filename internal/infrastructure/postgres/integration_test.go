@@ -5,6 +5,7 @@ import (
     "github.com/kimvlry/avito-internship-assignment/internal/domain"
     "os"
     "testing"
+    "time"
 
     "github.com/golang-migrate/migrate/v4"
     _ "github.com/golang-migrate/migrate/v4/database/postgres"
@@ -17,6 +18,7 @@ import (
     "github.com/testcontainers/testcontainers-go/wait"
 
     "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/repository"
     "github.com/kimvlry/avito-internship-assignment/internal/infrastructure/postgres"
 )
 
@@ -83,6 +85,12 @@ func (tdb *TestDB) CleanDatabase(t *testing.T) {
     ctx := context.Background()
     query := `
         TRUNCATE TABLE 
+            team_rotation_cursors,
+            team_fallbacks,
+            ownership_rules,
+            user_expertise,
+            user_unavailability,
+            team_members,
             pull_request_reviewers, 
             pull_requests, 
             users, 
@@ -101,6 +109,7 @@ func TestRepositories(t *testing.T) {
     teamRepo := postgres.NewTeamRepository(testDB.DB)
     userRepo := postgres.NewUserRepository(testDB.DB)
     prRepo := postgres.NewPullRequestRepository(testDB.DB)
+    ownershipRepo := postgres.NewOwnershipRepository(testDB.DB)
     transactor := postgres.NewTransactor(testDB.DB.Pool)
 
     ctx := context.Background()
@@ -115,6 +124,81 @@ func TestRepositories(t *testing.T) {
         fetched, err := teamRepo.GetByName(ctx, "backend")
         require.NoError(t, err)
         assert.Equal(t, "backend", fetched.Name)
+        assert.Equal(t, entity.ReviewerStrategyRandom, fetched.ReviewerStrategy)
+        assert.Equal(t, entity.DefaultMinReviewers, fetched.MinReviewers)
+        assert.Equal(t, entity.DefaultMaxReviewers, fetched.MaxReviewers)
+        assert.Equal(t, entity.DefaultWorkdayStart, fetched.ReviewSLA.WorkdayStart)
+        assert.Equal(t, entity.DefaultWorkdayEnd, fetched.ReviewSLA.WorkdayEnd)
+        assert.Equal(t, entity.DefaultTimeZone, fetched.ReviewSLA.TimeZone)
+
+        fetched.ReviewerStrategy = entity.ReviewerStrategyLeastLoaded
+        fetched.MinReviewers = 1
+        fetched.MaxReviewers = 3
+        fetched.ReviewSLA.WorkdayStart = 10
+        fetched.ReviewSLA.TimeZone = "Europe/Moscow"
+        err = teamRepo.Update(ctx, fetched)
+        require.NoError(t, err)
+
+        updated, err := teamRepo.GetByName(ctx, "backend")
+        require.NoError(t, err)
+        assert.Equal(t, entity.ReviewerStrategyLeastLoaded, updated.ReviewerStrategy)
+        assert.Equal(t, 1, updated.MinReviewers)
+        assert.Equal(t, 3, updated.MaxReviewers)
+        assert.Equal(t, 10, updated.ReviewSLA.WorkdayStart)
+        assert.Equal(t, "Europe/Moscow", updated.ReviewSLA.TimeZone)
+
+        err = teamRepo.Create(ctx, &entity.Team{Name: "platform"})
+        require.NoError(t, err)
+
+        updated.FallbackTeams = []string{"platform"}
+        err = teamRepo.Update(ctx, updated)
+        require.NoError(t, err)
+
+        withFallback, err := teamRepo.GetByName(ctx, "backend")
+        require.NoError(t, err)
+        assert.Equal(t, []string{"platform"}, withFallback.FallbackTeams)
+
+        withFallback.FallbackTeams = []string{"missing"}
+        err = teamRepo.Update(ctx, withFallback)
+        assert.ErrorIs(t, err, domain.ErrTeamNotFound)
+
+        err = teamRepo.Update(ctx, &entity.Team{Name: "nonexistent", ReviewerStrategy: entity.ReviewerStrategyRandom})
+        assert.ErrorIs(t, err, domain.ErrTeamNotFound)
+
+        err = userRepo.Create(ctx, &entity.User{ID: "lead1", Username: "lead", TeamName: "backend", IsActive: true})
+        require.NoError(t, err)
+        require.NoError(t, teamRepo.SetLead(ctx, "backend", "lead1"))
+        withLead, err := teamRepo.GetByName(ctx, "backend")
+        require.NoError(t, err)
+        assert.Equal(t, "lead1", withLead.LeadID)
+
+        err = teamRepo.SetLead(ctx, "backend", "missing")
+        assert.ErrorIs(t, err, domain.ErrUserNotFound)
+        err = teamRepo.SetLead(ctx, "nonexistent", "lead1")
+        assert.ErrorIs(t, err, domain.ErrTeamNotFound)
+        require.NoError(t, teamRepo.SetLead(ctx, "backend", ""))
+        withoutLead, err := teamRepo.GetByName(ctx, "backend")
+        require.NoError(t, err)
+        assert.Empty(t, withoutLead.LeadID)
+
+        err = transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+            cursor, err := teamRepo.LockRotationCursor(ctx, "backend")
+            require.NoError(t, err)
+            assert.Empty(t, cursor)
+            return teamRepo.SetRotationCursor(ctx, "backend", "u2")
+        })
+        require.NoError(t, err)
+
+        err = transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+            cursor, err := teamRepo.LockRotationCursor(ctx, "backend")
+            require.NoError(t, err)
+            assert.Equal(t, "u2", cursor)
+            return nil
+        })
+        require.NoError(t, err)
+
+        _, err = teamRepo.LockRotationCursor(ctx, "nonexistent")
+        assert.ErrorIs(t, err, domain.ErrTeamNotFound)
 
         exists, err := teamRepo.Exists(ctx, "backend")
         require.NoError(t, err)
@@ -125,6 +209,106 @@ func TestRepositories(t *testing.T) {
 
         _, err = teamRepo.GetByName(ctx, "nonexistent")
         assert.ErrorIs(t, err, domain.ErrTeamNotFound)
+
+        err = userRepo.Create(ctx, &entity.User{ID: "member1", Username: "alice", TeamName: "platform", IsActive: true})
+        require.NoError(t, err)
+        err = ownershipRepo.ReplaceAll(ctx, []entity.OwnershipRule{{Pattern: "*", TeamNames: []string{"platform"}}})
+        require.NoError(t, err)
+
+        err = teamRepo.Rename(ctx, "platform", "core")
+        require.NoError(t, err)
+        member, err := userRepo.GetByID(ctx, "member1")
+        require.NoError(t, err)
+        assert.Equal(t, "core", member.TeamName, "участник переходит вместе с командой")
+        withFallback, err = teamRepo.GetByName(ctx, "backend")
+        require.NoError(t, err)
+        assert.Equal(t, []string{"core"}, withFallback.FallbackTeams)
+        rules, err := ownershipRepo.GetAll(ctx)
+        require.NoError(t, err)
+        assert.Equal(t, []string{"core"}, rules[0].TeamNames)
+
+        err = teamRepo.Rename(ctx, "core", "backend")
+        assert.ErrorIs(t, err, domain.ErrTeamAlreadyExists)
+        err = teamRepo.Rename(ctx, "nonexistent", "other")
+        assert.ErrorIs(t, err, domain.ErrTeamNotFound)
+
+        summaries, err := teamRepo.List(ctx, "", 10)
+        require.NoError(t, err)
+        assert.Equal(t, []repository.TeamSummary{
+            {Name: "backend"},
+            {Name: "core", MemberCount: 1, ActiveMemberCount: 1},
+        }, summaries)
+        summaries, err = teamRepo.List(ctx, "backend", 10)
+        require.NoError(t, err)
+        require.Len(t, summaries, 1)
+        assert.Equal(t, "core", summaries[0].Name)
+
+        err = teamRepo.Delete(ctx, "core")
+        assert.ErrorIs(t, err, domain.ErrTeamNotEmpty)
+
+        err = userRepo.MoveTeamMembers(ctx, "core", "backend")
+        require.NoError(t, err)
+        member, err = userRepo.GetByID(ctx, "member1")
+        require.NoError(t, err)
+        assert.Equal(t, "backend", member.TeamName, "основная команда переходит к целевой")
+        assert.Equal(t, []string{"backend"}, member.Teams)
+        err = teamRepo.Delete(ctx, "core")
+        require.NoError(t, err)
+        withFallback, err = teamRepo.GetByName(ctx, "backend")
+        require.NoError(t, err)
+        assert.Empty(t, withFallback.FallbackTeams)
+        rules, err = ownershipRepo.GetAll(ctx)
+        require.NoError(t, err)
+        assert.Empty(t, rules[0].TeamNames)
+
+        err = teamRepo.Delete(ctx, "core")
+        assert.ErrorIs(t, err, domain.ErrTeamNotFound)
+    })
+
+    t.Run("TeamHierarchy", func(t *testing.T) {
+        testDB.CleanDatabase(t)
+
+        for _, team := range []*entity.Team{
+            {Name: "department"},
+            {Name: "tribe", ParentName: "department"},
+            {Name: "squad-a", ParentName: "tribe"},
+            {Name: "squad-b", ParentName: "tribe"},
+        } {
+            require.NoError(t, teamRepo.Create(ctx, team))
+        }
+        err := teamRepo.Create(ctx, &entity.Team{Name: "orphan", ParentName: "missing"})
+        assert.ErrorIs(t, err, domain.ErrTeamNotFound)
+
+        ancestors, err := teamRepo.GetAncestors(ctx, "squad-a")
+        require.NoError(t, err)
+        assert.Equal(t, []string{"tribe", "department"}, ancestors)
+        ancestors, err = teamRepo.GetAncestors(ctx, "department")
+        require.NoError(t, err)
+        assert.Empty(t, ancestors)
+
+        children, err := teamRepo.GetChildren(ctx, "tribe")
+        require.NoError(t, err)
+        assert.Equal(t, []string{"squad-a", "squad-b"}, children)
+
+        err = teamRepo.Rename(ctx, "tribe", "platform")
+        require.NoError(t, err)
+        squad, err := teamRepo.GetByName(ctx, "squad-a")
+        require.NoError(t, err)
+        assert.Equal(t, "platform", squad.ParentName, "дочерние команды следуют за переименованием")
+
+        squad.ParentName = "ghost"
+        err = teamRepo.Update(ctx, squad)
+        assert.ErrorIs(t, err, domain.ErrTeamNotFound)
+
+        err = teamRepo.Delete(ctx, "platform")
+        require.NoError(t, err)
+        parents, err := teamRepo.GetParents(ctx)
+        require.NoError(t, err)
+        assert.Equal(t, map[string]string{
+            "department": "",
+            "squad-a":    "department",
+            "squad-b":    "department",
+        }, parents, "дочерние команды удаленной переходят к ее родителю")
     })
 
     t.Run("UserRepository", func(t *testing.T) {
@@ -165,6 +349,185 @@ func TestRepositories(t *testing.T) {
         require.NoError(t, err)
         assert.Len(t, users, 1)
         assert.Equal(t, "user1", users[0].ID)
+
+        err = userRepo.SetExpertise(ctx, "user1", []string{"sql", "go"})
+        require.NoError(t, err)
+
+        withExpertise, err := userRepo.GetByID(ctx, "user1")
+        require.NoError(t, err)
+        assert.Equal(t, []string{"go", "sql"}, withExpertise.Expertise)
+
+        experts, err := userRepo.GetExpertActiveTeamUsers(ctx, "team1", nil, []string{"sql"}, 2)
+        require.NoError(t, err)
+        require.Len(t, experts, 1)
+        assert.Equal(t, "user1", experts[0].ID)
+
+        experts, err = userRepo.GetExpertActiveTeamUsers(ctx, "team1", nil, []string{"frontend"}, 2)
+        require.NoError(t, err)
+        assert.Empty(t, experts)
+
+        err = teamRepo.Create(ctx, &entity.Team{Name: "team2"})
+        require.NoError(t, err)
+        err = userRepo.AddToTeam(ctx, "user1", "team2")
+        require.NoError(t, err)
+        err = userRepo.AddToTeam(ctx, "user1", "team2")
+        require.NoError(t, err, "повторное добавление ничего не меняет")
+        err = userRepo.AddToTeam(ctx, "user1", "missing")
+        assert.ErrorIs(t, err, domain.ErrTeamNotFound)
+
+        multi, err := userRepo.GetByID(ctx, "user1")
+        require.NoError(t, err)
+        assert.Equal(t, "team1", multi.TeamName, "основной остаётся первая команда")
+        assert.Equal(t, []string{"team1", "team2"}, multi.Teams)
+        candidates, err := userRepo.GetRandomActiveTeamUsers(ctx, "team2", nil, 2)
+        require.NoError(t, err)
+        require.Len(t, candidates, 1, "ревьюит за любую свою команду")
+        assert.Equal(t, "user1", candidates[0].ID)
+
+        err = userRepo.RemoveFromTeam(ctx, "user1", "team1")
+        require.NoError(t, err)
+        moved, err := userRepo.GetByID(ctx, "user1")
+        require.NoError(t, err)
+        assert.Equal(t, "team2", moved.TeamName, "основной становится оставшаяся команда")
+        users, err = userRepo.GetByTeam(ctx, "team1")
+        require.NoError(t, err)
+        assert.Empty(t, users)
+
+        err = userRepo.RemoveFromTeam(ctx, "user1", "team2")
+        require.NoError(t, err)
+        teamless, err := userRepo.GetByID(ctx, "user1")
+        require.NoError(t, err)
+        assert.Empty(t, teamless.TeamName, "пользователь исключён из всех команд")
+        assert.Empty(t, teamless.Teams)
+        assert.Equal(t, 0, teamless.ReviewCapacity, "без основной команды лимита нет")
+        loads, err := userRepo.GetAllReviewLoads(ctx)
+        require.NoError(t, err)
+        assert.Len(t, loads, 1)
+        err = userRepo.RemoveFromTeam(ctx, "user1", "team2")
+        assert.ErrorIs(t, err, domain.ErrUserNotInTeam)
+    })
+
+    t.Run("TeamlessUser", func(t *testing.T) {
+        testDB.CleanDatabase(t)
+
+        require.NoError(t, teamRepo.Create(ctx, &entity.Team{Name: "team1", DefaultMaxOpenReviews: 3}))
+        require.NoError(t, userRepo.Create(ctx, &entity.User{ID: "member", Username: "member", TeamName: "team1", IsActive: true}))
+        require.NoError(t, userRepo.Create(ctx, &entity.User{ID: "loner", Username: "loner", IsActive: true}))
+
+        loner, err := userRepo.GetByID(ctx, "loner")
+        require.NoError(t, err)
+        assert.Empty(t, loner.TeamName)
+        assert.Equal(t, 0, loner.ReviewCapacity, "без команды лимита нет")
+        assert.True(t, loner.HasReviewCapacity())
+
+        loads, err := userRepo.GetAllReviewLoads(ctx)
+        require.NoError(t, err)
+        require.Len(t, loads, 2)
+        assert.Equal(t, "loner", loads[0].ID)
+        assert.Equal(t, 0, loads[0].ReviewCapacity)
+        assert.Equal(t, 3, loads[1].ReviewCapacity)
+
+        pastDue := time.Now().Add(-time.Hour)
+        err = prRepo.CreateWithReviewers(ctx, &entity.PullRequest{
+            ID:                "pr-loner",
+            Name:              "Orphan feature",
+            AuthorID:          "loner",
+            Status:            entity.PROpen,
+            AssignedReviewers: []string{"member"},
+        }, &pastDue)
+        require.NoError(t, err)
+
+        overdue, err := prRepo.GetOverdueAssignments(ctx, time.Now(), 10)
+        require.NoError(t, err)
+        require.Len(t, overdue, 1, "PR автора без команды тоже эскалируется")
+        assert.Equal(t, "pr-loner", overdue[0].PullRequestID)
+        assert.Empty(t, overdue[0].AuthorTeam)
+    })
+
+    t.Run("SetIsActiveBatch", func(t *testing.T) {
+        testDB.CleanDatabase(t)
+
+        require.NoError(t, teamRepo.Create(ctx, &entity.Team{Name: "team1"}))
+        for _, id := range []string{"user2", "user1"} {
+            require.NoError(t, userRepo.Create(ctx, &entity.User{ID: id, Username: id, TeamName: "team1", IsActive: true}))
+        }
+
+        users, err := userRepo.SetIsActiveBatch(ctx, []string{"user2", "missing", "user1"}, false)
+        require.NoError(t, err)
+        require.Len(t, users, 2)
+        assert.Equal(t, "user1", users[0].ID)
+        assert.Equal(t, []string{"team1"}, users[0].Teams)
+        assert.False(t, users[1].IsActive)
+
+        candidates, err := userRepo.GetRandomActiveTeamUsers(ctx, "team1", nil, 2)
+        require.NoError(t, err)
+        assert.Empty(t, candidates)
+
+        users, err = userRepo.SetIsActiveBatch(ctx, nil, true)
+        require.NoError(t, err)
+        assert.Empty(t, users)
+    })
+
+    t.Run("Unavailability", func(t *testing.T) {
+        testDB.CleanDatabase(t)
+
+        require.NoError(t, teamRepo.Create(ctx, &entity.Team{Name: "team1"}))
+        for _, id := range []string{"user1", "user2"} {
+            require.NoError(t, userRepo.Create(ctx, &entity.User{ID: id, Username: id, TeamName: "team1", IsActive: true}))
+        }
+
+        now := time.Now()
+        current := &entity.Unavailability{
+            UserID:   "user1",
+            StartsAt: now.Add(-time.Hour),
+            EndsAt:   now.Add(48 * time.Hour),
+            Reason:   "Отпуск",
+        }
+        require.NoError(t, userRepo.AddUnavailability(ctx, current))
+        assert.NotZero(t, current.ID)
+
+        away, err := userRepo.GetByID(ctx, "user1")
+        require.NoError(t, err)
+        require.NotNil(t, away.UnavailableUntil)
+        assert.WithinDuration(t, current.EndsAt, *away.UnavailableUntil, time.Millisecond)
+        assert.True(t, away.IsActive, "недоступность не меняет is_active")
+
+        candidates, err := userRepo.GetRandomActiveTeamUsers(ctx, "team1", nil, 2)
+        require.NoError(t, err)
+        require.Len(t, candidates, 1, "недоступный пользователь не выбирается")
+        assert.Equal(t, "user2", candidates[0].ID)
+
+        imported := &entity.Unavailability{
+            UserID:      "user2",
+            StartsAt:    now.Add(24 * time.Hour),
+            EndsAt:      now.Add(72 * time.Hour),
+            ExternalUID: "vacation-1",
+        }
+        require.NoError(t, userRepo.AddUnavailability(ctx, imported))
+        reimported := *imported
+        reimported.Reason = "Отпуск перенесён"
+        reimported.EndsAt = now.Add(96 * time.Hour)
+        require.NoError(t, userRepo.AddUnavailability(ctx, &reimported))
+        assert.Equal(t, imported.ID, reimported.ID, "повторный импорт обновляет событие с тем же UID")
+
+        upcoming, err := userRepo.GetUpcomingUnavailability(ctx, []string{"user1", "user2"}, now)
+        require.NoError(t, err)
+        require.Len(t, upcoming, 2)
+        assert.Equal(t, "user1", upcoming[0].UserID)
+        assert.Equal(t, "Отпуск перенесён", upcoming[1].Reason)
+
+        err = userRepo.AddUnavailability(ctx, &entity.Unavailability{UserID: "user1", StartsAt: now, EndsAt: now})
+        assert.ErrorIs(t, err, domain.ErrInvalidUnavailability)
+        err = userRepo.AddUnavailability(ctx, &entity.Unavailability{UserID: "missing", StartsAt: now, EndsAt: now.Add(time.Hour)})
+        assert.ErrorIs(t, err, domain.ErrUserNotFound)
+
+        require.NoError(t, userRepo.DeleteUnavailability(ctx, "user1", current.ID))
+        err = userRepo.DeleteUnavailability(ctx, "user1", current.ID)
+        assert.ErrorIs(t, err, domain.ErrUnavailabilityNotFound)
+
+        back, err := userRepo.GetByID(ctx, "user1")
+        require.NoError(t, err)
+        assert.Nil(t, back.UnavailableUntil)
     })
 
     t.Run("PullRequestRepository", func(t *testing.T) {
@@ -189,7 +552,7 @@ func TestRepositories(t *testing.T) {
             Status:            entity.PROpen,
             AssignedReviewers: []string{"reviewer1"},
         }
-        err = prRepo.CreateWithReviewers(ctx, pr)
+        err = prRepo.CreateWithReviewers(ctx, pr, nil)
         require.NoError(t, err)
 
         fetched, err := prRepo.GetByID(ctx, "pr1")
@@ -215,6 +578,281 @@ func TestRepositories(t *testing.T) {
         require.NoError(t, err)
         assert.Len(t, prs, 1)
         assert.Equal(t, "pr1", prs[0].ID)
+
+        idle := &entity.User{ID: "reviewer2", Username: "idle", TeamName: "dev-team", IsActive: true}
+        err = userRepo.Create(ctx, idle)
+        require.NoError(t, err)
+
+        err = prRepo.CreateWithReviewers(ctx, &entity.PullRequest{
+            ID:                "pr2",
+            Name:              "Another feature",
+            AuthorID:          "author1",
+            Status:            entity.PROpen,
+            AssignedReviewers: []string{"reviewer1"},
+        }, nil)
+        require.NoError(t, err)
+
+        err = prRepo.ReplaceReviewer(ctx, "pr2", "reviewer1", "reviewer2", true, nil)
+        require.NoError(t, err)
+
+        replaced, err := prRepo.GetByID(ctx, "pr2")
+        require.NoError(t, err)
+        assert.Equal(t, []string{"reviewer2"}, replaced.AssignedReviewers)
+        assert.Equal(t, []string{"reviewer2"}, replaced.FallbackReviewers)
+
+        pastDue := time.Now().Add(-time.Hour)
+        err = prRepo.ReplaceReviewer(ctx, "pr2", "reviewer2", "reviewer1", false, &pastDue)
+        require.NoError(t, err)
+
+        overdue, err := prRepo.GetOverdueAssignments(ctx, time.Now(), 10)
+        require.NoError(t, err)
+        require.Len(t, overdue, 1, "у смерженного pr1 нет срока, а срок pr2 прошёл")
+        assert.Equal(t, "pr2", overdue[0].PullRequestID)
+        assert.Equal(t, "reviewer1", overdue[0].ReviewerID)
+        assert.Equal(t, "dev-team", overdue[0].AuthorTeam)
+
+        assigned, err := prRepo.GetByReviewer(ctx, "reviewer1")
+        require.NoError(t, err)
+        for _, assignedPr := range assigned {
+            if assignedPr.ID == "pr2" {
+                require.NotNil(t, assignedPr.ReviewDueAt)
+                assert.True(t, assignedPr.IsReviewOverdue(time.Now()))
+            } else {
+                assert.Nil(t, assignedPr.ReviewDueAt)
+            }
+        }
+
+        err = prRepo.RecordEscalationFailure(ctx, "pr2", "reviewer1", time.Now().Add(time.Hour))
+        require.NoError(t, err)
+        overdue, err = prRepo.GetOverdueAssignments(ctx, time.Now(), 10)
+        require.NoError(t, err)
+        assert.Empty(t, overdue, "неудачная эскалация ждёт повтора")
+        overdue, err = prRepo.GetOverdueAssignments(ctx, time.Now().Add(2*time.Hour), 10)
+        require.NoError(t, err)
+        require.Len(t, overdue, 1)
+        assert.Equal(t, 1, overdue[0].Attempts)
+
+        err = prRepo.MarkEscalated(ctx, "pr2", "reviewer1")
+        require.NoError(t, err)
+        overdue, err = prRepo.GetOverdueAssignments(ctx, time.Now(), 10)
+        require.NoError(t, err)
+        assert.Empty(t, overdue, "эскалированное назначение не возвращается повторно")
+
+        leastLoaded, err := userRepo.GetLeastLoadedActiveTeamUsers(ctx, "dev-team", []string{"author1"}, 1)
+        require.NoError(t, err)
+        require.Len(t, leastLoaded, 1)
+        assert.Equal(t, "reviewer2", leastLoaded[0].ID)
+
+        limit := 1
+        err = userRepo.SetMaxOpenReviews(ctx, "reviewer1", &limit)
+        require.NoError(t, err)
+
+        busy, err := userRepo.GetByID(ctx, "reviewer1")
+        require.NoError(t, err)
+        assert.Equal(t, 1, busy.OpenReviews)
+        assert.Equal(t, 1, busy.ReviewCapacity)
+        assert.False(t, busy.CanReview())
+
+        candidates, err := userRepo.GetRandomActiveTeamUsers(ctx, "dev-team", []string{"author1"}, 2)
+        require.NoError(t, err)
+        require.Len(t, candidates, 1)
+        assert.Equal(t, "reviewer2", candidates[0].ID)
+
+        err = userRepo.SetMaxOpenReviews(ctx, "reviewer1", nil)
+        require.NoError(t, err)
+        devTeam, err := teamRepo.GetByName(ctx, "dev-team")
+        require.NoError(t, err)
+        devTeam.DefaultMaxOpenReviews = 1
+        devTeam.MergePolicy = entity.MergePolicy{RequiredApprovals: 1, BlockOnChangesRequested: true}
+        err = teamRepo.Update(ctx, devTeam)
+        require.NoError(t, err)
+
+        devTeam, err = teamRepo.GetByName(ctx, "dev-team")
+        require.NoError(t, err)
+        assert.Equal(t, entity.MergePolicy{RequiredApprovals: 1, BlockOnChangesRequested: true}, devTeam.MergePolicy)
+
+        candidates, err = userRepo.GetRandomActiveTeamUsers(ctx, "dev-team", []string{"author1"}, 2)
+        require.NoError(t, err)
+        require.Len(t, candidates, 1)
+        assert.Equal(t, "reviewer2", candidates[0].ID)
+
+        err = prRepo.CreateWithReviewers(ctx, &entity.PullRequest{
+            ID:       "pr3",
+            Name:     "Draft feature",
+            AuthorID: "author1",
+            Status:   entity.PRDraft,
+        }, nil)
+        require.NoError(t, err)
+
+        all, err := prRepo.GetAll(ctx)
+        require.NoError(t, err)
+        assert.Len(t, all, 3, "PR без ревьюверов тоже читается")
+
+        err = prRepo.AssignReviewers(ctx, "pr3", []string{"reviewer2"}, nil, nil)
+        require.NoError(t, err)
+        err = prRepo.UpdateStatus(ctx, "pr3", entity.PRClosed)
+        require.NoError(t, err)
+
+        closed, err := prRepo.GetByID(ctx, "pr3")
+        require.NoError(t, err)
+        assert.Equal(t, entity.PRClosed, closed.Status)
+        assert.Equal(t, []string{"reviewer2"}, closed.AssignedReviewers)
+        assert.NotNil(t, closed.ClosedAt)
+
+        assert.Empty(t, replaced.Reviews)
+        err = prRepo.SubmitVerdict(ctx, "pr2", "reviewer1", entity.VerdictChangesRequested, "")
+        require.NoError(t, err)
+        err = prRepo.SubmitVerdict(ctx, "pr2", "reviewer1", entity.VerdictApproved, "looks good")
+        require.NoError(t, err)
+
+        reviewed, err := prRepo.GetByID(ctx, "pr2")
+        require.NoError(t, err)
+        require.Len(t, reviewed.Reviews, 1, "повторный вердикт заменяет предыдущий")
+        assert.Equal(t, "reviewer1", reviewed.Reviews[0].ReviewerID)
+        assert.Equal(t, entity.VerdictApproved, reviewed.Reviews[0].Verdict)
+        assert.Equal(t, "looks good", reviewed.Reviews[0].Comment)
+
+        err = prRepo.SubmitVerdict(ctx, "pr2", "reviewer2", entity.VerdictApproved, "")
+        assert.ErrorIs(t, err, domain.ErrReviewerNotAssigned)
+
+        assert.Nil(t, reviewed.MergeOverride)
+        err = prRepo.RecordMergeOverride(ctx, "pr2", "admin", []string{"0 of 1 required approvals"})
+        require.NoError(t, err)
+
+        overridden, err := prRepo.GetByID(ctx, "pr2")
+        require.NoError(t, err)
+        require.NotNil(t, overridden.MergeOverride)
+        assert.Equal(t, "admin", overridden.MergeOverride.By)
+        assert.Equal(t, []string{"0 of 1 required approvals"}, overridden.MergeOverride.Unmet)
+
+        overridden.Name = "Another feature, renamed"
+        overridden.URL = "https://git.example.com/pulls/2"
+        overridden.SourceBranch = "feature/another"
+        err = prRepo.UpdateDetails(ctx, overridden)
+        require.NoError(t, err)
+
+        renamed, err := prRepo.GetByID(ctx, "pr2")
+        require.NoError(t, err)
+        assert.Equal(t, "Another feature, renamed", renamed.Name)
+        assert.Equal(t, "https://git.example.com/pulls/2", renamed.URL)
+        assert.Equal(t, "feature/another", renamed.SourceBranch)
+        assert.Empty(t, renamed.Description)
+
+        firstPage, err := prRepo.List(ctx, repository.PullRequestFilter{TeamName: "dev-team"}, nil, 2)
+        require.NoError(t, err)
+        require.Len(t, firstPage, 2)
+        last := firstPage[1]
+        secondPage, err := prRepo.List(
+            ctx,
+            repository.PullRequestFilter{TeamName: "dev-team"},
+            &repository.PullRequestCursor{CreatedAt: last.CreatedAt, ID: last.ID},
+            2,
+        )
+        require.NoError(t, err)
+        require.Len(t, secondPage, 1)
+        assert.NotContains(t, []string{firstPage[0].ID, firstPage[1].ID}, secondPage[0].ID)
+
+        reviewedBy, err := prRepo.List(ctx, repository.PullRequestFilter{
+            ReviewerID: "reviewer2",
+            Statuses:   []entity.PullRequestStatus{entity.PRClosed},
+        }, nil, 10)
+        require.NoError(t, err)
+        require.Len(t, reviewedBy, 1)
+        assert.Equal(t, "pr3", reviewedBy[0].ID)
+        assert.Equal(t, []string{"reviewer2"}, reviewedBy[0].AssignedReviewers)
+
+        found, err := prRepo.Search(ctx, "feat", repository.PullRequestFilter{TeamName: "dev-team"}, 10)
+        require.NoError(t, err)
+        assert.Len(t, found, 3, "слово ищется как префикс")
+
+        found, err = prRepo.Search(ctx, "add FEAT", repository.PullRequestFilter{}, 10)
+        require.NoError(t, err)
+        require.Len(t, found, 1)
+        assert.Equal(t, "pr1", found[0].PullRequest.ID)
+        assert.Positive(t, found[0].Rank)
+        assert.Contains(t, found[0].Highlight, "<b>Add</b>")
+
+        found, err = prRepo.Search(ctx, "feature", repository.PullRequestFilter{
+            Statuses: []entity.PullRequestStatus{entity.PROpen},
+        }, 10)
+        require.NoError(t, err)
+        require.Len(t, found, 1)
+        assert.Equal(t, "pr2", found[0].PullRequest.ID)
+
+        found, err = prRepo.Search(ctx, "feature", repository.PullRequestFilter{
+            ReviewerID: "reviewer2",
+            Statuses:   []entity.PullRequestStatus{entity.PRClosed},
+        }, 10)
+        require.NoError(t, err)
+        require.Len(t, found, 1)
+        assert.Equal(t, "pr3", found[0].PullRequest.ID)
+
+        _, err = prRepo.Search(ctx, "!!!", repository.PullRequestFilter{}, 10)
+        assert.ErrorIs(t, err, domain.ErrInvalidSearchQuery)
+
+        err = prRepo.AppendEvents(ctx, []entity.ReviewerEvent{
+            {PullRequestID: "pr2", Type: entity.EventAssigned, ReviewerID: "reviewer1", ActorID: "author1", Reason: "pull request created"},
+            {PullRequestID: "pr2", Type: entity.EventReassigned, ReviewerID: "reviewer2", PreviousReviewerID: "reviewer1", Reason: "review overdue"},
+            {PullRequestID: "pr2", Type: entity.EventVerdict, ReviewerID: "reviewer2", ActorID: "reviewer2", Verdict: entity.VerdictApproved},
+        })
+        require.NoError(t, err)
+        history, err := prRepo.GetEvents(ctx, "pr2")
+        require.NoError(t, err)
+        require.Len(t, history, 3)
+        assert.Equal(t, entity.EventAssigned, history[0].Type)
+        assert.Equal(t, "author1", history[0].ActorID)
+        assert.Equal(t, "reviewer1", history[1].PreviousReviewerID)
+        assert.Empty(t, history[1].ActorID, "событие сервиса без инициатора")
+        assert.Equal(t, entity.VerdictApproved, history[2].Verdict)
+        assert.Less(t, history[0].ID, history[1].ID)
+
+        err = prRepo.AppendEvents(ctx, []entity.ReviewerEvent{
+            {PullRequestID: "pr2", Type: entity.EventClosed, Reason: "closed"},
+            {PullRequestID: "pr2", Type: entity.EventReopened, Reason: "reopened"},
+        })
+        require.NoError(t, err, "закрытие и переоткрытие пишутся в журнал")
+        history, err = prRepo.GetEvents(ctx, "pr2")
+        require.NoError(t, err)
+        require.Len(t, history, 5)
+        assert.Equal(t, entity.EventReopened, history[4].Type)
+        assert.Empty(t, history[4].ReviewerID)
+
+        err = prRepo.AppendEvents(ctx, []entity.ReviewerEvent{{PullRequestID: "missing", Type: entity.EventMerged}})
+        assert.ErrorIs(t, err, domain.ErrPullRequestNotFound)
+
+        err = userRepo.CheckUsersAvailableForTeam(ctx, []string{"reviewer1", "author1"}, "qa")
+        assert.NoError(t, err, "ревью назначены не за эту команду")
+        err = userRepo.CheckUsersAvailableForTeam(ctx, []string{"reviewer1", "author1"}, "dev-team")
+        var blocked *domain.TeamChangeBlockedError
+        require.ErrorAs(t, err, &blocked)
+        assert.ErrorIs(t, err, domain.ErrUserHasActiveAssignments)
+        assert.Contains(t, blocked.Reviews, domain.BlockingReview{UserID: "reviewer1", PullRequestID: "pr2"})
+        for _, review := range blocked.Reviews {
+            assert.NotEqual(t, "author1", review.UserID, "автор без ревью не блокируется")
+            assert.NotEqual(t, "pr1", review.PullRequestID, "смерженный PR не блокирует")
+        }
+    })
+
+    t.Run("OwnershipRepository", func(t *testing.T) {
+        testDB.CleanDatabase(t)
+
+        rules := []entity.OwnershipRule{
+            {Pattern: "*", TeamNames: []string{"backend"}},
+            {Pattern: "*.sql", UserIDs: []string{"u3"}},
+        }
+        err := ownershipRepo.ReplaceAll(ctx, rules)
+        require.NoError(t, err)
+
+        err = ownershipRepo.ReplaceAll(ctx, rules)
+        require.NoError(t, err)
+
+        fetched, err := ownershipRepo.GetAll(ctx)
+        require.NoError(t, err)
+        require.Len(t, fetched, 2)
+        assert.Equal(t, "*", fetched[0].Pattern)
+        assert.Equal(t, []string{"backend"}, fetched[0].TeamNames)
+        assert.Empty(t, fetched[0].UserIDs)
+        assert.Equal(t, []string{"u3"}, fetched[1].UserIDs)
     })
 
     t.Run("Transactor", func(t *testing.T) {
@@ -239,5 +877,24 @@ func TestRepositories(t *testing.T) {
         user, err := userRepo.GetByID(ctx, "tx-user")
         require.NoError(t, err)
         assert.Equal(t, "tx-user", user.Username)
+
+        err = transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+            if _, err := userRepo.SetIsActive(ctx, "tx-user", true); err != nil {
+                return err
+            }
+            nestedErr := transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+                if _, err := userRepo.SetIsActive(ctx, "tx-user", false); err != nil {
+                    return err
+                }
+                return domain.ErrNoReviewerCandidate
+            })
+            assert.ErrorIs(t, nestedErr, domain.ErrNoReviewerCandidate)
+            return nil
+        })
+        require.NoError(t, err)
+
+        user, err = userRepo.GetByID(ctx, "tx-user")
+        require.NoError(t, err)
+        assert.True(t, user.IsActive, "вложенная транзакция откатывается до точки сохранения")
     })
 }
@@ -0,0 +1,78 @@
+package postgres
+
+import (
+    "context"
+    "fmt"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
+    "github.com/kimvlry/avito-internship-assignment/internal/domain/repository"
+)
+
+type ownershipRepository struct {
+    db *DB
+}
+
+func NewOwnershipRepository(db *DB) repository.OwnershipRepository {
+    return &ownershipRepository{db: db}
+}
+
+func (r *ownershipRepository) ReplaceAll(ctx context.Context, rules []entity.OwnershipRule) error {
+    deleteQuery := `
+		DELETE FROM ownership_rules
+	`
+    insertQuery := `
+		INSERT INTO ownership_rules (position, pattern, user_ids, team_names)
+		VALUES ($1, $2, $3, $4)
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    if _, err := querier.Exec(ctx, deleteQuery); err != nil {
+        return fmt.Errorf("exec delete ownership rules: %w", err)
+    }
+
+    for i, rule := range rules {
+        _, err := querier.Exec(ctx, insertQuery, i+1, rule.Pattern, nonNil(rule.UserIDs), nonNil(rule.TeamNames))
+        if err != nil {
+            return fmt.Errorf("exec insert ownership rule: %w", err)
+        }
+    }
+    return nil
+}
+
+func (r *ownershipRepository) GetAll(ctx context.Context) ([]entity.OwnershipRule, error) {
+    query := `
+		SELECT pattern, user_ids, team_names
+		FROM ownership_rules
+		ORDER BY position
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    rows, err := querier.Query(ctx, query)
+    if err != nil {
+        return nil, fmt.Errorf("query ownership rules: %w", err)
+    }
+    defer rows.Close()
+
+    var rules []entity.OwnershipRule
+    for rows.Next() {
+        var rule entity.OwnershipRule
+        if err := rows.Scan(&rule.Pattern, &rule.UserIDs, &rule.TeamNames); err != nil {
+            return nil, fmt.Errorf("scan ownership rule: %w", err)
+        }
+        rules = append(rules, rule)
+    }
+
+    if err := rows.Err(); err != nil {
+        return nil, fmt.Errorf("rows error: %w", err)
+    }
+    return rules, nil
+}
+
+// nonNil keeps NOT NULL array columns from receiving NULL for empty slices
+func nonNil(values []string) []string {
+    if values == nil {
+        return []string{}
+    }
+    return values
+}
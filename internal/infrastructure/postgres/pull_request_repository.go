@@ -4,6 +4,11 @@ import (
     "context"
     "errors"
     "fmt"
+    "strings"
+    "time"
+    "unicode"
+
+    "github.com/Masterminds/squirrel"
     "github.com/jackc/pgx/v5"
     "github.com/kimvlry/avito-internship-assignment/internal/domain"
     "github.com/kimvlry/avito-internship-assignment/pkg/logger"
@@ -23,6 +28,7 @@ func NewPullRequestRepository(db *DB) repository.PullRequestRepository {
 func (r *pullRequestRepository) CreateWithReviewers(
     ctx context.Context,
     pr *entity.PullRequest,
+    dueAt *time.Time,
 ) error {
     query := `
 		WITH inserted_pr AS (
@@ -30,9 +36,9 @@ func (r *pullRequestRepository) CreateWithReviewers(
 			VALUES ($1, $2, $3, $4, $5)
 			RETURNING pull_request_id
 		)
-		INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id)
-		SELECT inserted_pr.pull_request_id, unnest($6::text[])
-		FROM inserted_pr
+		INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id, is_fallback, due_at)
+		SELECT inserted_pr.pull_request_id, r.reviewer_id, COALESCE(r.reviewer_id = ANY($7::text[]), false), $8
+		FROM inserted_pr, unnest($6::text[]) AS r(reviewer_id)
 	`
 
     querier := r.db.GetQuerier(ctx)
@@ -44,6 +50,8 @@ func (r *pullRequestRepository) CreateWithReviewers(
         pr.Status,
         pr.CreatedAt,
         pr.AssignedReviewers,
+        pr.FallbackReviewers,
+        dueAt,
     )
 
     if err != nil {
@@ -66,15 +74,28 @@ func (r *pullRequestRepository) GetByID(
 		SELECT 
 			pr.pull_request_id,
 			pr.pull_request_name,
+			COALESCE(pr.description, ''),
+			COALESCE(pr.url, ''),
+			COALESCE(pr.source_branch, ''),
+			COALESCE(pr.target_branch, ''),
+			COALESCE(pr.external_id, ''),
 			pr.author_id,
 			pr.status,
 			pr.created_at,
 			pr.merged_at,
+			pr.closed_at,
+			pr.merge_override_by,
+			COALESCE(pr.merge_override_unmet, '{}'),
 			COALESCE(
 				array_agg(prr.reviewer_id) 
 				FILTER (WHERE prr.reviewer_id IS NOT NULL), 
 				'{}'
-			) as reviewers
+			) as reviewers,
+			COALESCE(
+				array_agg(prr.reviewer_id)
+				FILTER (WHERE prr.is_fallback),
+				'{}'
+			) as fallback_reviewers
 		FROM pull_requests pr
 		LEFT JOIN pull_request_reviewers prr ON pr.pull_request_id = prr.pull_request_id
 		WHERE pr.pull_request_id = $1
@@ -84,14 +105,25 @@ func (r *pullRequestRepository) GetByID(
     querier := r.db.GetQuerier(ctx)
 
     var pr entity.PullRequest
+    var overrideBy *string
+    var overrideUnmet []string
     err := querier.QueryRow(ctx, query, id).Scan(
         &pr.ID,
         &pr.Name,
+        &pr.Description,
+        &pr.URL,
+        &pr.SourceBranch,
+        &pr.TargetBranch,
+        &pr.ExternalID,
         &pr.AuthorID,
         &pr.Status,
         &pr.CreatedAt,
         &pr.MergedAt,
+        &pr.ClosedAt,
+        &overrideBy,
+        &overrideUnmet,
         &pr.AssignedReviewers,
+        &pr.FallbackReviewers,
     )
 
     if err != nil {
@@ -100,9 +132,77 @@ func (r *pullRequestRepository) GetByID(
         }
         return nil, fmt.Errorf("query pr by id: %w", err)
     }
+    if overrideBy != nil {
+        pr.MergeOverride = &entity.MergeOverride{By: *overrideBy, Unmet: overrideUnmet}
+    }
+
+    pr.Reviews, err = r.getReviews(ctx, id)
+    if err != nil {
+        return nil, err
+    }
     return &pr, nil
 }
 
+func (r *pullRequestRepository) getReviews(ctx context.Context, prID string) ([]entity.Review, error) {
+    query := `
+		SELECT reviewer_id, verdict, COALESCE(verdict_comment, ''), verdict_at
+		FROM pull_request_reviewers
+		WHERE pull_request_id = $1 AND verdict IS NOT NULL
+		ORDER BY verdict_at
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    rows, err := querier.Query(ctx, query, prID)
+    if err != nil {
+        return nil, fmt.Errorf("query pr reviews: %w", err)
+    }
+    defer rows.Close()
+
+    reviews := make([]entity.Review, 0)
+    for rows.Next() {
+        var review entity.Review
+        if err := rows.Scan(&review.ReviewerID, &review.Verdict, &review.Comment, &review.SubmittedAt); err != nil {
+            return nil, fmt.Errorf("scan review: %w", err)
+        }
+        reviews = append(reviews, review)
+    }
+
+    if err := rows.Err(); err != nil {
+        return nil, fmt.Errorf("rows error: %w", err)
+    }
+    return reviews, nil
+}
+
+func (r *pullRequestRepository) SubmitVerdict(
+    ctx context.Context,
+    prID, reviewerID string,
+    verdict entity.ReviewVerdict,
+    comment string,
+) error {
+    query := `
+		UPDATE pull_request_reviewers
+		SET verdict = $3, verdict_comment = NULLIF($4, ''), verdict_at = NOW()
+		WHERE pull_request_id = $1 AND reviewer_id = $2
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    result, err := querier.Exec(ctx, query, prID, reviewerID, string(verdict), comment)
+    if err != nil {
+        if isPgCheckViolation(err) {
+            return domain.ErrInvalidReviewVerdict
+        }
+        return fmt.Errorf("exec submit verdict: %w", err)
+    }
+
+    if result.RowsAffected() == 0 {
+        return domain.ErrReviewerNotAssigned
+    }
+
+    return nil
+}
+
 func (r *pullRequestRepository) Exists(ctx context.Context, id string) (bool, error) {
     query := `
 		SELECT EXISTS(
@@ -121,6 +221,40 @@ func (r *pullRequestRepository) Exists(ctx context.Context, id string) (bool, er
     return exists, nil
 }
 
+func (r *pullRequestRepository) UpdateDetails(ctx context.Context, pr *entity.PullRequest) error {
+    query := `
+		UPDATE pull_requests
+		SET pull_request_name = $2,
+			description = NULLIF($3, ''),
+			url = NULLIF($4, ''),
+			source_branch = NULLIF($5, ''),
+			target_branch = NULLIF($6, ''),
+			external_id = NULLIF($7, '')
+		WHERE pull_request_id = $1
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    result, err := querier.Exec(ctx, query,
+        pr.ID,
+        pr.Name,
+        pr.Description,
+        pr.URL,
+        pr.SourceBranch,
+        pr.TargetBranch,
+        pr.ExternalID,
+    )
+    if err != nil {
+        return fmt.Errorf("exec update pr details: %w", err)
+    }
+
+    if result.RowsAffected() == 0 {
+        return domain.ErrPullRequestNotFound
+    }
+
+    return nil
+}
+
 func (r *pullRequestRepository) UpdateStatus(
     ctx context.Context,
     prID string,
@@ -129,7 +263,8 @@ func (r *pullRequestRepository) UpdateStatus(
     query := `
         UPDATE pull_requests
         SET status = $2::varchar, 
-            merged_at = CASE WHEN $2::varchar = 'MERGED' THEN NOW() ELSE merged_at END
+            merged_at = CASE WHEN $2::varchar = 'MERGED' THEN NOW() ELSE merged_at END,
+            closed_at = CASE WHEN $2::varchar = 'CLOSED' THEN NOW() END
         WHERE pull_request_id = $1
     `
 
@@ -147,9 +282,36 @@ func (r *pullRequestRepository) UpdateStatus(
     return nil
 }
 
+func (r *pullRequestRepository) RecordMergeOverride(
+    ctx context.Context,
+    prID, actorID string,
+    unmet []string,
+) error {
+    query := `
+		UPDATE pull_requests
+		SET merge_override_by = $2, merge_override_unmet = $3
+		WHERE pull_request_id = $1
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    result, err := querier.Exec(ctx, query, prID, actorID, unmet)
+    if err != nil {
+        return fmt.Errorf("exec record merge override: %w", err)
+    }
+
+    if result.RowsAffected() == 0 {
+        return domain.ErrPullRequestNotFound
+    }
+
+    return nil
+}
+
 func (r *pullRequestRepository) ReplaceReviewer(
     ctx context.Context,
     prID, oldUserID, newUserID string,
+    isFallback bool,
+    dueAt *time.Time,
 ) error {
     query := `
 		WITH deleted AS (
@@ -157,14 +319,14 @@ func (r *pullRequestRepository) ReplaceReviewer(
 			WHERE pull_request_id = $1 AND reviewer_id = $2
 			RETURNING pull_request_id
 		)
-		INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id)
-		SELECT pull_request_id, $3
+		INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id, is_fallback, due_at)
+		SELECT pull_request_id, $3, $4, $5
 		FROM deleted
 	`
 
     querier := r.db.GetQuerier(ctx)
 
-    result, err := querier.Exec(ctx, query, prID, oldUserID, newUserID)
+    result, err := querier.Exec(ctx, query, prID, oldUserID, newUserID, isFallback, dueAt)
     if err != nil {
         if isPgForeignKeyViolation(err) {
             return domain.ErrUserNotFound
@@ -179,6 +341,50 @@ func (r *pullRequestRepository) ReplaceReviewer(
     return nil
 }
 
+func (r *pullRequestRepository) AssignReviewers(
+    ctx context.Context,
+    prID string,
+    reviewerIDs, fallbackIDs []string,
+    dueAt *time.Time,
+) error {
+    query := `
+		INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id, is_fallback, due_at)
+		SELECT $1, r.reviewer_id, COALESCE(r.reviewer_id = ANY($3::text[]), false), $4
+		FROM unnest($2::text[]) AS r(reviewer_id)
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    _, err := querier.Exec(ctx, query, prID, reviewerIDs, fallbackIDs, dueAt)
+    if err != nil {
+        if isPgForeignKeyViolation(err) {
+            return domain.ErrUserNotFound
+        }
+        return fmt.Errorf("exec assign reviewers: %w", err)
+    }
+    return nil
+}
+
+func (r *pullRequestRepository) RemoveReviewer(ctx context.Context, prID, userID string) error {
+    query := `
+		DELETE FROM pull_request_reviewers
+		WHERE pull_request_id = $1 AND reviewer_id = $2
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    result, err := querier.Exec(ctx, query, prID, userID)
+    if err != nil {
+        return fmt.Errorf("exec remove reviewer: %w", err)
+    }
+
+    if result.RowsAffected() == 0 {
+        return domain.ErrReviewerNotAssigned
+    }
+
+    return nil
+}
+
 func (r *pullRequestRepository) GetByReviewer(
     ctx context.Context,
     userID string,
@@ -191,7 +397,14 @@ func (r *pullRequestRepository) GetByReviewer(
 			pr.status,
 			pr.created_at,
 			pr.merged_at,
-			array_agg(prr.reviewer_id) as reviewers
+			pr.closed_at,
+			array_agg(prr.reviewer_id) as reviewers,
+			COALESCE(
+				array_agg(prr.reviewer_id)
+				FILTER (WHERE prr.is_fallback),
+				'{}'
+			) as fallback_reviewers,
+			MAX(prr.due_at) FILTER (WHERE prr.reviewer_id = $1) as review_due_at
 		FROM pull_requests pr
 		JOIN pull_request_reviewers prr ON pr.pull_request_id = prr.pull_request_id
 		WHERE EXISTS (
@@ -213,9 +426,32 @@ func (r *pullRequestRepository) GetByReviewer(
     }
     defer rows.Close()
 
-    prs, err := scanPullRequests(rows)
+    var prs []*entity.PullRequest
+    for rows.Next() {
+        var pr entity.PullRequest
+        err := rows.Scan(
+            &pr.ID,
+            &pr.Name,
+            &pr.AuthorID,
+            &pr.Status,
+            &pr.CreatedAt,
+            &pr.MergedAt,
+            &pr.ClosedAt,
+            &pr.AssignedReviewers,
+            &pr.FallbackReviewers,
+            &pr.ReviewDueAt,
+        )
+        if err != nil {
+            return nil, fmt.Errorf("scan pr: %w", err)
+        }
+        prs = append(prs, &pr)
+    }
+    if err := rows.Err(); err != nil {
+        return nil, fmt.Errorf("rows error: %w", err)
+    }
+
     logger.Debug(ctx, fmt.Sprintf("rows affected by GetByReview query: %v", len(prs)))
-    return prs, err
+    return prs, nil
 }
 
 func scanPullRequests(rows pgx.Rows) ([]*entity.PullRequest, error) {
@@ -230,7 +466,9 @@ func scanPullRequests(rows pgx.Rows) ([]*entity.PullRequest, error) {
             &pr.Status,
             &pr.CreatedAt,
             &pr.MergedAt,
+            &pr.ClosedAt,
             &pr.AssignedReviewers,
+            &pr.FallbackReviewers,
         )
         if err != nil {
             return nil, fmt.Errorf("scan pr: %w", err)
@@ -245,6 +483,183 @@ func scanPullRequests(rows pgx.Rows) ([]*entity.PullRequest, error) {
     return prs, nil
 }
 
+func (r *pullRequestRepository) List(
+    ctx context.Context,
+    filter repository.PullRequestFilter,
+    after *repository.PullRequestCursor,
+    limit int,
+) ([]*entity.PullRequest, error) {
+    page := r.db.QueryBuilder().
+        Select(
+            "pull_request_id",
+            "pull_request_name",
+            "author_id",
+            "status",
+            "created_at",
+            "merged_at",
+            "closed_at",
+        ).
+        From("pull_requests").
+        OrderBy("created_at DESC", "pull_request_id DESC").
+        Limit(uint64(limit))
+
+    page = withPullRequestFilter(page, filter)
+    if after != nil {
+        page = page.Where("(created_at, pull_request_id) < (?, ?)", after.CreatedAt, after.ID)
+    }
+
+    // reviewers are aggregated for the page only, so the ordered scan can stop at the limit
+    query, args, err := r.db.QueryBuilder().
+        Select(
+            "pr.pull_request_id",
+            "pr.pull_request_name",
+            "pr.author_id",
+            "pr.status",
+            "pr.created_at",
+            "pr.merged_at",
+            "pr.closed_at",
+            "COALESCE(array_agg(prr.reviewer_id) FILTER (WHERE prr.reviewer_id IS NOT NULL), '{}') AS reviewers",
+            "COALESCE(array_agg(prr.reviewer_id) FILTER (WHERE prr.is_fallback), '{}') AS fallback_reviewers",
+        ).
+        FromSelect(page, "pr").
+        LeftJoin("pull_request_reviewers prr ON pr.pull_request_id = prr.pull_request_id").
+        GroupBy(
+            "pr.pull_request_id",
+            "pr.pull_request_name",
+            "pr.author_id",
+            "pr.status",
+            "pr.created_at",
+            "pr.merged_at",
+            "pr.closed_at",
+        ).
+        OrderBy("pr.created_at DESC", "pr.pull_request_id DESC").
+        ToSql()
+    if err != nil {
+        return nil, fmt.Errorf("build query: %w", err)
+    }
+
+    rows, err := r.db.GetQuerier(ctx).Query(ctx, query, args...)
+    if err != nil {
+        return nil, fmt.Errorf("query pr page: %w", err)
+    }
+    defer rows.Close()
+
+    return scanPullRequests(rows)
+}
+
+func (r *pullRequestRepository) Search(
+    ctx context.Context,
+    text string,
+    filter repository.PullRequestFilter,
+    limit int,
+) ([]repository.PullRequestSearchHit, error) {
+    tsQuery := prefixTsQuery(text)
+    if tsQuery == "" {
+        return nil, fmt.Errorf("%w: no words to search for", domain.ErrInvalidSearchQuery)
+    }
+
+    qb := r.db.QueryBuilder().
+        Select(
+            "pull_request_id",
+            "pull_request_name",
+            "author_id",
+            "status",
+            "created_at",
+            "ts_rank(search_vector, q.query) AS rank",
+            `ts_headline(
+				'simple',
+				pull_request_name || COALESCE(' ' || description, ''),
+				q.query,
+				'StartSel=<b>, StopSel=</b>, MaxFragments=2, MinWords=5, MaxWords=20'
+			) AS highlight`,
+        ).
+        From("pull_requests").
+        JoinClause("CROSS JOIN to_tsquery('simple', ?) AS q(query)", tsQuery).
+        Where("search_vector @@ q.query").
+        OrderBy("rank DESC", "created_at DESC", "pull_request_id DESC").
+        Limit(uint64(limit))
+    qb = withPullRequestFilter(qb, filter)
+
+    query, args, err := qb.ToSql()
+    if err != nil {
+        return nil, fmt.Errorf("build query: %w", err)
+    }
+
+    rows, err := r.db.GetQuerier(ctx).Query(ctx, query, args...)
+    if err != nil {
+        return nil, fmt.Errorf("query pr search: %w", err)
+    }
+    defer rows.Close()
+
+    hits := make([]repository.PullRequestSearchHit, 0)
+    for rows.Next() {
+        var pr entity.PullRequest
+        var hit repository.PullRequestSearchHit
+        err := rows.Scan(
+            &pr.ID,
+            &pr.Name,
+            &pr.AuthorID,
+            &pr.Status,
+            &pr.CreatedAt,
+            &hit.Rank,
+            &hit.Highlight,
+        )
+        if err != nil {
+            return nil, fmt.Errorf("scan search hit: %w", err)
+        }
+        hit.PullRequest = &pr
+        hits = append(hits, hit)
+    }
+
+    if err := rows.Err(); err != nil {
+        return nil, fmt.Errorf("rows error: %w", err)
+    }
+    return hits, nil
+}
+
+// prefixTsQuery turns free text into a tsquery matching every word as a prefix.
+// Anything but letters and digits separates words, so the result is safe for to_tsquery
+func prefixTsQuery(text string) string {
+    words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+        return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+    })
+    for i, word := range words {
+        words[i] = word + ":*"
+    }
+    return strings.Join(words, " & ")
+}
+
+// withPullRequestFilter narrows a query over pull_requests by the filter
+func withPullRequestFilter(qb squirrel.SelectBuilder, filter repository.PullRequestFilter) squirrel.SelectBuilder {
+    if len(filter.Statuses) > 0 {
+        statuses := make([]string, 0, len(filter.Statuses))
+        for _, status := range filter.Statuses {
+            statuses = append(statuses, string(status))
+        }
+        qb = qb.Where(squirrel.Eq{"status": statuses})
+    }
+    if filter.AuthorID != "" {
+        qb = qb.Where(squirrel.Eq{"author_id": filter.AuthorID})
+    }
+    if filter.TeamName != "" {
+        qb = qb.Where("author_id IN (SELECT user_id FROM team_members WHERE team_name = ?)", filter.TeamName)
+    }
+    if filter.ReviewerID != "" {
+        qb = qb.Where(`EXISTS (
+			SELECT 1
+			FROM pull_request_reviewers f
+			WHERE f.pull_request_id = pull_requests.pull_request_id AND f.reviewer_id = ?
+		)`, filter.ReviewerID)
+    }
+    if filter.CreatedFrom != nil {
+        qb = qb.Where(squirrel.GtOrEq{"created_at": *filter.CreatedFrom})
+    }
+    if filter.CreatedTo != nil {
+        qb = qb.Where(squirrel.Lt{"created_at": *filter.CreatedTo})
+    }
+    return qb
+}
+
 func (r *pullRequestRepository) GetAll(ctx context.Context) ([]*entity.PullRequest, error) {
     query := `
         SELECT 
@@ -254,7 +669,17 @@ func (r *pullRequestRepository) GetAll(ctx context.Context) ([]*entity.PullReque
             pr.status,
             pr.created_at,
             pr.merged_at,
-            array_agg(prr.reviewer_id) as reviewers
+            pr.closed_at,
+            COALESCE(
+                array_agg(prr.reviewer_id)
+                FILTER (WHERE prr.reviewer_id IS NOT NULL),
+                '{}'
+            ) as reviewers,
+            COALESCE(
+                array_agg(prr.reviewer_id)
+                FILTER (WHERE prr.is_fallback),
+                '{}'
+            ) as fallback_reviewers
         FROM pull_requests pr
         LEFT JOIN pull_request_reviewers prr ON pr.pull_request_id = prr.pull_request_id
         GROUP BY pr.pull_request_id
@@ -268,3 +693,192 @@ func (r *pullRequestRepository) GetAll(ctx context.Context) ([]*entity.PullReque
 
     return scanPullRequests(rows)
 }
+
+func (r *pullRequestRepository) GetOverdueAssignments(
+    ctx context.Context,
+    now time.Time,
+    limit int,
+) ([]repository.OverdueAssignment, error) {
+    query := `
+		SELECT prr.pull_request_id, prr.reviewer_id, COALESCE(tm.team_name, ''), prr.due_at, prr.escalation_attempts
+		FROM pull_request_reviewers prr
+		JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+		LEFT JOIN team_members tm ON tm.user_id = pr.author_id AND tm.is_primary
+		WHERE prr.due_at < $1
+		  AND prr.escalated_at IS NULL
+		  AND (prr.next_escalation_at IS NULL OR prr.next_escalation_at <= $1)
+		  AND pr.status = 'OPEN'
+		ORDER BY prr.due_at, prr.pull_request_id, prr.reviewer_id
+		LIMIT $2
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    rows, err := querier.Query(ctx, query, now, limit)
+    if err != nil {
+        return nil, fmt.Errorf("query overdue assignments: %w", err)
+    }
+    defer rows.Close()
+
+    assignments := make([]repository.OverdueAssignment, 0)
+    for rows.Next() {
+        var a repository.OverdueAssignment
+        if err := rows.Scan(&a.PullRequestID, &a.ReviewerID, &a.AuthorTeam, &a.DueAt, &a.Attempts); err != nil {
+            return nil, fmt.Errorf("scan overdue assignment: %w", err)
+        }
+        assignments = append(assignments, a)
+    }
+
+    if err := rows.Err(); err != nil {
+        return nil, fmt.Errorf("rows error: %w", err)
+    }
+    return assignments, nil
+}
+
+func (r *pullRequestRepository) MarkEscalated(ctx context.Context, prID, reviewerID string) error {
+    query := `
+		UPDATE pull_request_reviewers
+		SET escalated_at = NOW()
+		WHERE pull_request_id = $1 AND reviewer_id = $2
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    result, err := querier.Exec(ctx, query, prID, reviewerID)
+    if err != nil {
+        return fmt.Errorf("exec mark escalated: %w", err)
+    }
+
+    if result.RowsAffected() == 0 {
+        return domain.ErrReviewerNotAssigned
+    }
+    return nil
+}
+
+func (r *pullRequestRepository) RecordEscalationFailure(
+    ctx context.Context,
+    prID,
+    reviewerID string,
+    retryAt time.Time,
+) error {
+    query := `
+		UPDATE pull_request_reviewers
+		SET escalation_attempts = escalation_attempts + 1,
+			next_escalation_at = $3
+		WHERE pull_request_id = $1 AND reviewer_id = $2
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    result, err := querier.Exec(ctx, query, prID, reviewerID, retryAt)
+    if err != nil {
+        return fmt.Errorf("exec record escalation failure: %w", err)
+    }
+
+    if result.RowsAffected() == 0 {
+        return domain.ErrReviewerNotAssigned
+    }
+    return nil
+}
+
+func (r *pullRequestRepository) AppendEvents(ctx context.Context, events []entity.ReviewerEvent) error {
+    if len(events) == 0 {
+        return nil
+    }
+
+    query := `
+		INSERT INTO pull_request_reviewer_events (
+			pull_request_id,
+			event_type,
+			reviewer_id,
+			previous_reviewer_id,
+			actor_id,
+			reason,
+			verdict
+		)
+		SELECT
+			e.pull_request_id,
+			e.event_type,
+			NULLIF(e.reviewer_id, ''),
+			NULLIF(e.previous_reviewer_id, ''),
+			NULLIF(e.actor_id, ''),
+			e.reason,
+			NULLIF(e.verdict, '')
+		FROM unnest($1::text[], $2::text[], $3::text[], $4::text[], $5::text[], $6::text[], $7::text[])
+			WITH ORDINALITY AS e(pull_request_id, event_type, reviewer_id, previous_reviewer_id, actor_id, reason, verdict, position)
+		ORDER BY e.position
+	`
+
+    var prIDs, types, reviewerIDs, previousIDs, actorIDs, reasons, verdicts []string
+    for _, event := range events {
+        prIDs = append(prIDs, event.PullRequestID)
+        types = append(types, string(event.Type))
+        reviewerIDs = append(reviewerIDs, event.ReviewerID)
+        previousIDs = append(previousIDs, event.PreviousReviewerID)
+        actorIDs = append(actorIDs, event.ActorID)
+        reasons = append(reasons, event.Reason)
+        verdicts = append(verdicts, string(event.Verdict))
+    }
+
+    querier := r.db.GetQuerier(ctx)
+
+    _, err := querier.Exec(ctx, query, prIDs, types, reviewerIDs, previousIDs, actorIDs, reasons, verdicts)
+    if err != nil {
+        if isPgForeignKeyViolation(err) {
+            return domain.ErrPullRequestNotFound
+        }
+        return fmt.Errorf("exec append reviewer events: %w", err)
+    }
+    return nil
+}
+
+func (r *pullRequestRepository) GetEvents(ctx context.Context, prID string) ([]entity.ReviewerEvent, error) {
+    query := `
+		SELECT
+			event_id,
+			pull_request_id,
+			event_type,
+			COALESCE(reviewer_id, ''),
+			COALESCE(previous_reviewer_id, ''),
+			COALESCE(actor_id, ''),
+			reason,
+			COALESCE(verdict, ''),
+			created_at
+		FROM pull_request_reviewer_events
+		WHERE pull_request_id = $1
+		ORDER BY event_id
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    rows, err := querier.Query(ctx, query, prID)
+    if err != nil {
+        return nil, fmt.Errorf("query reviewer events: %w", err)
+    }
+    defer rows.Close()
+
+    events := make([]entity.ReviewerEvent, 0)
+    for rows.Next() {
+        var event entity.ReviewerEvent
+        err := rows.Scan(
+            &event.ID,
+            &event.PullRequestID,
+            &event.Type,
+            &event.ReviewerID,
+            &event.PreviousReviewerID,
+            &event.ActorID,
+            &event.Reason,
+            &event.Verdict,
+            &event.CreatedAt,
+        )
+        if err != nil {
+            return nil, fmt.Errorf("scan reviewer event: %w", err)
+        }
+        events = append(events, event)
+    }
+
+    if err := rows.Err(); err != nil {
+        return nil, fmt.Errorf("rows error: %w", err)
+    }
+    return events, nil
+}
@@ -20,25 +20,83 @@ func NewTeamRepository(db *DB) repository.TeamRepository {
 
 func (r *teamRepository) Create(ctx context.Context, team *entity.Team) error {
     query := `
-		INSERT INTO teams (name)
-		VALUES ($1)
+		INSERT INTO teams (
+			name,
+			reviewer_strategy,
+			min_reviewers,
+			max_reviewers,
+			default_max_open_reviews,
+			required_approvals,
+			block_on_changes_requested,
+			review_sla_hours,
+			review_sla_business_hours,
+			review_sla_workday_start,
+			review_sla_workday_end,
+			review_sla_timezone,
+			escalation_policy,
+			parent_name
+		)
+		VALUES (
+			$1, COALESCE(NULLIF($2, ''), 'random'), $3, COALESCE(NULLIF($4, 0), 2), $5, $6, $7,
+			$8, $9, CASE WHEN $11 = 0 THEN 9 ELSE $10 END, COALESCE(NULLIF($11, 0), 18), COALESCE(NULLIF($12, ''), 'UTC'),
+			COALESCE(NULLIF($13, ''), 'reassign'), NULLIF($14, '')
+		)
 	`
 
     querier := r.db.GetQuerier(ctx)
 
-    _, err := querier.Exec(ctx, query, team.Name)
+    _, err := querier.Exec(ctx, query,
+        team.Name,
+        string(team.ReviewerStrategy),
+        team.MinReviewers,
+        team.MaxReviewers,
+        team.DefaultMaxOpenReviews,
+        team.MergePolicy.RequiredApprovals,
+        team.MergePolicy.BlockOnChangesRequested,
+        team.ReviewSLA.Hours,
+        team.ReviewSLA.BusinessHours,
+        team.ReviewSLA.WorkdayStart,
+        team.ReviewSLA.WorkdayEnd,
+        team.ReviewSLA.TimeZone,
+        string(team.ReviewSLA.Escalation),
+        team.ParentName,
+    )
     if err != nil {
         if isPgUniqueViolation(err) {
             return domain.ErrTeamAlreadyExists
         }
+        if isPgForeignKeyViolation(err) {
+            return fmt.Errorf("%w: parent team %s", domain.ErrTeamNotFound, team.ParentName)
+        }
         return fmt.Errorf("exec create team: %w", err)
     }
-    return nil
+    return r.setFallbackTeams(ctx, team)
 }
 
 func (r *teamRepository) GetByName(ctx context.Context, name string) (*entity.Team, error) {
     query := `
-		SELECT name
+		SELECT
+			name,
+			reviewer_strategy,
+			min_reviewers,
+			max_reviewers,
+			default_max_open_reviews,
+			required_approvals,
+			block_on_changes_requested,
+			review_sla_hours,
+			review_sla_business_hours,
+			review_sla_workday_start,
+			review_sla_workday_end,
+			review_sla_timezone,
+			escalation_policy,
+			COALESCE(lead_id, ''),
+			COALESCE(parent_name, ''),
+			ARRAY(
+				SELECT f.fallback_team_name
+				FROM team_fallbacks f
+				WHERE f.team_name = teams.name
+				ORDER BY f.position
+			) AS fallback_teams
 		FROM teams
 		WHERE name = $1
 	`
@@ -48,6 +106,21 @@ func (r *teamRepository) GetByName(ctx context.Context, name string) (*entity.Te
     var team entity.Team
     err := querier.QueryRow(ctx, query, name).Scan(
         &team.Name,
+        &team.ReviewerStrategy,
+        &team.MinReviewers,
+        &team.MaxReviewers,
+        &team.DefaultMaxOpenReviews,
+        &team.MergePolicy.RequiredApprovals,
+        &team.MergePolicy.BlockOnChangesRequested,
+        &team.ReviewSLA.Hours,
+        &team.ReviewSLA.BusinessHours,
+        &team.ReviewSLA.WorkdayStart,
+        &team.ReviewSLA.WorkdayEnd,
+        &team.ReviewSLA.TimeZone,
+        &team.ReviewSLA.Escalation,
+        &team.LeadID,
+        &team.ParentName,
+        &team.FallbackTeams,
     )
 
     if err != nil {
@@ -77,3 +150,374 @@ func (r *teamRepository) Exists(ctx context.Context, name string) (bool, error)
 
     return exists, nil
 }
+
+func (r *teamRepository) Update(ctx context.Context, team *entity.Team) error {
+    query := `
+		UPDATE teams
+		SET reviewer_strategy = $2,
+			min_reviewers = $3,
+			max_reviewers = $4,
+			default_max_open_reviews = $5,
+			required_approvals = $6,
+			block_on_changes_requested = $7,
+			review_sla_hours = $8,
+			review_sla_business_hours = $9,
+			review_sla_workday_start = $10,
+			review_sla_workday_end = $11,
+			review_sla_timezone = $12,
+			escalation_policy = $13,
+			lead_id = NULLIF($14, ''),
+			parent_name = NULLIF($15, '')
+		WHERE name = $1
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    result, err := querier.Exec(ctx, query,
+        team.Name,
+        string(team.ReviewerStrategy),
+        team.MinReviewers,
+        team.MaxReviewers,
+        team.DefaultMaxOpenReviews,
+        team.MergePolicy.RequiredApprovals,
+        team.MergePolicy.BlockOnChangesRequested,
+        team.ReviewSLA.Hours,
+        team.ReviewSLA.BusinessHours,
+        team.ReviewSLA.WorkdayStart,
+        team.ReviewSLA.WorkdayEnd,
+        team.ReviewSLA.TimeZone,
+        string(team.ReviewSLA.Escalation),
+        team.LeadID,
+        team.ParentName,
+    )
+    if err != nil {
+        if isPgForeignKeyViolation(err) {
+            if pgConstraintName(err) == "fk_teams_parent" {
+                return fmt.Errorf("%w: parent team %s", domain.ErrTeamNotFound, team.ParentName)
+            }
+            return domain.ErrUserNotFound
+        }
+        return fmt.Errorf("exec update team: %w", err)
+    }
+    if result.RowsAffected() == 0 {
+        return domain.ErrTeamNotFound
+    }
+    return r.setFallbackTeams(ctx, team)
+}
+
+func (r *teamRepository) SetLead(ctx context.Context, teamName, leadID string) error {
+    query := `
+		UPDATE teams
+		SET lead_id = NULLIF($2, '')
+		WHERE name = $1
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    result, err := querier.Exec(ctx, query, teamName, leadID)
+    if err != nil {
+        if isPgForeignKeyViolation(err) {
+            return fmt.Errorf("%w: team lead %s", domain.ErrUserNotFound, leadID)
+        }
+        return fmt.Errorf("exec set team lead: %w", err)
+    }
+    if result.RowsAffected() == 0 {
+        return domain.ErrTeamNotFound
+    }
+    return nil
+}
+
+func (r *teamRepository) List(ctx context.Context, afterName string, limit int) ([]repository.TeamSummary, error) {
+    // counts are computed per row of the page, the name index gives the order
+    query := `
+		SELECT
+			t.name,
+			(SELECT COUNT(*) FROM team_members tm WHERE tm.team_name = t.name) AS member_count,
+			(
+				SELECT COUNT(*)
+				FROM team_members tm
+				JOIN users u ON u.user_id = tm.user_id
+				WHERE tm.team_name = t.name
+				  AND u.is_active
+			) AS active_member_count,
+			(
+				SELECT COUNT(*)
+				FROM team_members tm
+				JOIN pull_request_reviewers prr ON prr.reviewer_id = tm.user_id
+				JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+				WHERE tm.team_name = t.name
+				  AND pr.status = 'OPEN'
+			) AS open_reviews
+		FROM teams t
+		WHERE t.name > $1
+		ORDER BY t.name
+		LIMIT $2
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    rows, err := querier.Query(ctx, query, afterName, limit)
+    if err != nil {
+        return nil, fmt.Errorf("query teams: %w", err)
+    }
+    defer rows.Close()
+
+    teams := make([]repository.TeamSummary, 0)
+    for rows.Next() {
+        var team repository.TeamSummary
+        err := rows.Scan(&team.Name, &team.MemberCount, &team.ActiveMemberCount, &team.OpenReviews)
+        if err != nil {
+            return nil, fmt.Errorf("scan team summary: %w", err)
+        }
+        teams = append(teams, team)
+    }
+
+    if err := rows.Err(); err != nil {
+        return nil, fmt.Errorf("rows error: %w", err)
+    }
+    return teams, nil
+}
+
+func (r *teamRepository) Rename(ctx context.Context, oldName, newName string) error {
+    // users, fallbacks and rotation cursors follow through ON UPDATE CASCADE,
+    // ownership rules keep team names in an array and are rewritten here
+    query := `
+		UPDATE teams
+		SET name = $2
+		WHERE name = $1
+	`
+    rulesQuery := `
+		UPDATE ownership_rules
+		SET team_names = array_replace(team_names, $1, $2)
+		WHERE $1 = ANY(team_names)
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    result, err := querier.Exec(ctx, query, oldName, newName)
+    if err != nil {
+        if isPgUniqueViolation(err) {
+            return domain.ErrTeamAlreadyExists
+        }
+        return fmt.Errorf("exec rename team: %w", err)
+    }
+    if result.RowsAffected() == 0 {
+        return domain.ErrTeamNotFound
+    }
+
+    if _, err := querier.Exec(ctx, rulesQuery, oldName, newName); err != nil {
+        return fmt.Errorf("exec rename team in ownership rules: %w", err)
+    }
+    return nil
+}
+
+func (r *teamRepository) Delete(ctx context.Context, teamName string) error {
+    childrenQuery := `
+		UPDATE teams
+		SET parent_name = (SELECT p.parent_name FROM teams p WHERE p.name = $1)
+		WHERE parent_name = $1
+	`
+    query := `
+		DELETE FROM teams
+		WHERE name = $1
+	`
+    rulesQuery := `
+		UPDATE ownership_rules
+		SET team_names = array_remove(team_names, $1)
+		WHERE $1 = ANY(team_names)
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    if _, err := querier.Exec(ctx, childrenQuery, teamName); err != nil {
+        return fmt.Errorf("exec move child teams: %w", err)
+    }
+    result, err := querier.Exec(ctx, query, teamName)
+    if err != nil {
+        if isPgForeignKeyViolation(err) {
+            return domain.ErrTeamNotEmpty
+        }
+        return fmt.Errorf("exec delete team: %w", err)
+    }
+    if result.RowsAffected() == 0 {
+        return domain.ErrTeamNotFound
+    }
+
+    if _, err := querier.Exec(ctx, rulesQuery, teamName); err != nil {
+        return fmt.Errorf("exec delete team from ownership rules: %w", err)
+    }
+    return nil
+}
+
+func (r *teamRepository) GetAncestors(ctx context.Context, teamName string) ([]string, error) {
+    query := `
+		WITH RECURSIVE ancestors AS (
+			SELECT t.parent_name AS name, 1 AS depth
+			FROM teams t
+			WHERE t.name = $1
+			  AND t.parent_name IS NOT NULL
+			UNION ALL
+			SELECT t.parent_name, a.depth + 1
+			FROM ancestors a
+			JOIN teams t ON t.name = a.name
+			WHERE t.parent_name IS NOT NULL
+		)
+		SELECT name
+		FROM ancestors
+		ORDER BY depth
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    rows, err := querier.Query(ctx, query, teamName)
+    if err != nil {
+        return nil, fmt.Errorf("query team ancestors: %w", err)
+    }
+    return scanTeamNames(rows)
+}
+
+func (r *teamRepository) GetChildren(ctx context.Context, teamName string) ([]string, error) {
+    query := `
+		SELECT name
+		FROM teams
+		WHERE parent_name = $1
+		ORDER BY name
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    rows, err := querier.Query(ctx, query, teamName)
+    if err != nil {
+        return nil, fmt.Errorf("query team children: %w", err)
+    }
+    return scanTeamNames(rows)
+}
+
+func (r *teamRepository) GetParents(ctx context.Context) (map[string]string, error) {
+    query := `
+		SELECT name, COALESCE(parent_name, '')
+		FROM teams
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    rows, err := querier.Query(ctx, query)
+    if err != nil {
+        return nil, fmt.Errorf("query team parents: %w", err)
+    }
+    defer rows.Close()
+
+    parents := make(map[string]string)
+    for rows.Next() {
+        var name, parent string
+        if err := rows.Scan(&name, &parent); err != nil {
+            return nil, fmt.Errorf("scan team parent: %w", err)
+        }
+        parents[name] = parent
+    }
+
+    if err := rows.Err(); err != nil {
+        return nil, fmt.Errorf("rows error: %w", err)
+    }
+    return parents, nil
+}
+
+func scanTeamNames(rows pgx.Rows) ([]string, error) {
+    defer rows.Close()
+
+    names := make([]string, 0)
+    for rows.Next() {
+        var name string
+        if err := rows.Scan(&name); err != nil {
+            return nil, fmt.Errorf("scan team name: %w", err)
+        }
+        names = append(names, name)
+    }
+
+    if err := rows.Err(); err != nil {
+        return nil, fmt.Errorf("rows error: %w", err)
+    }
+    return names, nil
+}
+
+// setFallbackTeams replaces fallback teams of the team keeping their order
+func (r *teamRepository) setFallbackTeams(ctx context.Context, team *entity.Team) error {
+    deleteQuery := `
+		DELETE FROM team_fallbacks
+		WHERE team_name = $1
+	`
+    insertQuery := `
+		INSERT INTO team_fallbacks (team_name, fallback_team_name, position)
+		SELECT $1, f.name, f.position
+		FROM unnest($2::text[]) WITH ORDINALITY AS f(name, position)
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    if _, err := querier.Exec(ctx, deleteQuery, team.Name); err != nil {
+        return fmt.Errorf("exec delete fallback teams: %w", err)
+    }
+    if len(team.FallbackTeams) == 0 {
+        return nil
+    }
+
+    _, err := querier.Exec(ctx, insertQuery, team.Name, team.FallbackTeams)
+    if err != nil {
+        if isPgForeignKeyViolation(err) {
+            return domain.ErrTeamNotFound
+        }
+        if isPgCheckViolation(err) {
+            return domain.ErrInvalidFallbackTeams
+        }
+        return fmt.Errorf("exec insert fallback teams: %w", err)
+    }
+    return nil
+}
+
+func (r *teamRepository) LockRotationCursor(ctx context.Context, teamName string) (string, error) {
+    insertQuery := `
+		INSERT INTO team_rotation_cursors (team_name)
+		VALUES ($1)
+		ON CONFLICT (team_name) DO NOTHING
+	`
+    selectQuery := `
+		SELECT last_user_id
+		FROM team_rotation_cursors
+		WHERE team_name = $1
+		FOR UPDATE
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    if _, err := querier.Exec(ctx, insertQuery, teamName); err != nil {
+        if isPgForeignKeyViolation(err) {
+            return "", domain.ErrTeamNotFound
+        }
+        return "", fmt.Errorf("exec init rotation cursor: %w", err)
+    }
+
+    var lastUserID string
+    if err := querier.QueryRow(ctx, selectQuery, teamName).Scan(&lastUserID); err != nil {
+        return "", fmt.Errorf("query rotation cursor: %w", err)
+    }
+    return lastUserID, nil
+}
+
+func (r *teamRepository) SetRotationCursor(ctx context.Context, teamName, lastUserID string) error {
+    query := `
+		UPDATE team_rotation_cursors
+		SET last_user_id = $2, updated_at = NOW()
+		WHERE team_name = $1
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    result, err := querier.Exec(ctx, query, teamName, lastUserID)
+    if err != nil {
+        return fmt.Errorf("exec set rotation cursor: %w", err)
+    }
+    if result.RowsAffected() == 0 {
+        return domain.ErrTeamNotFound
+    }
+    return nil
+}
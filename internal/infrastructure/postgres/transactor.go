@@ -16,11 +16,19 @@ func NewTransactor(pool *pgxpool.Pool) repository.Transactor {
     return &transactor{pool: pool}
 }
 
+// WithinTransaction runs fn in a transaction. A call nested into another one runs in a savepoint,
+// so its failure rolls back only its own changes and the outer transaction can go on
 func (t *transactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
-    tx, err := t.pool.BeginTx(ctx, pgx.TxOptions{
-        IsoLevel:   pgx.ReadCommitted,
-        AccessMode: pgx.ReadWrite,
-    })
+    var tx pgx.Tx
+    var err error
+    if outer, ok := extractTx(ctx); ok {
+        tx, err = outer.Begin(ctx)
+    } else {
+        tx, err = t.pool.BeginTx(ctx, pgx.TxOptions{
+            IsoLevel:   pgx.ReadCommitted,
+            AccessMode: pgx.ReadWrite,
+        })
+    }
     if err != nil {
         return fmt.Errorf("begin transaction: %w", err)
     }
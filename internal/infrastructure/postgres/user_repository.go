@@ -9,6 +9,7 @@ import (
     "github.com/kimvlry/avito-internship-assignment/internal/domain"
     "github.com/kimvlry/avito-internship-assignment/internal/domain/entity"
     "github.com/kimvlry/avito-internship-assignment/internal/domain/repository"
+    "time"
 )
 
 type userRepository struct {
@@ -21,8 +22,8 @@ func NewUserRepository(db *DB) repository.UserRepository {
 
 func (r *userRepository) Create(ctx context.Context, user *entity.User) error {
     query := `
-		INSERT INTO users (user_id, username, team_name, is_active)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO users (user_id, username, is_active)
+		VALUES ($1, $2, $3)
 	`
 
     querier := r.db.GetQuerier(ctx)
@@ -30,7 +31,6 @@ func (r *userRepository) Create(ctx context.Context, user *entity.User) error {
     _, err := querier.Exec(ctx, query,
         user.ID,
         user.Username,
-        user.TeamName,
         user.IsActive,
     )
 
@@ -38,19 +38,19 @@ func (r *userRepository) Create(ctx context.Context, user *entity.User) error {
         if isPgUniqueViolation(err) {
             return ErrUserAlreadyExists
         }
-        if isPgForeignKeyViolation(err) {
-            return domain.ErrTeamNotFound
-        }
         return fmt.Errorf("exec create user: %w", err)
     }
 
-    return nil
+    if user.TeamName == "" {
+        return nil
+    }
+    return r.AddToTeam(ctx, user.ID, user.TeamName)
 }
 
 func (r *userRepository) Update(ctx context.Context, user *entity.User) error {
     query := `
 		UPDATE users
-		SET username = $2, team_name = $3, is_active = $4
+		SET username = $2, is_active = $3
 		WHERE user_id = $1
 	`
 
@@ -59,14 +59,10 @@ func (r *userRepository) Update(ctx context.Context, user *entity.User) error {
     result, err := querier.Exec(ctx, query,
         user.ID,
         user.Username,
-        user.TeamName,
         user.IsActive,
     )
 
     if err != nil {
-        if isPgForeignKeyViolation(err) {
-            return domain.ErrTeamNotFound
-        }
         return fmt.Errorf("exec update user: %w", err)
     }
 
@@ -78,20 +74,30 @@ func (r *userRepository) Update(ctx context.Context, user *entity.User) error {
 }
 
 func (r *userRepository) GetByID(ctx context.Context, id string) (*entity.User, error) {
-    query := `
-		SELECT user_id, username, team_name, is_active
-		FROM users
-		WHERE user_id = $1
-	`
+    query, args, err := r.db.QueryBuilder().
+        Select(userColumns...).
+        Column(userExpertiseSQL + " AS expertise").
+        From("users").
+        Where(squirrel.Eq{"user_id": id}).
+        ToSql()
+    if err != nil {
+        return nil, fmt.Errorf("build query: %w", err)
+    }
 
     querier := r.db.GetQuerier(ctx)
 
     var user entity.User
-    err := querier.QueryRow(ctx, query, id).Scan(
+    err = querier.QueryRow(ctx, query, args...).Scan(
         &user.ID,
         &user.Username,
         &user.TeamName,
+        &user.Teams,
         &user.IsActive,
+        &user.MaxOpenReviews,
+        &user.OpenReviews,
+        &user.ReviewCapacity,
+        &user.UnavailableUntil,
+        &user.Expertise,
     )
 
     if err != nil {
@@ -105,21 +111,17 @@ func (r *userRepository) GetByID(ctx context.Context, id string) (*entity.User,
 }
 
 func (r *userRepository) GetByTeam(ctx context.Context, teamName string) ([]entity.User, error) {
-    query := `
-		SELECT user_id, username, team_name, is_active
-		FROM users
-		WHERE team_name = $1
-		ORDER BY username
-	`
-
-    querier := r.db.GetQuerier(ctx)
+    qb := r.db.QueryBuilder().
+        Select(userColumns...).
+        From("users").
+        Where(teamMemberSQL, teamName).
+        OrderBy("username")
 
-    rows, err := querier.Query(ctx, query, teamName)
+    users, err := r.queryUsers(ctx, qb)
     if err != nil {
         return nil, fmt.Errorf("query users by team: %w", err)
     }
-    defer rows.Close()
-    return scanUsers(rows)
+    return users, nil
 }
 
 func (r *userRepository) SetIsActive(ctx context.Context, id string, isActive bool) (*entity.User, error) {
@@ -127,7 +129,7 @@ func (r *userRepository) SetIsActive(ctx context.Context, id string, isActive bo
 		UPDATE users
 		SET is_active = $2
 		WHERE user_id = $1
-		RETURNING user_id, username, team_name, is_active
+		RETURNING user_id, username, ` + primaryTeamSQL + `, ` + userTeamsSQL + `, is_active
 	`
 
     var u entity.User
@@ -137,6 +139,7 @@ func (r *userRepository) SetIsActive(ctx context.Context, id string, isActive bo
         &u.ID,
         &u.Username,
         &u.TeamName,
+        &u.Teams,
         &u.IsActive,
     )
     if err != nil {
@@ -148,6 +151,81 @@ func (r *userRepository) SetIsActive(ctx context.Context, id string, isActive bo
     return &u, nil
 }
 
+func (r *userRepository) SetIsActiveBatch(ctx context.Context, ids []string, isActive bool) ([]entity.User, error) {
+    if len(ids) == 0 {
+        return []entity.User{}, nil
+    }
+
+    query := `
+		WITH updated AS (
+			UPDATE users
+			SET is_active = $2
+			WHERE user_id = ANY($1)
+			RETURNING user_id, username, ` + primaryTeamSQL + ` AS team_name, ` + userTeamsSQL + ` AS teams, is_active
+		)
+		SELECT user_id, username, team_name, teams, is_active
+		FROM updated
+		ORDER BY user_id
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    rows, err := querier.Query(ctx, query, ids, isActive)
+    if err != nil {
+        return nil, fmt.Errorf("exec set is_active batch: %w", err)
+    }
+    defer rows.Close()
+
+    users := make([]entity.User, 0, len(ids))
+    for rows.Next() {
+        var u entity.User
+        if err := rows.Scan(&u.ID, &u.Username, &u.TeamName, &u.Teams, &u.IsActive); err != nil {
+            return nil, fmt.Errorf("scan user: %w", err)
+        }
+        users = append(users, u)
+    }
+
+    if err := rows.Err(); err != nil {
+        return nil, fmt.Errorf("rows error: %w", err)
+    }
+    return users, nil
+}
+
+func (r *userRepository) SetMaxOpenReviews(ctx context.Context, id string, maxOpenReviews *int) error {
+    query := `
+		UPDATE users
+		SET max_open_reviews = $2
+		WHERE user_id = $1
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    result, err := querier.Exec(ctx, query, id, maxOpenReviews)
+    if err != nil {
+        if isPgCheckViolation(err) {
+            return domain.ErrInvalidReviewCapacity
+        }
+        return fmt.Errorf("exec set max_open_reviews: %w", err)
+    }
+    if result.RowsAffected() == 0 {
+        return domain.ErrUserNotFound
+    }
+    return nil
+}
+
+func (r *userRepository) GetAllReviewLoads(ctx context.Context) ([]entity.User, error) {
+    qb := r.db.QueryBuilder().
+        Select(userColumns...).
+        From("users").
+        OrderBy("user_id")
+
+    users, err := r.queryUsers(ctx, qb)
+    if err != nil {
+        return nil, fmt.Errorf("query review loads: %w", err)
+    }
+    return users, nil
+}
+
 func (r *userRepository) Exists(ctx context.Context, id string) (bool, error) {
     query := `
 		SELECT EXISTS(
@@ -172,20 +250,182 @@ func (r *userRepository) GetRandomActiveTeamUsers(
     excludeUserIDs []string,
     maxCount int,
 ) ([]entity.User, error) {
+    qb := r.activeTeamUsersQuery(teamName, excludeUserIDs, maxCount).
+        OrderBy("RANDOM()")
+
+    users, err := r.queryUsers(ctx, qb)
+    if err != nil {
+        return nil, fmt.Errorf("query random active users: %w", err)
+    }
+    return users, nil
+}
+
+func (r *userRepository) GetLeastLoadedActiveTeamUsers(
+    ctx context.Context,
+    teamName string,
+    excludeUserIDs []string,
+    maxCount int,
+) ([]entity.User, error) {
+    qb := r.activeTeamUsersQuery(teamName, excludeUserIDs, maxCount).
+        OrderBy(openReviewLoadSQL, "RANDOM()")
+
+    users, err := r.queryUsers(ctx, qb)
+    if err != nil {
+        return nil, fmt.Errorf("query least loaded active users: %w", err)
+    }
+    return users, nil
+}
+
+func (r *userRepository) GetExpertActiveTeamUsers(
+    ctx context.Context,
+    teamName string,
+    excludeUserIDs []string,
+    tags []string,
+    maxCount int,
+) ([]entity.User, error) {
+    qb := r.activeTeamUsersQuery(teamName, excludeUserIDs, maxCount).
+        Where(squirrel.Expr(matchedExpertiseSQL+" > 0", tags)).
+        OrderByClause(matchedExpertiseSQL+" DESC", tags).
+        OrderBy("RANDOM()")
+
+    users, err := r.queryUsers(ctx, qb)
+    if err != nil {
+        return nil, fmt.Errorf("query expert active users: %w", err)
+    }
+    return users, nil
+}
+
+func (r *userRepository) SetExpertise(ctx context.Context, userID string, tags []string) error {
+    deleteQuery := `
+		DELETE FROM user_expertise
+		WHERE user_id = $1
+	`
+    insertQuery := `
+		INSERT INTO user_expertise (user_id, tag)
+		SELECT $1, unnest($2::text[])
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    if _, err := querier.Exec(ctx, deleteQuery, userID); err != nil {
+        return fmt.Errorf("exec delete expertise: %w", err)
+    }
+    if len(tags) == 0 {
+        return nil
+    }
+
+    if _, err := querier.Exec(ctx, insertQuery, userID, tags); err != nil {
+        if isPgForeignKeyViolation(err) {
+            return domain.ErrUserNotFound
+        }
+        return fmt.Errorf("exec insert expertise: %w", err)
+    }
+    return nil
+}
+
+// matchedExpertiseSQL counts the requested tags the current users row has
+const matchedExpertiseSQL = `(
+		SELECT COUNT(*)
+		FROM user_expertise ue
+		WHERE ue.user_id = users.user_id
+		  AND ue.tag = ANY(?::text[])
+	)`
+
+// openReviewLoadSQL counts OPEN pull requests the current users row is assigned to review
+const openReviewLoadSQL = `(
+		SELECT COUNT(*)
+		FROM pull_request_reviewers prr
+		JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+		WHERE prr.reviewer_id = users.user_id
+		  AND pr.status = 'OPEN'
+	)`
+
+// reviewCapacitySQL is the effective OPEN review limit of the current users row, 0 means unlimited.
+// The default comes from the primary team, a user without a team has no limit
+const reviewCapacitySQL = `COALESCE(
+		users.max_open_reviews,
+		(
+			SELECT t.default_max_open_reviews
+			FROM team_members tm
+			JOIN teams t ON t.name = tm.team_name
+			WHERE tm.user_id = users.user_id
+			  AND tm.is_primary
+		),
+		0
+	)`
+
+// unavailableUntilSQL is the end of the unavailability period the current users row is in, NULL when available
+const unavailableUntilSQL = `(
+		SELECT MAX(ua.ends_at)
+		FROM user_unavailability ua
+		WHERE ua.user_id = users.user_id
+		  AND ua.starts_at <= now()
+		  AND ua.ends_at > now()
+	)`
+
+const primaryTeamSQL = `COALESCE((
+		SELECT tm.team_name
+		FROM team_members tm
+		WHERE tm.user_id = users.user_id
+		  AND tm.is_primary
+	), '')`
+
+const userTeamsSQL = `ARRAY(
+		SELECT tm.team_name
+		FROM team_members tm
+		WHERE tm.user_id = users.user_id
+		ORDER BY tm.is_primary DESC, tm.team_name
+	)`
+
+// teamMemberSQL keeps users rows that are members of the team, primary or not
+const teamMemberSQL = `EXISTS (
+		SELECT 1
+		FROM team_members tm
+		WHERE tm.user_id = users.user_id
+		  AND tm.team_name = ?
+	)`
+
+const userExpertiseSQL = `ARRAY(
+		SELECT ue.tag
+		FROM user_expertise ue
+		WHERE ue.user_id = users.user_id
+		ORDER BY ue.tag
+	)`
+
+// userColumns are read by scanUsers in this order
+var userColumns = []string{
+    "user_id",
+    "username",
+    primaryTeamSQL + " AS team_name",
+    userTeamsSQL + " AS teams",
+    "is_active",
+    "max_open_reviews",
+    openReviewLoadSQL + " AS open_reviews",
+    reviewCapacitySQL + " AS review_capacity",
+    unavailableUntilSQL + " AS unavailable_until",
+}
+
+func (r *userRepository) activeTeamUsersQuery(
+    teamName string,
+    excludeUserIDs []string,
+    maxCount int,
+) squirrel.SelectBuilder {
     qb := r.db.QueryBuilder().
-        Select("user_id", "username", "team_name", "is_active").
+        Select(userColumns...).
         From("users").
-        Where(squirrel.Eq{
-            "team_name": teamName,
-            "is_active": true,
-        }).
-        OrderBy("RANDOM()").
+        Where(teamMemberSQL, teamName).
+        Where(squirrel.Eq{"is_active": true}).
+        Where(unavailableUntilSQL + " IS NULL").
+        Where("(" + reviewCapacitySQL + " = 0 OR " + openReviewLoadSQL + " < " + reviewCapacitySQL + ")").
         Limit(uint64(maxCount))
 
     if len(excludeUserIDs) > 0 {
         qb = qb.Where(squirrel.NotEq{"user_id": excludeUserIDs})
     }
+    return qb
+}
 
+func (r *userRepository) queryUsers(ctx context.Context, qb squirrel.SelectBuilder) ([]entity.User, error) {
     query, args, err := qb.ToSql()
     if err != nil {
         return nil, fmt.Errorf("build query: %w", err)
@@ -195,13 +435,137 @@ func (r *userRepository) GetRandomActiveTeamUsers(
 
     rows, err := querier.Query(ctx, query, args...)
     if err != nil {
-        return nil, fmt.Errorf("query random active users: %w", err)
+        return nil, err
     }
     defer rows.Close()
 
     return scanUsers(rows)
 }
 
+func (r *userRepository) AddToTeam(ctx context.Context, userID, teamName string) error {
+    query := `
+		INSERT INTO team_members (team_name, user_id, is_primary)
+		VALUES ($2, $1, NOT EXISTS (
+			SELECT 1
+			FROM team_members
+			WHERE user_id = $1
+			  AND is_primary
+		))
+		ON CONFLICT (team_name, user_id) DO NOTHING
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    if _, err := querier.Exec(ctx, query, userID, teamName); err != nil {
+        if isPgForeignKeyViolation(err) {
+            return domain.ErrTeamNotFound
+        }
+        return fmt.Errorf("exec add team member: %w", err)
+    }
+    return nil
+}
+
+func (r *userRepository) RemoveFromTeam(ctx context.Context, userID, teamName string) error {
+    query := `
+		DELETE FROM team_members
+		WHERE user_id = $1
+		  AND team_name = $2
+		RETURNING is_primary
+	`
+    promoteQuery := `
+		UPDATE team_members
+		SET is_primary = true
+		WHERE user_id = $1
+		  AND team_name = (
+			  SELECT MIN(team_name)
+			  FROM team_members
+			  WHERE user_id = $1
+		  )
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    var wasPrimary bool
+    err := querier.QueryRow(ctx, query, userID, teamName).Scan(&wasPrimary)
+    if err != nil {
+        if errors.Is(err, pgx.ErrNoRows) {
+            return domain.ErrUserNotInTeam
+        }
+        return fmt.Errorf("exec remove team member: %w", err)
+    }
+    if !wasPrimary {
+        return nil
+    }
+
+    if _, err := querier.Exec(ctx, promoteQuery, userID); err != nil {
+        return fmt.Errorf("exec promote primary team: %w", err)
+    }
+    return nil
+}
+
+func (r *userRepository) MoveTeamMembers(ctx context.Context, fromTeam, toTeam string) error {
+    // members of both teams lose the fromTeam row first, so that no user has two primary rows
+    dropQuery := `
+		DELETE FROM team_members
+		WHERE team_name = $1
+		  AND user_id IN (
+			  SELECT user_id
+			  FROM team_members
+			  WHERE team_name = $2
+		  )
+		RETURNING user_id, is_primary
+	`
+    moveQuery := `
+		UPDATE team_members
+		SET team_name = $2
+		WHERE team_name = $1
+	`
+    promoteQuery := `
+		UPDATE team_members
+		SET is_primary = true
+		WHERE team_name = $1
+		  AND user_id = ANY($2)
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    rows, err := querier.Query(ctx, dropQuery, fromTeam, toTeam)
+    if err != nil {
+        return fmt.Errorf("exec drop shared members: %w", err)
+    }
+    var promoted []string
+    for rows.Next() {
+        var userID string
+        var wasPrimary bool
+        if err := rows.Scan(&userID, &wasPrimary); err != nil {
+            rows.Close()
+            return fmt.Errorf("scan shared member: %w", err)
+        }
+        if wasPrimary {
+            promoted = append(promoted, userID)
+        }
+    }
+    rows.Close()
+    if err := rows.Err(); err != nil {
+        return fmt.Errorf("rows error: %w", err)
+    }
+
+    if _, err := querier.Exec(ctx, moveQuery, fromTeam, toTeam); err != nil {
+        if isPgForeignKeyViolation(err) {
+            return domain.ErrTeamNotFound
+        }
+        return fmt.Errorf("exec move team members: %w", err)
+    }
+    if len(promoted) == 0 {
+        return nil
+    }
+
+    if _, err := querier.Exec(ctx, promoteQuery, toTeam, promoted); err != nil {
+        return fmt.Errorf("exec promote primary team: %w", err)
+    }
+    return nil
+}
+
 func (r *userRepository) CheckUsersAvailableForTeam(
     ctx context.Context,
     userIDs []string,
@@ -212,17 +576,26 @@ func (r *userRepository) CheckUsersAvailableForTeam(
     }
 
     query := `
-		SELECT u.user_id
-		FROM users u
-		WHERE u.user_id = ANY($1)
-		  AND u.team_name != $2
+		SELECT prr.reviewer_id, prr.pull_request_id
+		FROM pull_request_reviewers prr
+		JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+		WHERE prr.reviewer_id = ANY($1)
+		  AND pr.status = 'OPEN'
 		  AND EXISTS (
-			SELECT 1
-			FROM pull_request_reviewers prr
-			JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
-			WHERE prr.reviewer_id = u.user_id
-			  AND pr.status = 'OPEN'
+			  SELECT 1
+			  FROM team_members author
+			  WHERE author.user_id = pr.author_id
+			    AND author.team_name = $2
 		  )
+		  AND NOT EXISTS (
+			  SELECT 1
+			  FROM team_members author
+			  JOIN team_members reviewer ON reviewer.team_name = author.team_name
+			  WHERE author.user_id = pr.author_id
+			    AND reviewer.user_id = prr.reviewer_id
+			    AND author.team_name <> $2
+		  )
+		ORDER BY prr.reviewer_id, prr.pull_request_id
 	`
 
     querier := r.db.GetQuerier(ctx)
@@ -233,23 +606,120 @@ func (r *userRepository) CheckUsersAvailableForTeam(
     }
     defer rows.Close()
 
-    var blockedUsers []string
+    var blocking []domain.BlockingReview
     for rows.Next() {
-        var userID string
-        if err := rows.Scan(&userID); err != nil {
-            return fmt.Errorf("scan blocked user: %w", err)
+        var review domain.BlockingReview
+        if err := rows.Scan(&review.UserID, &review.PullRequestID); err != nil {
+            return fmt.Errorf("scan blocking review: %w", err)
         }
-        blockedUsers = append(blockedUsers, userID)
+        blocking = append(blocking, review)
     }
 
-    if len(blockedUsers) > 0 {
-        return fmt.Errorf("%w: users %v have active PR assignments in other teams",
-            ErrUserHasActiveAssignments, blockedUsers)
+    if err := rows.Err(); err != nil {
+        return fmt.Errorf("rows error: %w", err)
+    }
+    if len(blocking) > 0 {
+        return &domain.TeamChangeBlockedError{Reviews: blocking}
+    }
+
+    return nil
+}
+
+func (r *userRepository) AddUnavailability(ctx context.Context, period *entity.Unavailability) error {
+    query := `
+		INSERT INTO user_unavailability (user_id, starts_at, ends_at, reason, external_uid)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''))
+		ON CONFLICT (user_id, external_uid) DO UPDATE
+		SET starts_at = EXCLUDED.starts_at,
+		    ends_at = EXCLUDED.ends_at,
+		    reason = EXCLUDED.reason
+		RETURNING unavailability_id
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    err := querier.QueryRow(ctx, query,
+        period.UserID,
+        period.StartsAt,
+        period.EndsAt,
+        period.Reason,
+        period.ExternalUID,
+    ).Scan(&period.ID)
+    if err != nil {
+        if isPgForeignKeyViolation(err) {
+            return domain.ErrUserNotFound
+        }
+        if isPgCheckViolation(err) {
+            return domain.ErrInvalidUnavailability
+        }
+        return fmt.Errorf("exec add unavailability: %w", err)
     }
+    return nil
+}
+
+func (r *userRepository) DeleteUnavailability(ctx context.Context, userID string, id int64) error {
+    query := `
+		DELETE FROM user_unavailability
+		WHERE unavailability_id = $2
+		  AND user_id = $1
+	`
+
+    querier := r.db.GetQuerier(ctx)
 
+    result, err := querier.Exec(ctx, query, userID, id)
+    if err != nil {
+        return fmt.Errorf("exec delete unavailability: %w", err)
+    }
+    if result.RowsAffected() == 0 {
+        return domain.ErrUnavailabilityNotFound
+    }
     return nil
 }
 
+func (r *userRepository) GetUpcomingUnavailability(
+    ctx context.Context,
+    userIDs []string,
+    now time.Time,
+) ([]entity.Unavailability, error) {
+    query := `
+		SELECT unavailability_id, user_id, starts_at, ends_at, reason, COALESCE(external_uid, '')
+		FROM user_unavailability
+		WHERE user_id = ANY($1)
+		  AND ends_at > $2
+		ORDER BY user_id, starts_at, unavailability_id
+	`
+
+    querier := r.db.GetQuerier(ctx)
+
+    rows, err := querier.Query(ctx, query, userIDs, now)
+    if err != nil {
+        return nil, fmt.Errorf("query upcoming unavailability: %w", err)
+    }
+    defer rows.Close()
+
+    periods := make([]entity.Unavailability, 0)
+    for rows.Next() {
+        var period entity.Unavailability
+        err := rows.Scan(
+            &period.ID,
+            &period.UserID,
+            &period.StartsAt,
+            &period.EndsAt,
+            &period.Reason,
+            &period.ExternalUID,
+        )
+        if err != nil {
+            return nil, fmt.Errorf("scan unavailability: %w", err)
+        }
+        periods = append(periods, period)
+    }
+
+    if err := rows.Err(); err != nil {
+        return nil, fmt.Errorf("rows error: %w", err)
+    }
+    return periods, nil
+}
+
 func scanUsers(rows pgx.Rows) ([]entity.User, error) {
     var users []entity.User
 
@@ -259,7 +729,12 @@ func scanUsers(rows pgx.Rows) ([]entity.User, error) {
             &user.ID,
             &user.Username,
             &user.TeamName,
+            &user.Teams,
             &user.IsActive,
+            &user.MaxOpenReviews,
+            &user.OpenReviews,
+            &user.ReviewCapacity,
+            &user.UnavailableUntil,
         )
         if err != nil {
             return nil, fmt.Errorf("scan user: %w", err)
@@ -0,0 +1,2 @@
+alter table teams drop constraint if exists chk_teams_reviewer_strategy;
+alter table teams drop column if exists reviewer_strategy;
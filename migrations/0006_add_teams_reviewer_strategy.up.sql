@@ -0,0 +1,6 @@
+alter table teams
+    add column if not exists reviewer_strategy varchar(32) default 'random' not null;
+
+alter table teams
+    add constraint chk_teams_reviewer_strategy
+        check (reviewer_strategy in ('random', 'least_loaded', 'round_robin'));
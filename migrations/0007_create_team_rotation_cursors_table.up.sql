@@ -0,0 +1,12 @@
+create table if not exists team_rotation_cursors (
+    team_name varchar(255) primary key,
+    last_user_id varchar(255) default '' not null,
+    updated_at timestamptz default current_timestamp not null,
+
+    constraint fk_rotation_cursor_team
+        foreign key (team_name)
+        references teams(name)
+        on delete cascade
+);
+
+comment on table team_rotation_cursors is 'last reviewer handed out by round-robin selection, row is locked while a PR gets its reviewers';
@@ -0,0 +1,3 @@
+alter table teams drop constraint if exists chk_teams_reviewer_limits;
+alter table teams drop column if exists min_reviewers;
+alter table teams drop column if exists max_reviewers;
@@ -0,0 +1,7 @@
+alter table teams
+    add column if not exists min_reviewers integer default 0 not null,
+    add column if not exists max_reviewers integer default 2 not null;
+
+alter table teams
+    add constraint chk_teams_reviewer_limits
+        check (min_reviewers >= 0 and max_reviewers >= 1 and min_reviewers <= max_reviewers);
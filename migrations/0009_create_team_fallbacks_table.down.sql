@@ -0,0 +1,2 @@
+alter table pull_request_reviewers drop column if exists is_fallback;
+drop table if exists team_fallbacks;
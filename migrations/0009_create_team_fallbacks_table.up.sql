@@ -0,0 +1,23 @@
+create table if not exists team_fallbacks (
+    team_name varchar(255) not null,
+    fallback_team_name varchar(255) not null,
+    position integer not null,
+
+    primary key (team_name, fallback_team_name),
+
+    constraint fk_team_fallbacks_team
+        foreign key (team_name)
+        references teams(name)
+        on delete cascade,
+
+    constraint fk_team_fallbacks_fallback
+        foreign key (fallback_team_name)
+        references teams(name)
+        on delete cascade,
+
+    constraint chk_team_fallbacks_not_self
+        check (team_name <> fallback_team_name)
+);
+
+alter table pull_request_reviewers
+    add column if not exists is_fallback boolean default false not null;
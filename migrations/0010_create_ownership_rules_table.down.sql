@@ -0,0 +1 @@
+drop table if exists ownership_rules;
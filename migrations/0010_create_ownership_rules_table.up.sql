@@ -0,0 +1,8 @@
+create table if not exists ownership_rules (
+    position integer primary key,
+    pattern varchar(1024) not null,
+    user_ids text[] default '{}' not null,
+    team_names text[] default '{}' not null
+);
+
+comment on column ownership_rules.position is 'order of the rule in the uploaded document, the last matching rule wins';
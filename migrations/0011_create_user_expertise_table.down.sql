@@ -0,0 +1,2 @@
+drop index if exists idx_user_expertise_tag;
+drop table if exists user_expertise;
@@ -0,0 +1,14 @@
+create table if not exists user_expertise (
+    user_id varchar(255) not null,
+    tag varchar(64) not null,
+
+    primary key (user_id, tag),
+
+    constraint fk_user_expertise_user
+        foreign key (user_id)
+        references users(user_id)
+        on delete cascade
+);
+
+create index if not exists idx_user_expertise_tag
+on user_expertise(tag);
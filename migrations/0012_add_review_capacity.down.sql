@@ -0,0 +1,4 @@
+alter table users drop constraint if exists chk_users_max_open_reviews;
+alter table users drop column if exists max_open_reviews;
+alter table teams drop constraint if exists chk_teams_default_max_open_reviews;
+alter table teams drop column if exists default_max_open_reviews;
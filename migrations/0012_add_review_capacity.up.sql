@@ -0,0 +1,13 @@
+alter table teams
+    add column if not exists default_max_open_reviews integer default 0 not null;
+
+alter table teams
+    add constraint chk_teams_default_max_open_reviews
+        check (default_max_open_reviews >= 0);
+
+alter table users
+    add column if not exists max_open_reviews integer;
+
+alter table users
+    add constraint chk_users_max_open_reviews
+        check (max_open_reviews is null or max_open_reviews >= 1);
@@ -0,0 +1,3 @@
+alter table pull_requests drop constraint if exists chk_pull_requests_status;
+alter table pull_requests drop column if exists closed_at;
+alter table pull_requests alter column status drop not null;
@@ -0,0 +1,9 @@
+update pull_requests set status = 'OPEN' where status is null;
+
+alter table pull_requests
+    alter column status set not null,
+    add column if not exists closed_at timestamptz;
+
+alter table pull_requests
+    add constraint chk_pull_requests_status
+        check (status in ('DRAFT', 'OPEN', 'MERGED', 'CLOSED'));
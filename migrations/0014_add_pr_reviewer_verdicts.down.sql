@@ -0,0 +1,4 @@
+alter table pull_request_reviewers drop constraint if exists chk_pr_reviewers_verdict;
+alter table pull_request_reviewers drop column if exists verdict_at;
+alter table pull_request_reviewers drop column if exists verdict_comment;
+alter table pull_request_reviewers drop column if exists verdict;
@@ -0,0 +1,8 @@
+alter table pull_request_reviewers
+    add column if not exists verdict varchar(32),
+    add column if not exists verdict_comment text,
+    add column if not exists verdict_at timestamptz;
+
+alter table pull_request_reviewers
+    add constraint chk_pr_reviewers_verdict
+        check (verdict is null or verdict in ('APPROVED', 'CHANGES_REQUESTED'));
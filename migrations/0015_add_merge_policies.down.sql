@@ -0,0 +1,10 @@
+alter table pull_requests
+    drop column if exists merge_override_unmet,
+    drop column if exists merge_override_by;
+
+alter table teams
+    drop constraint if exists chk_teams_required_approvals;
+
+alter table teams
+    drop column if exists block_on_changes_requested,
+    drop column if exists required_approvals;
@@ -0,0 +1,11 @@
+alter table teams
+    add column if not exists required_approvals integer default 0 not null,
+    add column if not exists block_on_changes_requested boolean default false not null;
+
+alter table teams
+    add constraint chk_teams_required_approvals
+        check (required_approvals >= 0);
+
+alter table pull_requests
+    add column if not exists merge_override_by varchar(255),
+    add column if not exists merge_override_unmet text[];
@@ -0,0 +1,6 @@
+alter table pull_requests
+    drop column if exists external_id,
+    drop column if exists target_branch,
+    drop column if exists source_branch,
+    drop column if exists url,
+    drop column if exists description;
@@ -0,0 +1,6 @@
+alter table pull_requests
+    add column if not exists description text,
+    add column if not exists url text,
+    add column if not exists source_branch varchar(255),
+    add column if not exists target_branch varchar(255),
+    add column if not exists external_id varchar(255);
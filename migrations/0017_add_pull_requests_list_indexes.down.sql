@@ -0,0 +1,6 @@
+drop index if exists idx_pull_requests_author_created_at;
+drop index if exists idx_pull_requests_status_created_at;
+drop index if exists idx_pull_requests_created_at;
+
+alter table pull_requests
+    alter column created_at drop not null;
@@ -0,0 +1,13 @@
+update pull_requests set created_at = current_timestamp where created_at is null;
+
+alter table pull_requests
+    alter column created_at set not null;
+
+create index if not exists idx_pull_requests_created_at
+on pull_requests(created_at desc, pull_request_id desc);
+
+create index if not exists idx_pull_requests_status_created_at
+on pull_requests(status, created_at desc, pull_request_id desc);
+
+create index if not exists idx_pull_requests_author_created_at
+on pull_requests(author_id, created_at desc, pull_request_id desc);
@@ -0,0 +1,4 @@
+drop index if exists idx_pull_requests_search_vector;
+
+alter table pull_requests
+    drop column if exists search_vector;
@@ -0,0 +1,9 @@
+alter table pull_requests
+    add column if not exists search_vector tsvector
+        generated always as (
+            setweight(to_tsvector('simple', coalesce(pull_request_name, '')), 'A') ||
+            setweight(to_tsvector('simple', coalesce(description, '')), 'B')
+        ) stored;
+
+create index if not exists idx_pull_requests_search_vector
+on pull_requests using gin(search_vector);
@@ -0,0 +1,16 @@
+drop index if exists idx_pr_reviewers_due_at;
+
+alter table pull_request_reviewers
+    drop column if exists escalated_at,
+    drop column if exists due_at;
+
+alter table teams
+    drop constraint if exists fk_teams_lead,
+    drop constraint if exists chk_teams_escalation_policy,
+    drop constraint if exists chk_teams_review_sla_hours;
+
+alter table teams
+    drop column if exists lead_id,
+    drop column if exists escalation_policy,
+    drop column if exists review_sla_business_hours,
+    drop column if exists review_sla_hours;
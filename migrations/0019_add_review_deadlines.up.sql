@@ -0,0 +1,23 @@
+alter table teams
+    add column if not exists review_sla_hours integer default 0 not null,
+    add column if not exists review_sla_business_hours boolean default false not null,
+    add column if not exists escalation_policy varchar(32) default 'reassign' not null,
+    add column if not exists lead_id varchar(255);
+
+alter table teams
+    add constraint chk_teams_review_sla_hours
+        check (review_sla_hours >= 0),
+    add constraint chk_teams_escalation_policy
+        check (escalation_policy in ('reassign', 'notify_lead')),
+    add constraint fk_teams_lead
+        foreign key (lead_id)
+        references users(user_id)
+        on delete set null;
+
+alter table pull_request_reviewers
+    add column if not exists due_at timestamptz,
+    add column if not exists escalated_at timestamptz;
+
+create index if not exists idx_pr_reviewers_due_at
+on pull_request_reviewers(due_at)
+where due_at is not null and escalated_at is null;
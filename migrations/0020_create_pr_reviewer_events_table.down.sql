@@ -0,0 +1 @@
+drop table if exists pull_request_reviewer_events;
@@ -0,0 +1,31 @@
+create table if not exists pull_request_reviewer_events (
+    event_id bigserial primary key,
+    pull_request_id varchar(255) not null,
+    event_type varchar(32) not null,
+    reviewer_id varchar(255),
+    previous_reviewer_id varchar(255),
+    actor_id varchar(255),
+    reason text not null default '',
+    verdict varchar(32),
+    created_at timestamptz default now() not null,
+
+    constraint fk_pr_reviewer_events_pr
+        foreign key (pull_request_id)
+        references pull_requests(pull_request_id)
+        on delete cascade,
+
+    constraint chk_pr_reviewer_events_type
+        check (event_type in ('ASSIGNED', 'REASSIGNED', 'UNASSIGNED', 'VERDICT', 'MERGED'))
+);
+
+comment on table pull_request_reviewer_events is 'append-only history of reviewer assignments, rows are never updated';
+comment on column pull_request_reviewer_events.actor_id is 'user_id of the token the change was made with, null for changes made by the service itself';
+
+create index if not exists idx_pr_reviewer_events_pr_id
+on pull_request_reviewer_events(pull_request_id, event_id);
+
+insert into pull_request_reviewer_events (pull_request_id, event_type, reviewer_id, reason, created_at)
+select prr.pull_request_id, 'ASSIGNED', prr.reviewer_id, 'assigned before history was recorded', coalesce(pr.created_at, now())
+from pull_request_reviewers prr
+join pull_requests pr on pr.pull_request_id = prr.pull_request_id
+order by pr.created_at, prr.pull_request_id, prr.reviewer_id;
@@ -0,0 +1 @@
+alter table users alter column team_name set not null;
@@ -0,0 +1,3 @@
+alter table users alter column team_name drop not null;
+
+comment on column users.team_name is 'null for users removed from their team, they are not picked as reviewers';
@@ -0,0 +1,25 @@
+alter table team_fallbacks
+    drop constraint if exists fk_team_fallbacks_team,
+    drop constraint if exists fk_team_fallbacks_fallback,
+    add constraint fk_team_fallbacks_team
+        foreign key (team_name)
+        references teams(name)
+        on delete cascade,
+    add constraint fk_team_fallbacks_fallback
+        foreign key (fallback_team_name)
+        references teams(name)
+        on delete cascade;
+
+alter table team_rotation_cursors
+    drop constraint if exists fk_rotation_cursor_team,
+    add constraint fk_rotation_cursor_team
+        foreign key (team_name)
+        references teams(name)
+        on delete cascade;
+
+alter table users
+    drop constraint if exists fk_users_team,
+    add constraint fk_users_team
+        foreign key (team_name)
+        references teams(name)
+        on delete restrict;
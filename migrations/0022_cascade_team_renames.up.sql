@@ -0,0 +1,31 @@
+alter table users
+    drop constraint if exists fk_users_team,
+    add constraint fk_users_team
+        foreign key (team_name)
+        references teams(name)
+        on delete restrict
+        on update cascade;
+
+alter table team_rotation_cursors
+    drop constraint if exists fk_rotation_cursor_team,
+    add constraint fk_rotation_cursor_team
+        foreign key (team_name)
+        references teams(name)
+        on delete cascade
+        on update cascade;
+
+alter table team_fallbacks
+    drop constraint if exists fk_team_fallbacks_team,
+    drop constraint if exists fk_team_fallbacks_fallback,
+    add constraint fk_team_fallbacks_team
+        foreign key (team_name)
+        references teams(name)
+        on delete cascade
+        on update cascade,
+    add constraint fk_team_fallbacks_fallback
+        foreign key (fallback_team_name)
+        references teams(name)
+        on delete cascade
+        on update cascade;
+
+comment on constraint fk_users_team on users is 'CASCADE on update: renaming a team keeps its members, RESTRICT on delete: members are moved out first';
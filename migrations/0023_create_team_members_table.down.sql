@@ -0,0 +1,23 @@
+alter table users add column team_name varchar(255);
+
+update users u
+set team_name = tm.team_name
+from team_members tm
+where tm.user_id = u.user_id
+  and tm.is_primary;
+
+alter table users
+    add constraint fk_users_team
+        foreign key (team_name)
+        references teams(name)
+        on delete restrict
+        on update cascade;
+
+create index if not exists idx_users_team_active
+on users(team_name, is_active)
+where is_active = true;
+
+create index if not exists idx_users_team_name
+on users(team_name);
+
+drop table if exists team_members;
@@ -0,0 +1,37 @@
+create table if not exists team_members (
+    team_name varchar(255) not null,
+    user_id varchar(255) not null,
+    is_primary boolean default false not null,
+
+    primary key (team_name, user_id),
+
+    constraint fk_team_members_team
+        foreign key (team_name)
+        references teams(name)
+        on delete restrict
+        on update cascade,
+
+    constraint fk_team_members_user
+        foreign key (user_id)
+        references users(user_id)
+        on delete cascade
+);
+
+create unique index if not exists ux_team_members_primary
+on team_members(user_id)
+where is_primary;
+
+create index if not exists idx_team_members_user_id
+on team_members(user_id);
+
+insert into team_members (team_name, user_id, is_primary)
+select team_name, user_id, true
+from users
+where team_name is not null;
+
+drop index if exists idx_users_team_active;
+drop index if exists idx_users_team_name;
+
+alter table users drop column team_name;
+
+comment on column team_members.is_primary is 'the team whose settings apply to the user''s own pull requests and review limit';
@@ -0,0 +1,6 @@
+drop index if exists idx_teams_parent_name;
+
+alter table teams
+    drop constraint if exists chk_teams_parent_not_self,
+    drop constraint if exists fk_teams_parent,
+    drop column if exists parent_name;
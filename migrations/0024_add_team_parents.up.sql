@@ -0,0 +1,14 @@
+alter table teams
+    add column if not exists parent_name varchar(255),
+    add constraint fk_teams_parent
+        foreign key (parent_name)
+        references teams(name)
+        on delete restrict
+        on update cascade,
+    add constraint chk_teams_parent_not_self
+        check (parent_name <> name);
+
+create index if not exists idx_teams_parent_name
+on teams(parent_name);
+
+comment on column teams.parent_name is 'the team this one rolls up into (squad -> tribe -> department), null for a root team';
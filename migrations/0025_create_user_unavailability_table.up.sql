@@ -0,0 +1,26 @@
+create table if not exists user_unavailability (
+    unavailability_id bigserial primary key,
+    user_id varchar(255) not null,
+    starts_at timestamptz not null,
+    ends_at timestamptz not null,
+    reason text not null default '',
+    external_uid text,
+    created_at timestamptz default now() not null,
+
+    constraint fk_user_unavailability_user
+        foreign key (user_id)
+        references users(user_id)
+        on delete cascade,
+
+    constraint chk_user_unavailability_period
+        check (ends_at > starts_at),
+
+    constraint ux_user_unavailability_external_uid
+        unique (user_id, external_uid)
+);
+
+comment on column user_unavailability.ends_at is 'exclusive, the user is back in rotation from this moment';
+comment on column user_unavailability.external_uid is 'UID of the imported iCalendar event, null for periods created through the API';
+
+create index if not exists idx_user_unavailability_user_ends_at
+on user_unavailability(user_id, ends_at);
@@ -0,0 +1,3 @@
+alter table pull_request_reviewers
+    drop column if exists next_escalation_at,
+    drop column if exists escalation_attempts;
@@ -0,0 +1,5 @@
+alter table pull_request_reviewers
+    add column if not exists escalation_attempts integer default 0 not null,
+    add column if not exists next_escalation_at timestamptz;
+
+comment on column pull_request_reviewers.next_escalation_at is 'a failed escalation is not retried before this moment, null when it never failed';
@@ -0,0 +1,6 @@
+alter table pull_request_reviewer_events
+    drop constraint if exists chk_pr_reviewer_events_type;
+
+alter table pull_request_reviewer_events
+    add constraint chk_pr_reviewer_events_type
+        check (event_type in ('ASSIGNED', 'REASSIGNED', 'UNASSIGNED', 'VERDICT', 'MERGED', 'CLOSED', 'REOPENED'));
@@ -0,0 +1,7 @@
+alter table teams
+    drop constraint if exists chk_teams_review_sla_workday;
+
+alter table teams
+    drop column if exists review_sla_timezone,
+    drop column if exists review_sla_workday_end,
+    drop column if exists review_sla_workday_start;
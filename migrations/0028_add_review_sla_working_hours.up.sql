@@ -0,0 +1,10 @@
+alter table teams
+    add column if not exists review_sla_workday_start integer default 9 not null,
+    add column if not exists review_sla_workday_end integer default 18 not null,
+    add column if not exists review_sla_timezone varchar(64) default 'UTC' not null;
+
+alter table teams
+    add constraint chk_teams_review_sla_workday
+        check (review_sla_workday_start >= 0 and review_sla_workday_start < review_sla_workday_end and review_sla_workday_end <= 24);
+
+comment on column teams.review_sla_business_hours is 'count only the hours between review_sla_workday_start and review_sla_workday_end on weekdays in review_sla_timezone';
@@ -0,0 +1,164 @@
+// Package codeowners parses CODEOWNERS-style documents and matches file paths against them.
+//
+// Supported syntax is a subset of the GitHub one: one rule per line, a gitignore-like
+// pattern followed by owners. "@name" is a user, "@org/name" is a team named name.
+// Blank lines and lines starting with "#" are skipped. When several rules match a path,
+// the last one wins
+package codeowners
+
+import (
+    "bufio"
+    "fmt"
+    "io"
+    "regexp"
+    "strings"
+)
+
+type Rule struct {
+    Pattern string
+    Users   []string
+    Teams   []string
+    Line    int
+
+    re *regexp.Regexp
+}
+
+type Ruleset []Rule
+
+// ParseError points at the line of the document that could not be parsed
+type ParseError struct {
+    Line   int
+    Reason string
+}
+
+func (e ParseError) Error() string {
+    return fmt.Sprintf("line %d: %s", e.Line, e.Reason)
+}
+
+func Parse(r io.Reader) (Ruleset, error) {
+    var rules Ruleset
+
+    scanner := bufio.NewScanner(r)
+    line := 0
+    for scanner.Scan() {
+        line++
+        text := strings.TrimSpace(scanner.Text())
+        if text == "" || strings.HasPrefix(text, "#") {
+            continue
+        }
+        if i := strings.Index(text, " #"); i >= 0 {
+            text = strings.TrimSpace(text[:i])
+        }
+
+        fields := strings.Fields(text)
+        users, teams, err := parseOwners(fields[1:])
+        if err != nil {
+            return nil, ParseError{Line: line, Reason: fmt.Sprintf("pattern %q: %s", fields[0], err)}
+        }
+        rule, err := NewRule(fields[0], users, teams)
+        if err != nil {
+            return nil, ParseError{Line: line, Reason: err.Error()}
+        }
+        rule.Line = line
+        rules = append(rules, rule)
+    }
+    if err := scanner.Err(); err != nil {
+        return nil, fmt.Errorf("read codeowners: %w", err)
+    }
+    return rules, nil
+}
+
+// NewRule compiles pattern into a rule owned by the given users and teams
+func NewRule(pattern string, users, teams []string) (Rule, error) {
+    re, err := compile(pattern)
+    if err != nil {
+        return Rule{}, err
+    }
+    return Rule{Pattern: pattern, Users: users, Teams: teams, re: re}, nil
+}
+
+func parseOwners(owners []string) ([]string, []string, error) {
+    if len(owners) == 0 {
+        return nil, nil, fmt.Errorf("no owners")
+    }
+
+    var users, teams []string
+    for _, owner := range owners {
+        name, ok := strings.CutPrefix(owner, "@")
+        if !ok || name == "" {
+            return nil, nil, fmt.Errorf("owner %q must start with @", owner)
+        }
+        if _, team, isTeam := strings.Cut(name, "/"); isTeam {
+            if team == "" {
+                return nil, nil, fmt.Errorf("owner %q has empty team name", owner)
+            }
+            teams = append(teams, team)
+            continue
+        }
+        users = append(users, name)
+    }
+    return users, teams, nil
+}
+
+func (r Rule) Match(path string) bool {
+    return r.re != nil && r.re.MatchString(strings.TrimPrefix(path, "/"))
+}
+
+// Owners returns the last rule matching path
+func (rs Ruleset) Owners(path string) (Rule, bool) {
+    for i := len(rs) - 1; i >= 0; i-- {
+        if rs[i].Match(path) {
+            return rs[i], true
+        }
+    }
+    return Rule{}, false
+}
+
+// compile turns a gitignore-like pattern into a regexp over slash separated paths.
+// A pattern without a slash in the middle matches at any depth, a trailing slash
+// matches everything under the directory
+func compile(pattern string) (*regexp.Regexp, error) {
+    if pattern == "" {
+        return nil, fmt.Errorf("empty pattern")
+    }
+    if strings.ContainsAny(pattern, "[]\\!") {
+        return nil, fmt.Errorf("pattern %q: character classes, escapes and negation are not supported", pattern)
+    }
+
+    dirOnly := strings.HasSuffix(pattern, "/")
+    p := strings.Trim(pattern, "/")
+    if p == "" {
+        return nil, fmt.Errorf("pattern %q matches nothing", pattern)
+    }
+    anchored := strings.HasPrefix(pattern, "/") || strings.Contains(p, "/")
+
+    var b strings.Builder
+    b.WriteString("^")
+    if !anchored {
+        b.WriteString("(?:.*/)?")
+    }
+
+    for i := 0; i < len(p); i++ {
+        switch c := p[i]; {
+        case strings.HasPrefix(p[i:], "**/"):
+            b.WriteString("(?:.*/)?")
+            i += 2
+        case strings.HasPrefix(p[i:], "**"):
+            b.WriteString(".*")
+            i++
+        case c == '*':
+            b.WriteString("[^/]*")
+        case c == '?':
+            b.WriteString("[^/]")
+        default:
+            b.WriteString(regexp.QuoteMeta(p[i : i+1]))
+        }
+    }
+
+    if dirOnly {
+        b.WriteString("/.*$")
+    } else {
+        b.WriteString("(?:/.*)?$")
+    }
+    return regexp.Compile(b.String())
+}
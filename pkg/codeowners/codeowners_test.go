@@ -0,0 +1,73 @@
+package codeowners
+
+import (
+    "strings"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+    doc := `
+# default owners
+*                 @org/platform
+*.sql             @u3 @org/dba   # migrations
+/api/             @org/backend
+docs/**/*.md      @u5
+`
+    rules, err := Parse(strings.NewReader(doc))
+    require.NoError(t, err)
+    require.Len(t, rules, 4)
+
+    assert.Equal(t, []string{"u3"}, rules[1].Users)
+    assert.Equal(t, []string{"dba"}, rules[1].Teams)
+    assert.Equal(t, 4, rules[1].Line)
+
+    tests := []struct {
+        path    string
+        pattern string
+    }{
+        {path: "main.go", pattern: "*"},
+        {path: "migrations/0001_init.up.sql", pattern: "*.sql"},
+        {path: "api/openapi.yaml", pattern: "/api/"},
+        {path: "internal/api/handler.go", pattern: "*"},
+        {path: "docs/guide/intro.md", pattern: "docs/**/*.md"},
+        {path: "docs/intro.md", pattern: "docs/**/*.md"},
+    }
+    for _, tt := range tests {
+        t.Run(tt.path, func(t *testing.T) {
+            rule, ok := rules.Owners(tt.path)
+            require.True(t, ok)
+            assert.Equal(t, tt.pattern, rule.Pattern)
+        })
+    }
+}
+
+func TestParse_Errors(t *testing.T) {
+    tests := []struct {
+        name string
+        doc  string
+    }{
+        {name: "правило без владельцев", doc: "*.go"},
+        {name: "владелец без @", doc: "*.go alice"},
+        {name: "пустое имя команды", doc: "*.go @org/"},
+        {name: "отрицание не поддерживается", doc: "!*.go @u1"},
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            _, err := Parse(strings.NewReader(tt.doc))
+            var parseErr ParseError
+            require.ErrorAs(t, err, &parseErr)
+            assert.Equal(t, 1, parseErr.Line)
+        })
+    }
+}
+
+func TestRuleset_Owners_NoMatch(t *testing.T) {
+    rules, err := Parse(strings.NewReader("/cmd/ @u1"))
+    require.NoError(t, err)
+
+    _, ok := rules.Owners("internal/cmd/main.go")
+    assert.False(t, ok)
+}
@@ -0,0 +1,275 @@
+// Package ical reads events out of iCalendar (RFC 5545) documents.
+//
+// Only what an out-of-office calendar needs is supported: VEVENT components with
+// UID, SUMMARY, DTSTART and DTEND or DURATION. Times may be UTC, carry a TZID or be floating,
+// floating times and all-day dates are read in UTC. Recurring and cancelled events are skipped,
+// other components are ignored
+package ical
+
+import (
+    "bufio"
+    "fmt"
+    "io"
+    "strconv"
+    "strings"
+    "time"
+    // the service image ships without a zoneinfo database, TZID parameters need one
+    _ "time/tzdata"
+)
+
+type Event struct {
+    UID     string
+    Summary string
+    Start   time.Time
+    // End is exclusive
+    End    time.Time
+    AllDay bool
+    // Line is where the event begins in the document
+    Line int
+}
+
+// ParseError points at the line of the document that could not be parsed
+type ParseError struct {
+    Line   int
+    Reason string
+}
+
+func (e ParseError) Error() string {
+    return fmt.Sprintf("line %d: %s", e.Line, e.Reason)
+}
+
+type property struct {
+    name   string
+    params map[string]string
+    value  string
+    line   int
+}
+
+func Parse(r io.Reader) ([]Event, error) {
+    props, err := readProperties(r)
+    if err != nil {
+        return nil, err
+    }
+
+    var (
+        events []Event
+        event  *Event
+        skip   bool
+        // nested counts components opened inside the current VEVENT, such as VALARM
+        nested   int
+        duration *time.Duration
+    )
+    for _, prop := range props {
+        switch {
+        case prop.name == "BEGIN" && event != nil:
+            nested++
+        case prop.name == "BEGIN" && strings.EqualFold(prop.value, "VEVENT"):
+            event = &Event{Line: prop.line}
+            skip, duration = false, nil
+        case prop.name == "END" && event != nil && nested > 0:
+            nested--
+        case prop.name == "END" && event != nil:
+            if !skip {
+                if err := finishEvent(event, duration); err != nil {
+                    return nil, err
+                }
+                events = append(events, *event)
+            }
+            event = nil
+        case event == nil || nested > 0:
+            continue
+        case prop.name == "UID":
+            event.UID = prop.value
+        case prop.name == "SUMMARY":
+            event.Summary = unescapeText(prop.value)
+        case prop.name == "DTSTART":
+            event.Start, event.AllDay, err = parseTime(prop)
+        case prop.name == "DTEND":
+            event.End, _, err = parseTime(prop)
+        case prop.name == "DURATION":
+            var d time.Duration
+            d, err = parseDuration(prop.value)
+            duration = &d
+        case prop.name == "RRULE" || prop.name == "RDATE":
+            skip = true
+        case prop.name == "STATUS":
+            skip = skip || strings.EqualFold(prop.value, "CANCELLED")
+        }
+        if err != nil {
+            return nil, ParseError{Line: prop.line, Reason: fmt.Sprintf("%s: %s", prop.name, err)}
+        }
+    }
+    if event != nil {
+        return nil, ParseError{Line: event.Line, Reason: "VEVENT is not closed"}
+    }
+    return events, nil
+}
+
+// finishEvent fills the end of an event that has none: a DURATION after the start,
+// the next day for an all-day event
+func finishEvent(event *Event, duration *time.Duration) error {
+    if event.Start.IsZero() {
+        return ParseError{Line: event.Line, Reason: "VEVENT has no DTSTART"}
+    }
+    if !event.End.IsZero() {
+        return nil
+    }
+    switch {
+    case duration != nil:
+        event.End = event.Start.Add(*duration)
+    case event.AllDay:
+        event.End = event.Start.AddDate(0, 0, 1)
+    default:
+        event.End = event.Start
+    }
+    return nil
+}
+
+// readProperties splits the document into content lines, joining folded ones
+func readProperties(r io.Reader) ([]property, error) {
+    var (
+        props []property
+        text  strings.Builder
+        start int
+    )
+    flush := func() error {
+        if text.Len() == 0 {
+            return nil
+        }
+        prop, err := parseProperty(text.String())
+        if err != nil {
+            return ParseError{Line: start, Reason: err.Error()}
+        }
+        prop.line = start
+        props = append(props, prop)
+        text.Reset()
+        return nil
+    }
+
+    scanner := bufio.NewScanner(r)
+    line := 0
+    for scanner.Scan() {
+        line++
+        raw := strings.TrimRight(scanner.Text(), "\r")
+        if strings.HasPrefix(raw, " ") || strings.HasPrefix(raw, "\t") {
+            text.WriteString(raw[1:])
+            continue
+        }
+        if err := flush(); err != nil {
+            return nil, err
+        }
+        if strings.TrimSpace(raw) == "" {
+            continue
+        }
+        start = line
+        text.WriteString(raw)
+    }
+    if err := scanner.Err(); err != nil {
+        return nil, fmt.Errorf("read calendar: %w", err)
+    }
+    if err := flush(); err != nil {
+        return nil, err
+    }
+    return props, nil
+}
+
+// parseProperty splits "NAME;PARAM=value:value" keeping colons inside quoted parameter values
+func parseProperty(text string) (property, error) {
+    colon, quoted := -1, false
+    for i, c := range text {
+        if c == '"' {
+            quoted = !quoted
+        }
+        if c == ':' && !quoted {
+            colon = i
+            break
+        }
+    }
+    if colon < 0 {
+        return property{}, fmt.Errorf("no value in %q", text)
+    }
+
+    parts := strings.Split(text[:colon], ";")
+    prop := property{
+        name:   strings.ToUpper(parts[0]),
+        params: make(map[string]string, len(parts)-1),
+        value:  text[colon+1:],
+    }
+    for _, param := range parts[1:] {
+        key, value, _ := strings.Cut(param, "=")
+        prop.params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+    }
+    return prop, nil
+}
+
+func parseTime(prop property) (time.Time, bool, error) {
+    if prop.params["VALUE"] == "DATE" || len(prop.value) == len("20060102") {
+        t, err := time.Parse("20060102", prop.value)
+        if err != nil {
+            return time.Time{}, false, fmt.Errorf("invalid date %q", prop.value)
+        }
+        return t, true, nil
+    }
+
+    if strings.HasSuffix(prop.value, "Z") {
+        t, err := time.Parse("20060102T150405Z", prop.value)
+        if err != nil {
+            return time.Time{}, false, fmt.Errorf("invalid date-time %q", prop.value)
+        }
+        return t, false, nil
+    }
+
+    loc := time.UTC
+    if tzid := prop.params["TZID"]; tzid != "" {
+        var err error
+        loc, err = time.LoadLocation(strings.TrimPrefix(tzid, "/"))
+        if err != nil {
+            return time.Time{}, false, fmt.Errorf("unknown time zone %q", tzid)
+        }
+    }
+    t, err := time.ParseInLocation("20060102T150405", prop.value, loc)
+    if err != nil {
+        return time.Time{}, false, fmt.Errorf("invalid date-time %q", prop.value)
+    }
+    return t.UTC(), false, nil
+}
+
+// parseDuration reads a non-negative RFC 5545 duration such as P2W, P1DT12H or PT30M
+func parseDuration(value string) (time.Duration, error) {
+    rest, ok := strings.CutPrefix(strings.TrimPrefix(value, "+"), "P")
+    if !ok || rest == "" {
+        return 0, fmt.Errorf("invalid duration %q", value)
+    }
+
+    units := map[byte]time.Duration{'W': 7 * 24 * time.Hour, 'D': 24 * time.Hour}
+    var total time.Duration
+    for rest != "" {
+        if rest[0] == 'T' {
+            units = map[byte]time.Duration{'H': time.Hour, 'M': time.Minute, 'S': time.Second}
+            rest = rest[1:]
+            continue
+        }
+        i := 0
+        for i < len(rest) && rest[i] >= '0' && rest[i] <= '9' {
+            i++
+        }
+        if i == 0 || i == len(rest) {
+            return 0, fmt.Errorf("invalid duration %q", value)
+        }
+        unit, ok := units[rest[i]]
+        if !ok {
+            return 0, fmt.Errorf("invalid duration %q", value)
+        }
+        n, err := strconv.Atoi(rest[:i])
+        if err != nil {
+            return 0, fmt.Errorf("invalid duration %q", value)
+        }
+        total += time.Duration(n) * unit
+        rest = rest[i+1:]
+    }
+    return total, nil
+}
+
+func unescapeText(value string) string {
+    return strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(value)
+}
@@ -0,0 +1,102 @@
+package ical
+
+import (
+    "strings"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+    doc := strings.ReplaceAll(`BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//Example//Calendar//EN
+BEGIN:VEVENT
+UID:vacation-1@example.com
+SUMMARY:Отпуск\, море
+DTSTART;VALUE=DATE:20251103
+DTEND;VALUE=DATE:20251117
+BEGIN:VALARM
+ACTION:DISPLAY
+DTSTART:19700101T000000Z
+END:VALARM
+END:VEVENT
+BEGIN:VEVENT
+UID:conf-2@exa
+ mple.com
+SUMMARY:Конференция
+DTSTART;TZID=Europe/Moscow:20251201T090000
+DURATION:P1DT9H
+END:VEVENT
+BEGIN:VEVENT
+UID:sick-3
+DTSTART:20251010T070000Z
+DTEND:20251010T150000Z
+END:VEVENT
+BEGIN:VEVENT
+UID:day-off-4
+DTSTART;VALUE=DATE:20251231
+END:VEVENT
+BEGIN:VEVENT
+UID:standup
+DTSTART:20251001T070000Z
+DTEND:20251001T071500Z
+RRULE:FREQ=DAILY
+END:VEVENT
+BEGIN:VEVENT
+UID:cancelled
+DTSTART:20251002T070000Z
+DTEND:20251002T080000Z
+STATUS:CANCELLED
+END:VEVENT
+END:VCALENDAR
+`, "\n", "\r\n")
+
+    events, err := Parse(strings.NewReader(doc))
+    require.NoError(t, err)
+    require.Len(t, events, 4)
+
+    assert.Equal(t, Event{
+        UID:     "vacation-1@example.com",
+        Summary: "Отпуск, море",
+        Start:   time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC),
+        End:     time.Date(2025, 11, 17, 0, 0, 0, 0, time.UTC),
+        AllDay:  true,
+        Line:    4,
+    }, events[0])
+
+    assert.Equal(t, "conf-2@example.com", events[1].UID)
+    assert.Equal(t, time.Date(2025, 12, 1, 6, 0, 0, 0, time.UTC), events[1].Start)
+    assert.Equal(t, time.Date(2025, 12, 2, 15, 0, 0, 0, time.UTC), events[1].End)
+
+    assert.Equal(t, time.Date(2025, 10, 10, 7, 0, 0, 0, time.UTC), events[2].Start)
+    assert.Equal(t, time.Date(2025, 10, 10, 15, 0, 0, 0, time.UTC), events[2].End)
+    assert.False(t, events[2].AllDay)
+
+    assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), events[3].End)
+}
+
+func TestParse_Errors(t *testing.T) {
+    tests := []struct {
+        name string
+        doc  string
+        line int
+    }{
+        {name: "строка без значения", doc: "BEGIN:VEVENT\nDTSTART\nEND:VEVENT", line: 2},
+        {name: "событие без начала", doc: "BEGIN:VEVENT\nUID:1\nEND:VEVENT", line: 1},
+        {name: "неверная дата", doc: "BEGIN:VEVENT\nDTSTART:2025-11-03\nEND:VEVENT", line: 2},
+        {name: "неизвестная таймзона", doc: "BEGIN:VEVENT\nDTSTART;TZID=Mars/Olympus:20251103T090000\nEND:VEVENT", line: 2},
+        {name: "неверная длительность", doc: "BEGIN:VEVENT\nDTSTART:20251103T090000Z\nDURATION:P1X\nEND:VEVENT", line: 3},
+        {name: "незакрытое событие", doc: "BEGIN:VEVENT\nDTSTART:20251103T090000Z", line: 1},
+    }
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            _, err := Parse(strings.NewReader(tt.doc))
+            var parseErr ParseError
+            require.ErrorAs(t, err, &parseErr)
+            assert.Equal(t, tt.line, parseErr.Line)
+        })
+    }
+}